@@ -186,6 +186,10 @@ func (swapper *AutoSwapper) getDismissedChannels() (DismissedChannels, error) {
 		reasons.addChannels(swap.ChanIds, ReasonPendingSwap)
 	}
 	for _, swap := range reverseSwaps {
+		// failed by boltz, the nursery will move it into a terminal state
+		if swap.Status.IsFailedStatus() {
+			continue
+		}
 		reasons.addChannels(swap.ChanIds, ReasonPendingSwap)
 	}
 
@@ -292,9 +296,9 @@ func (swapper *AutoSwapper) execute(recommendation *SwapRecommendation, address
 		})
 	} else if recommendation.Type == boltz.NormalSwap {
 		err = swapper.ExecuteSwap(&boltzrpc.CreateSwapRequest{
-			Amount: int64(recommendation.Amount),
-			Pair:   pair,
-			//ChanIds:          chanIds,
+			Amount:           int64(recommendation.Amount),
+			Pair:             pair,
+			ChanIds:          chanIds,
 			SendFromInternal: true,
 			Wallet:           &swapper.cfg.Wallet,
 		})
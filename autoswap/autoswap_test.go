@@ -1,14 +1,19 @@
 package autoswap
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
 	"testing"
 	"time"
 
 	"github.com/BoltzExchange/boltz-client/lightning"
+	"github.com/BoltzExchange/boltz-client/utils"
 
 	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltz/mock"
 	"github.com/BoltzExchange/boltz-client/boltzrpc"
 	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/btcsuite/btcd/btcec/v2"
 
 	"github.com/stretchr/testify/require"
 )
@@ -232,6 +237,22 @@ func TestStrategies(t *testing.T) {
 			},
 			outcome: nil,
 		},
+		{
+			name: "PerChannel/Normal",
+			config: &SerializedConfig{
+				PerChannel:        true,
+				MaxBalancePercent: 60,
+				MinBalancePercent: 40,
+				SwapType:          "normal",
+			},
+			outcome: []*rawRecommendation{
+				{
+					Type:    boltz.NormalSwap,
+					Amount:  50,
+					Channel: channels[1],
+				},
+			},
+		},
 		{
 			name: "TotalBalance/Reverse",
 			config: &SerializedConfig{
@@ -424,6 +445,13 @@ func TestDismissedChannels(t *testing.T) {
 					IsAuto:  true,
 					ChanIds: []lightning.ChanId{3},
 				},
+				{
+					Id:      "TEST2",
+					State:   boltzrpc.SwapState_PENDING,
+					Status:  boltz.TransactionFailed,
+					IsAuto:  true,
+					ChanIds: []lightning.ChanId{4},
+				},
 			},
 			dismissed: DismissedChannels{
 				0: []string{ReasonPendingSwap},
@@ -578,3 +606,103 @@ func TestCheckSwapRecommendation(t *testing.T) {
 		})
 	}
 }
+
+func TestMockServer(t *testing.T) {
+	server, err := mock.NewServer(boltz.Regtest)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	client := server.Client()
+
+	swapper := getSwapper(t, &SerializedConfig{
+		PerChannel:        true,
+		MaxBalancePercent: 60,
+		MinBalancePercent: 40,
+		SwapType:          "reverse",
+		FailureBackoff:    1000,
+	})
+	channel := &lightning.LightningChannel{LocalSat: 900000, RemoteSat: 100000, Capacity: 1000000, Id: 1}
+	swapper.ListChannels = func() ([]*lightning.LightningChannel, error) {
+		return []*lightning.LightningChannel{channel}, nil
+	}
+	swapper.GetPairInfo = func(pair *boltzrpc.Pair, swapType boltz.SwapType) (*PairInfo, error) {
+		pairs, err := client.GetReversePairs()
+		if err != nil {
+			return nil, err
+		}
+		reversePair := pairs[boltz.CurrencyBtc][boltz.CurrencyBtc]
+		return &PairInfo{
+			Limits: Limits{
+				MinAmount: reversePair.Limits.Minimal,
+				MaxAmount: reversePair.Limits.Maximal,
+			},
+			PercentageFee: utils.Percentage(reversePair.Fees.Percentage),
+			OnchainFee:    reversePair.Fees.MinerFees.Lockup + reversePair.Fees.MinerFees.Claim,
+		}, nil
+	}
+	// creates the reverse swap with the server and stores it like the rpc server does
+	swapper.ExecuteReverseSwap = func(request *boltzrpc.CreateReverseSwapRequest) error {
+		preimage := make([]byte, 32)
+		if _, err := rand.Read(preimage); err != nil {
+			return err
+		}
+		preimageHash := sha256.Sum256(preimage)
+		claimKey, err := btcec.NewPrivateKey()
+		if err != nil {
+			return err
+		}
+		response, err := client.CreateReverseSwap(boltz.CreateReverseSwapRequest{
+			From:           boltz.CurrencyBtc,
+			To:             boltz.CurrencyBtc,
+			PreimageHash:   preimageHash[:],
+			ClaimPublicKey: claimKey.PubKey().SerializeCompressed(),
+			InvoiceAmount:  uint64(request.Amount),
+		})
+		if err != nil {
+			return err
+		}
+		chanId, err := lightning.NewChanIdFromString(request.ChanIds[0])
+		if err != nil {
+			return err
+		}
+		return swapper.database.CreateReverseSwap(database.ReverseSwap{
+			Id:            response.Id,
+			Pair:          boltz.PairBtc,
+			State:         boltzrpc.SwapState_PENDING,
+			Status:        boltz.SwapCreated,
+			PrivateKey:    claimKey,
+			Preimage:      preimage,
+			PaymentHash:   preimageHash[:],
+			Invoice:       response.Invoice,
+			OnchainAmount: response.OnchainAmount,
+			ChanIds:       []lightning.ChanId{chanId},
+			IsAuto:        true,
+			CreatedAt:     time.Now(),
+		})
+	}
+
+	// the limits of the server apply
+	server.SetLimits(mock.Limits{Minimal: 500000, Maximal: 10000000})
+	recommendations, err := swapper.GetSwapRecommendations()
+	require.NoError(t, err)
+	require.Len(t, recommendations, 1)
+	require.Equal(t, []string{ReasonAmountBelowMin}, recommendations[0].DismissedReasons)
+
+	server.SetLimits(mock.Limits{Minimal: 10000, Maximal: 10000000})
+	recommendations, err = swapper.GetSwapRecommendations()
+	require.NoError(t, err)
+	require.Len(t, recommendations, 1)
+	recommendation := recommendations[0]
+	require.False(t, recommendation.Dismissed())
+	require.Equal(t, uint64(400000), recommendation.Amount)
+	// 0.1% service fee and the miner fees of the lockup and claim
+	require.Equal(t, uint64(400+2*500), recommendation.FeeEstimate)
+
+	require.NoError(t, swapper.execute(recommendation, ""))
+	require.Len(t, server.Swaps(boltz.ReverseSwap), 1)
+
+	// no further swaps of the channel while the first one is pending
+	recommendations, err = swapper.GetSwapRecommendations()
+	require.NoError(t, err)
+	require.Len(t, recommendations, 1)
+	require.Equal(t, []string{ReasonPendingSwap}, recommendations[0].DismissedReasons)
+}
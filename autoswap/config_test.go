@@ -99,7 +99,7 @@ func TestConfigInit(t *testing.T) {
 			err: true,
 		},
 		{
-			name: "PerChannel/SubmarineForbidden",
+			name: "PerChannel/AbsoluteForbidden",
 			cfg: &SerializedConfig{
 				MinBalance: 10000,
 				PerChannel: true,
@@ -107,6 +107,15 @@ func TestConfigInit(t *testing.T) {
 			},
 			err: true,
 		},
+		{
+			name: "PerChannel/Submarine",
+			cfg: &SerializedConfig{
+				MinBalancePercent: 25,
+				PerChannel:        true,
+				SwapType:          "submarine",
+			},
+			err: false,
+		},
 	}
 
 	for _, tc := range tt {
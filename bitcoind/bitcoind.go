@@ -0,0 +1,211 @@
+package bitcoind
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/logger"
+	"github.com/BoltzExchange/boltz-client/onchain"
+)
+
+const defaultPollInterval = 10 * time.Second
+
+// Client talks to the JSON-RPC interface of a bitcoind or elementsd node
+type Client struct {
+	url      string
+	user     string
+	password string
+
+	PollInterval time.Duration
+
+	requestId atomic.Uint64
+}
+
+type rpcRequest struct {
+	JsonRpc string `json:"jsonrpc"`
+	Id      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (err *rpcError) Error() string {
+	return fmt.Sprintf("%s (code %d)", err.Message, err.Code)
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+// returned by the node if a transaction is neither in the mempool nor in the chain
+const errCodeNoSuchTransaction = -5
+
+type rawTransactionResponse struct {
+	Confirmations uint32 `json:"confirmations"`
+}
+
+type estimateSmartFeeResponse struct {
+	FeeRate *float64 `json:"feerate"`
+	Errors  []string `json:"errors"`
+}
+
+func NewClient(url string, user string, password string) *Client {
+	return &Client{
+		url:          url,
+		user:         user,
+		password:     password,
+		PollInterval: defaultPollInterval,
+	}
+}
+
+func (c *Client) call(method string, result any, params ...any) error {
+	if params == nil {
+		params = []any{}
+	}
+	body, err := json.Marshal(rpcRequest{
+		JsonRpc: "1.0",
+		Id:      c.requestId.Add(1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.user != "" || c.password != "" {
+		req.SetBasicAuth(c.user, c.password)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	raw, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	var response rpcResponse
+	if err := json.Unmarshal(raw, &response); err != nil {
+		return fmt.Errorf("could not parse %s response with status %d: %w", method, res.StatusCode, err)
+	}
+	if response.Error != nil {
+		return fmt.Errorf("%s failed: %w", method, response.Error)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(response.Result, result)
+}
+
+func (c *Client) GetBlockHeight() (uint32, error) {
+	var height uint32
+	err := c.call("getblockcount", &height)
+	return height, err
+}
+
+// RegisterBlockListener polls the node for new blocks since we don't want to require zmq to be configured
+func (c *Client) RegisterBlockListener(channel chan<- *onchain.BlockEpoch, stop <-chan bool) error {
+	ticker := time.NewTicker(c.PollInterval)
+	defer ticker.Stop()
+
+	var lastHeight uint32
+	for {
+		height, err := c.GetBlockHeight()
+		if err != nil {
+			return errors.New("could not get block height: " + err.Error())
+		}
+		if height != lastHeight {
+			logger.Silly(fmt.Sprintf("New block from node: %d", height))
+			lastHeight = height
+			block := &onchain.BlockEpoch{Height: height}
+			if timestamp, err := c.getBlockTime(height); err == nil {
+				block.Timestamp = timestamp
+			} else {
+				logger.Warnf("Could not get time of block %d: %v", height, err)
+			}
+			select {
+			case channel <- block:
+			case <-stop:
+				return nil
+			}
+		}
+
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) getBlockTime(height uint32) (time.Time, error) {
+	var hash string
+	if err := c.call("getblockhash", &hash, height); err != nil {
+		return time.Time{}, err
+	}
+	var header struct {
+		Time int64 `json:"time"`
+	}
+	if err := c.call("getblockheader", &header, hash); err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(header.Time, 0), nil
+}
+
+func (c *Client) EstimateFee(confTarget int32) (float64, error) {
+	var response estimateSmartFeeResponse
+	if err := c.call("estimatesmartfee", &response, confTarget); err != nil {
+		return 0, err
+	}
+	if response.FeeRate == nil {
+		if len(response.Errors) > 0 {
+			return 0, errors.New("could not estimate fee: " + response.Errors[0])
+		}
+		return 0, errors.New("could not estimate fee")
+	}
+	// the node returns BTC per kvB
+	return *response.FeeRate * 1e8 / 1000, nil
+}
+
+func (c *Client) GetTxHex(txId string) (string, error) {
+	var hex string
+	err := c.call("getrawtransaction", &hex, txId, false)
+	return hex, err
+}
+
+func (c *Client) IsTransactionConfirmed(txId string) (bool, error) {
+	var response rawTransactionResponse
+	if err := c.call("getrawtransaction", &response, txId, true); err != nil {
+		var rpcErr *rpcError
+		if errors.As(err, &rpcErr) && rpcErr.Code == errCodeNoSuchTransaction {
+			return false, fmt.Errorf("%w: %s", onchain.ErrTransactionNotFound, err)
+		}
+		return false, err
+	}
+	return response.Confirmations > 0, nil
+}
+
+func (c *Client) BroadcastTransaction(txHex string) (string, error) {
+	var txId string
+	err := c.call("sendrawtransaction", &txId, txHex)
+	return txId, err
+}
@@ -0,0 +1,200 @@
+package bitcoind
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/stretchr/testify/require"
+)
+
+const user = "boltz"
+const password = "anoVB0m1KvX0SmpPxvaLVADg0UQVLQTEx3jCD3qtuRI"
+
+type stubNode struct {
+	blockHeight   atomic.Uint32
+	txs           map[string]string
+	confirmations uint32
+}
+
+func (node *stubNode) handle(method string, params []json.RawMessage) (any, *rpcError) {
+	switch method {
+	case "getblockcount":
+		return node.blockHeight.Load(), nil
+	case "estimatesmartfee":
+		var confTarget int32
+		if err := json.Unmarshal(params[0], &confTarget); err != nil {
+			return nil, &rpcError{Code: -8, Message: err.Error()}
+		}
+		if confTarget > 100 {
+			return map[string]any{"errors": []string{"Insufficient data or no feerate found"}}, nil
+		}
+		return map[string]any{"feerate": 0.00021, "blocks": confTarget}, nil
+	case "getrawtransaction":
+		var txId string
+		if err := json.Unmarshal(params[0], &txId); err != nil {
+			return nil, &rpcError{Code: -8, Message: err.Error()}
+		}
+		hex, ok := node.txs[txId]
+		if !ok {
+			return nil, &rpcError{Code: -5, Message: "No such mempool or blockchain transaction"}
+		}
+		var verbose bool
+		if len(params) > 1 {
+			if err := json.Unmarshal(params[1], &verbose); err != nil {
+				return nil, &rpcError{Code: -8, Message: err.Error()}
+			}
+		}
+		if verbose {
+			return map[string]any{"txid": txId, "hex": hex, "confirmations": node.confirmations}, nil
+		}
+		return hex, nil
+	case "sendrawtransaction":
+		var hex string
+		if err := json.Unmarshal(params[0], &hex); err != nil {
+			return nil, &rpcError{Code: -8, Message: err.Error()}
+		}
+		node.txs["txId"] = hex
+		return "txId", nil
+	}
+	return nil, &rpcError{Code: -32601, Message: "Method not found"}
+}
+
+func setup(t *testing.T) (*Client, *stubNode) {
+	node := &stubNode{txs: make(map[string]string)}
+	node.blockHeight.Store(100)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != user || p != password {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var request struct {
+			Id     uint64            `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+
+		result, rpcErr := node.handle(request.Method, request.Params)
+		if rpcErr != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+			"id":     request.Id,
+			"result": result,
+			"error":  rpcErr,
+		}))
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, user, password)
+	client.PollInterval = 10 * time.Millisecond
+	return client, node
+}
+
+func TestGetBlockHeight(t *testing.T) {
+	client, _ := setup(t)
+
+	height, err := client.GetBlockHeight()
+	require.NoError(t, err)
+	require.Equal(t, uint32(100), height)
+}
+
+func TestUnauthorized(t *testing.T) {
+	client, _ := setup(t)
+	client.password = "wrong"
+
+	_, err := client.GetBlockHeight()
+	require.Error(t, err)
+}
+
+func TestEstimateFee(t *testing.T) {
+	client, _ := setup(t)
+
+	fee, err := client.EstimateFee(2)
+	require.NoError(t, err)
+	require.InDelta(t, 21, fee, 0.0001)
+
+	_, err = client.EstimateFee(1000)
+	require.ErrorContains(t, err, "Insufficient data")
+}
+
+func TestTransactions(t *testing.T) {
+	client, _ := setup(t)
+
+	_, err := client.GetTxHex("txId")
+	require.ErrorContains(t, err, "No such mempool or blockchain transaction")
+
+	txId, err := client.BroadcastTransaction("hex")
+	require.NoError(t, err)
+	require.Equal(t, "txId", txId)
+
+	hex, err := client.GetTxHex(txId)
+	require.NoError(t, err)
+	require.Equal(t, "hex", hex)
+}
+
+func TestIsTransactionConfirmed(t *testing.T) {
+	client, node := setup(t)
+
+	_, err := client.IsTransactionConfirmed("txId")
+	require.ErrorIs(t, err, onchain.ErrTransactionNotFound)
+
+	txId, err := client.BroadcastTransaction("hex")
+	require.NoError(t, err)
+
+	confirmed, err := client.IsTransactionConfirmed(txId)
+	require.NoError(t, err)
+	require.False(t, confirmed)
+
+	node.confirmations = 1
+	confirmed, err = client.IsTransactionConfirmed(txId)
+	require.NoError(t, err)
+	require.True(t, confirmed)
+}
+
+func TestBlockListener(t *testing.T) {
+	client, node := setup(t)
+
+	blocks := make(chan *onchain.BlockEpoch)
+	stop := make(chan bool)
+	go func() {
+		require.NoError(t, client.RegisterBlockListener(blocks, stop))
+		close(blocks)
+	}()
+
+	block := <-blocks
+	require.Equal(t, uint32(100), block.Height)
+
+	node.blockHeight.Store(101)
+	block = <-blocks
+	require.Equal(t, uint32(101), block.Height)
+
+	stop <- true
+	_, ok := <-blocks
+	require.False(t, ok)
+}
+
+func TestBlockListenerStopWhileSending(t *testing.T) {
+	client, _ := setup(t)
+
+	stop := make(chan bool)
+	stopped := make(chan error)
+	go func() {
+		// nobody receives the blocks
+		stopped <- client.RegisterBlockListener(make(chan *onchain.BlockEpoch), stop)
+	}()
+
+	stop <- true
+	select {
+	case err := <-stopped:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		require.Fail(t, "block listener did not stop")
+	}
+}
@@ -1,7 +1,6 @@
 package boltz
 
 import (
-	"bytes"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
@@ -9,12 +8,23 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
+
+	"github.com/BoltzExchange/boltz-client/proxy"
 )
 
 type Boltz struct {
-	URL string `long:"boltz.url" description:"URL endpoint of the Boltz API"`
+	URL          string   `long:"boltz.url" description:"URL endpoint of the Boltz API"`
+	FallbackURLs []string `long:"boltz.fallback-url" description:"URL endpoint of the Boltz API to use when the preferred ones can not be reached; can be specified multiple times"`
 
 	DisablePartialSignatures bool
+
+	current      string
+	resolver     EndpointResolver
+	endpointLock sync.RWMutex
+
+	proxy  *proxy.Proxy
+	client *http.Client
 }
 
 type SwapType string
@@ -22,6 +32,7 @@ type SwapType string
 const (
 	NormalSwap  SwapType = "submarine"
 	ReverseSwap SwapType = "reverse"
+	ChainSwap   SwapType = "chain"
 )
 
 func ParseSwapType(swapType string) (SwapType, error) {
@@ -30,6 +41,8 @@ func ParseSwapType(swapType string) (SwapType, error) {
 		return NormalSwap, nil
 	case string(ReverseSwap):
 		return ReverseSwap, nil
+	case string(ChainSwap):
+		return ChainSwap, nil
 	case "":
 		return "", nil
 	default:
@@ -52,6 +65,17 @@ func (s HexString) MarshalText() ([]byte, error) {
 
 type Error error
 
+// ErrInvalidPairHash is returned when a swap is created with the hash of fees which are not the current ones of boltz
+var ErrInvalidPairHash = errors.New("invalid pair hash")
+
+// createError converts the error boltz rejected the creation of a swap with
+func createError(message string) error {
+	if message == ErrInvalidPairHash.Error() {
+		return ErrInvalidPairHash
+	}
+	return Error(errors.New(message))
+}
+
 // Types for Boltz API
 type GetVersionResponse struct {
 	Version string `json:"version"`
@@ -91,6 +115,28 @@ type ReversePair struct {
 
 type ReversePairs map[Currency]map[Currency]ReversePair
 
+type ChainPair struct {
+	Hash   string  `json:"hash"`
+	Rate   float64 `json:"rate"`
+	Limits struct {
+		Minimal               uint64 `json:"minimal"`
+		Maximal               uint64 `json:"maximal"`
+		MaximalZeroConfAmount uint64 `json:"maximalZeroConf"`
+	} `json:"limits"`
+	Fees struct {
+		Percentage float64 `json:"percentage"`
+		MinerFees  struct {
+			Server uint64 `json:"server"`
+			User   struct {
+				Claim  uint64 `json:"claim"`
+				Lockup uint64 `json:"lockup"`
+			} `json:"user"`
+		} `json:"minerFees"`
+	} `json:"fees"`
+}
+
+type ChainPairs map[Currency]map[Currency]ChainPair
+
 type symbolMinerFees struct {
 	Normal  uint64 `json:"normal"`
 	Reverse struct {
@@ -161,12 +207,11 @@ type GetTransactionResponse struct {
 }
 
 type BroadcastTransactionRequest struct {
-	Currency       string `json:"currency"`
-	TransactionHex string `json:"transactionHex"`
+	Hex string `json:"hex"`
 }
 
 type BroadcastTransactionResponse struct {
-	TransactionId string `json:"transactionId"`
+	Id string `json:"id"`
 
 	Error string `json:"error"`
 }
@@ -175,7 +220,7 @@ type CreateSwapRequest struct {
 	From            Currency  `json:"from"`
 	To              Currency  `json:"to"`
 	PairHash        string    `json:"pairHash,omitempty"`
-	RefundPublicKey HexString `json:"refundPublicKey"`
+	RefundPublicKey HexString `json:"refundPublicKey,omitempty"`
 	Invoice         string    `json:"invoice,omitempty"`
 	ReferralId      string    `json:"referralId"`
 	PreimageHash    HexString `json:"preimageHash,omitempty"`
@@ -193,6 +238,10 @@ type CreateSwapResponse struct {
 	AcceptZeroConf     bool            `json:"acceptZeroConf"`
 	ExpectedAmount     uint64          `json:"expectedAmount"`
 	BlindingKey        HexString       `json:"blindingKey"`
+	// address of boltz which can claim the lockup of EVM swaps
+	ClaimAddress string `json:"claimAddress"`
+	// endpoint which created the swap
+	Endpoint string `json:"-"`
 
 	Error string `json:"error"`
 }
@@ -230,7 +279,8 @@ type CreateReverseSwapRequest struct {
 	From           Currency  `json:"from"`
 	To             Currency  `json:"to"`
 	PreimageHash   HexString `json:"preimageHash"`
-	ClaimPublicKey HexString `json:"claimPublicKey"`
+	ClaimPublicKey HexString `json:"claimPublicKey,omitempty"`
+	ClaimAddress   string    `json:"claimAddress,omitempty"`
 	InvoiceAmount  uint64    `json:"invoiceAmount,omitempty"`
 	OnchainAmount  uint64    `json:"onchainAmount,omitempty"`
 	PairHash       string    `json:"pairHash,omitempty"`
@@ -248,9 +298,27 @@ type CreateReverseSwapResponse struct {
 	TimeoutBlockHeight uint32          `json:"timeoutBlockHeight"`
 	OnchainAmount      uint64          `json:"onchainAmount"`
 	BlindingKey        HexString       `json:"blindingKey"`
+	// address of boltz which can refund the lockup of EVM swaps
+	RefundAddress string `json:"refundAddress"`
+	// endpoint which created the swap
+	Endpoint string `json:"-"`
+
+	Error string `json:"error"`
+}
+
+type ContractsResponse struct {
+	Network struct {
+		ChainId uint64 `json:"chainId"`
+		Name    string `json:"name"`
+	} `json:"network"`
+	SwapContracts struct {
+		EtherSwap string `json:"EtherSwap"`
+		ERC20Swap string `json:"ERC20Swap"`
+	} `json:"swapContracts"`
 
 	Error string `json:"error"`
 }
+
 type ClaimReverseSwapRequest struct {
 	Id          string    `json:"id"`
 	Preimage    HexString `json:"preimage"`
@@ -259,6 +327,82 @@ type ClaimReverseSwapRequest struct {
 	Index       int       `json:"index"`
 }
 
+type ChainRequest struct {
+	From             Currency  `json:"from"`
+	To               Currency  `json:"to"`
+	PreimageHash     HexString `json:"preimageHash"`
+	ClaimPublicKey   HexString `json:"claimPublicKey"`
+	RefundPublicKey  HexString `json:"refundPublicKey"`
+	UserLockAmount   uint64    `json:"userLockAmount,omitempty"`
+	ServerLockAmount uint64    `json:"serverLockAmount,omitempty"`
+	PairHash         string    `json:"pairHash,omitempty"`
+	ReferralId       string    `json:"referralId"`
+}
+
+type ChainSwapData struct {
+	SwapTree           *SerializedTree `json:"swapTree"`
+	LockupAddress      string          `json:"lockupAddress"`
+	ServerPublicKey    HexString       `json:"serverPublicKey"`
+	TimeoutBlockHeight uint32          `json:"timeoutBlockHeight"`
+	Amount             uint64          `json:"amount"`
+	BlindingKey        HexString       `json:"blindingKey"`
+	Bip21              string          `json:"bip21"`
+}
+
+type ChainResponse struct {
+	Id            string         `json:"id"`
+	ClaimDetails  *ChainSwapData `json:"claimDetails"`
+	LockupDetails *ChainSwapData `json:"lockupDetails"`
+	// endpoint which created the swap
+	Endpoint string `json:"-"`
+
+	Error string `json:"error"`
+}
+
+type ChainSwapTransaction struct {
+	Transaction struct {
+		Id  string `json:"id"`
+		Hex string `json:"hex"`
+	} `json:"transaction"`
+	Timeout *struct {
+		BlockHeight uint32 `json:"blockHeight"`
+		Eta         uint64 `json:"eta"`
+	} `json:"timeout"`
+}
+
+type ChainSwapTransactions struct {
+	UserLock   *ChainSwapTransaction `json:"userLock"`
+	ServerLock *ChainSwapTransaction `json:"serverLock"`
+
+	Error string `json:"error"`
+}
+
+type ChainSwapSigningDetails struct {
+	PubNonce        HexString `json:"pubNonce"`
+	PublicKey       HexString `json:"publicKey"`
+	TransactionHash HexString `json:"transactionHash"`
+
+	Error string `json:"error"`
+}
+
+type ChainSwapToSign struct {
+	PubNonce    HexString `json:"pubNonce"`
+	Transaction string    `json:"transaction"`
+	Index       int       `json:"index"`
+}
+
+type ChainSwapSigningRequest struct {
+	Preimage  HexString         `json:"preimage,omitempty"`
+	Signature *PartialSignature `json:"signature,omitempty"`
+	ToSign    *ChainSwapToSign  `json:"toSign,omitempty"`
+}
+
+type RefundChainSwapRequest struct {
+	PubNonce    HexString `json:"pubNonce"`
+	Transaction string    `json:"transaction"`
+	Index       int       `json:"index"`
+}
+
 type PartialSignature struct {
 	PubNonce         HexString `json:"pubNonce"`
 	PartialSignature HexString `json:"partialSignature"`
@@ -303,6 +447,24 @@ func (boltz *Boltz) GetReversePairs() (response ReversePairs, err error) {
 	return response, err
 }
 
+func (boltz *Boltz) GetChainPairs() (response ChainPairs, err error) {
+	err = boltz.sendGetRequest("/v2/swap/chain", &response)
+
+	return response, err
+}
+
+// GetContracts returns the swap contracts boltz uses on the EVM chain of the currency
+func (boltz *Boltz) GetContracts(currency Currency) (*ContractsResponse, error) {
+	var response ContractsResponse
+	err := boltz.sendGetRequest("/v2/chain/"+string(currency)+"/contracts", &response)
+
+	if response.Error != "" {
+		return nil, Error(errors.New(response.Error))
+	}
+
+	return &response, err
+}
+
 func (boltz *Boltz) GetNodes() (Nodes, error) {
 	var response Nodes
 	err := boltz.sendGetRequest("/v2/nodes", &response)
@@ -312,7 +474,7 @@ func (boltz *Boltz) GetNodes() (Nodes, error) {
 
 func (boltz *Boltz) SwapStatus(id string) (*SwapStatusResponse, error) {
 	var response SwapStatusResponse
-	err := boltz.sendGetRequest("/v2/swap/"+id, &response)
+	err := boltz.sendSwapGetRequest(id, "/v2/swap/"+id, &response)
 
 	if response.Error != "" {
 		return nil, Error(errors.New(response.Error))
@@ -323,7 +485,7 @@ func (boltz *Boltz) SwapStatus(id string) (*SwapStatusResponse, error) {
 
 func (boltz *Boltz) GetSwapTransaction(id string) (*GetSwapTransactionResponse, error) {
 	var response GetSwapTransactionResponse
-	err := boltz.sendPostRequest("/getswaptransaction", GetSwapTransactionRequest{
+	err := boltz.sendSwapPostRequest(id, "/getswaptransaction", GetSwapTransactionRequest{
 		Id: id,
 	}, &response)
 
@@ -346,34 +508,27 @@ func (boltz *Boltz) GetTransaction(transactionId string, currency Currency) (str
 	return response.Hex, err
 }
 
-func (boltz *Boltz) BroadcastTransaction(transaction Transaction) (*BroadcastTransactionResponse, error) {
+func (boltz *Boltz) BroadcastTransaction(transactionHex string, currency Currency) (string, error) {
 	var response BroadcastTransactionResponse
-	currency := CurrencyBtc
-	if _, ok := transaction.(*LiquidTransaction); ok {
-		currency = CurrencyLiquid
-	}
-	transactionHex, err := transaction.Serialize()
-	if err != nil {
-		return nil, fmt.Errorf("could not serialize transaction: %v", err)
-	}
-	err = boltz.sendPostRequest("/broadcasttransaction", BroadcastTransactionRequest{
-		Currency:       string(currency),
-		TransactionHex: transactionHex,
+	path := fmt.Sprintf("/v2/chain/%s/transaction", currency)
+	err := boltz.sendPostRequest(path, BroadcastTransactionRequest{
+		Hex: transactionHex,
 	}, &response)
 
 	if response.Error != "" {
-		return nil, Error(errors.New(response.Error))
+		return "", Error(errors.New(response.Error))
 	}
 
-	return &response, err
+	return response.Id, err
 }
 
 func (boltz *Boltz) CreateSwap(request CreateSwapRequest) (*CreateSwapResponse, error) {
 	var response CreateSwapResponse
-	err := boltz.sendPostRequest("/v2/swap/submarine", request, &response)
+	endpoint, err := boltz.sendCreateRequest("/v2/swap/submarine", request, &response)
+	response.Endpoint = endpoint
 
 	if response.Error != "" {
-		return nil, Error(errors.New(response.Error))
+		return nil, createError(response.Error)
 	}
 
 	return &response, err
@@ -384,7 +539,7 @@ func (boltz *Boltz) RefundSwap(request RefundSwapRequest) (*PartialSignature, er
 		return nil, errors.New("partial signatures are disabled")
 	}
 	var response PartialSignature
-	err := boltz.sendPostRequest("/v2/swap/submarine/refund", request, &response)
+	err := boltz.sendSwapPostRequest(request.Id, "/v2/swap/submarine/refund", request, &response)
 
 	if response.Error != "" {
 		return nil, Error(errors.New(response.Error))
@@ -395,7 +550,7 @@ func (boltz *Boltz) RefundSwap(request RefundSwapRequest) (*PartialSignature, er
 
 func (boltz *Boltz) GetInvoiceAmount(swapId string) (*GetInvoiceAmountResponse, error) {
 	var response GetInvoiceAmountResponse
-	err := boltz.sendGetRequest(fmt.Sprintf("/v2/swap/submarine/%s/invoice/amount", swapId), &response)
+	err := boltz.sendSwapGetRequest(swapId, fmt.Sprintf("/v2/swap/submarine/%s/invoice/amount", swapId), &response)
 
 	if response.Error != "" {
 		return nil, Error(errors.New(response.Error))
@@ -409,7 +564,7 @@ func (boltz *Boltz) GetSwapClaimDetails(swapId string) (*SwapClaimDetails, error
 		return nil, errors.New("partial signatures are disabled")
 	}
 	var response SwapClaimDetails
-	err := boltz.sendGetRequest(fmt.Sprintf("/v2/swap/submarine/%s/claim", swapId), &response)
+	err := boltz.sendSwapGetRequest(swapId, fmt.Sprintf("/v2/swap/submarine/%s/claim", swapId), &response)
 
 	if response.Error != "" {
 		return nil, Error(errors.New(response.Error))
@@ -420,7 +575,7 @@ func (boltz *Boltz) GetSwapClaimDetails(swapId string) (*SwapClaimDetails, error
 
 func (boltz *Boltz) SendSwapClaimSignature(swapId string, signature *PartialSignature) error {
 	var response ErrorMessage
-	err := boltz.sendPostRequest(fmt.Sprintf("/v2/swap/submarine/%s/claim", swapId), signature, &response)
+	err := boltz.sendSwapPostRequest(swapId, fmt.Sprintf("/v2/swap/submarine/%s/claim", swapId), signature, &response)
 
 	if response.Error != "" {
 		return Error(errors.New(response.Error))
@@ -431,7 +586,7 @@ func (boltz *Boltz) SendSwapClaimSignature(swapId string, signature *PartialSign
 
 func (boltz *Boltz) SetInvoice(swapId string, invoice string) (*SetInvoiceResponse, error) {
 	var response SetInvoiceResponse
-	err := boltz.sendPostRequest(fmt.Sprintf("/v2/swap/submarine/%s/invoice", swapId), SetInvoiceRequest{Invoice: invoice}, &response)
+	err := boltz.sendSwapPostRequest(swapId, fmt.Sprintf("/v2/swap/submarine/%s/invoice", swapId), SetInvoiceRequest{Invoice: invoice}, &response)
 
 	if response.Error != "" {
 		return nil, Error(errors.New(response.Error))
@@ -442,10 +597,11 @@ func (boltz *Boltz) SetInvoice(swapId string, invoice string) (*SetInvoiceRespon
 
 func (boltz *Boltz) CreateReverseSwap(request CreateReverseSwapRequest) (*CreateReverseSwapResponse, error) {
 	var response CreateReverseSwapResponse
-	err := boltz.sendPostRequest("/v2/swap/reverse", request, &response)
+	endpoint, err := boltz.sendCreateRequest("/v2/swap/reverse", request, &response)
+	response.Endpoint = endpoint
 
 	if response.Error != "" {
-		return nil, Error(errors.New(response.Error))
+		return nil, createError(response.Error)
 	}
 
 	return &response, err
@@ -456,7 +612,7 @@ func (boltz *Boltz) ClaimReverseSwap(request ClaimReverseSwapRequest) (*PartialS
 		return nil, errors.New("partial signatures are disabled")
 	}
 	var response PartialSignature
-	err := boltz.sendPostRequest("/v2/swap/reverse/claim", request, &response)
+	err := boltz.sendSwapPostRequest(request.Id, "/v2/swap/reverse/claim", request, &response)
 
 	if response.Error != "" {
 		return nil, Error(errors.New(response.Error))
@@ -465,33 +621,82 @@ func (boltz *Boltz) ClaimReverseSwap(request ClaimReverseSwapRequest) (*PartialS
 	return &response, err
 }
 
-func (boltz *Boltz) sendGetRequest(endpoint string, response interface{}) error {
-	res, err := http.Get(boltz.URL + endpoint)
+func (boltz *Boltz) CreateChainSwap(request ChainRequest) (*ChainResponse, error) {
+	var response ChainResponse
+	endpoint, err := boltz.sendCreateRequest("/v2/swap/chain", request, &response)
+	response.Endpoint = endpoint
 
-	if err != nil {
-		return err
+	if response.Error != "" {
+		return nil, createError(response.Error)
 	}
 
-	return unmarshalJson(res.Body, &response)
+	return &response, err
 }
 
-func (boltz *Boltz) sendPostRequest(endpoint string, requestBody interface{}, response interface{}) error {
-	rawBody, err := json.Marshal(requestBody)
+func (boltz *Boltz) GetChainSwapTransactions(swapId string) (*ChainSwapTransactions, error) {
+	var response ChainSwapTransactions
+	err := boltz.sendSwapGetRequest(swapId, fmt.Sprintf("/v2/swap/chain/%s/transactions", swapId), &response)
 
-	if err != nil {
-		return err
+	if response.Error != "" {
+		return nil, Error(errors.New(response.Error))
 	}
 
-	res, err := http.Post(boltz.URL+endpoint, "application/json", bytes.NewBuffer(rawBody))
+	return &response, err
+}
 
-	if err != nil {
-		return err
+func (boltz *Boltz) GetChainSwapClaimDetails(swapId string) (*ChainSwapSigningDetails, error) {
+	if boltz.DisablePartialSignatures {
+		return nil, errors.New("partial signatures are disabled")
 	}
+	var response ChainSwapSigningDetails
+	err := boltz.sendSwapGetRequest(swapId, fmt.Sprintf("/v2/swap/chain/%s/claim", swapId), &response)
 
-	if err := unmarshalJson(res.Body, &response); err != nil {
-		return fmt.Errorf("could not parse boltz response with status %d: %v", res.StatusCode, err)
+	if response.Error != "" {
+		return nil, Error(errors.New(response.Error))
 	}
-	return nil
+
+	return &response, err
+}
+
+func (boltz *Boltz) ExchangeChainSwapClaimSignature(swapId string, request ChainSwapSigningRequest) (*PartialSignature, error) {
+	if boltz.DisablePartialSignatures {
+		return nil, errors.New("partial signatures are disabled")
+	}
+	var response PartialSignature
+	err := boltz.sendSwapPostRequest(swapId, fmt.Sprintf("/v2/swap/chain/%s/claim", swapId), request, &response)
+
+	if response.Error != "" {
+		return nil, Error(errors.New(response.Error))
+	}
+
+	return &response, err
+}
+
+func (boltz *Boltz) SendChainSwapClaimSignature(swapId string, signature *PartialSignature) error {
+	var response ErrorMessage
+	err := boltz.sendSwapPostRequest(swapId, fmt.Sprintf("/v2/swap/chain/%s/claim", swapId), ChainSwapSigningRequest{
+		Signature: signature,
+	}, &response)
+
+	if response.Error != "" {
+		return Error(errors.New(response.Error))
+	}
+
+	return err
+}
+
+func (boltz *Boltz) RefundChainSwap(swapId string, request RefundChainSwapRequest) (*PartialSignature, error) {
+	if boltz.DisablePartialSignatures {
+		return nil, errors.New("partial signatures are disabled")
+	}
+	var response PartialSignature
+	err := boltz.sendSwapPostRequest(swapId, fmt.Sprintf("/v2/swap/chain/%s/refund", swapId), request, &response)
+
+	if response.Error != "" {
+		return nil, Error(errors.New(response.Error))
+	}
+
+	return &response, err
 }
 
 func unmarshalJson(body io.ReadCloser, response interface{}) error {
@@ -3,6 +3,7 @@ package boltz
 // Block times in minutes
 const BitcoinBlockTime = float64(10)
 const LiquidBlockTime = float64(1)
+const RootstockBlockTime = float64(0.5)
 
 func GetBlockTime(currency Currency) float64 {
 	switch currency {
@@ -10,6 +11,8 @@ func GetBlockTime(currency Currency) float64 {
 		return BitcoinBlockTime
 	case CurrencyLiquid:
 		return LiquidBlockTime
+	case CurrencyRootstock:
+		return RootstockBlockTime
 	default:
 		return 0
 	}
@@ -8,6 +8,7 @@ import (
 func TestGetBlockTime(t *testing.T) {
 	assert.Equal(t, float64(10), GetBlockTime(CurrencyBtc))
 	assert.Equal(t, float64(1), GetBlockTime(CurrencyLiquid))
+	assert.Equal(t, 0.5, GetBlockTime(CurrencyRootstock))
 
 	// Should return 0 when the symbol cannot be found
 	assert.Equal(t, float64(0), GetBlockTime(""))
@@ -0,0 +1,220 @@
+package boltz
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/logger"
+	"github.com/BoltzExchange/boltz-client/proxy"
+)
+
+// requests to Boltz which take longer than this are aborted
+const requestTimeout = 30 * time.Second
+
+var defaultClient = &http.Client{Timeout: requestTimeout}
+
+// EndpointResolver returns the endpoint a swap was created with, or an empty string if it is not known
+type EndpointResolver func(swapId string) string
+
+// Endpoints returns all configured endpoints of the Boltz API in order of preference
+func (boltz *Boltz) Endpoints() []string {
+	return append([]string{boltz.URL}, boltz.FallbackURLs...)
+}
+
+// CurrentEndpoint returns the endpoint which is used for requests that are not specific to a swap
+func (boltz *Boltz) CurrentEndpoint() string {
+	boltz.endpointLock.RLock()
+	defer boltz.endpointLock.RUnlock()
+
+	if boltz.current == "" {
+		return boltz.URL
+	}
+	return boltz.current
+}
+
+func (boltz *Boltz) setCurrentEndpoint(endpoint string) {
+	boltz.endpointLock.Lock()
+	defer boltz.endpointLock.Unlock()
+
+	if endpoint != boltz.current && (boltz.current != "" || endpoint != boltz.URL) {
+		logger.Infof("Switching to Boltz endpoint %s", endpoint)
+	}
+	boltz.current = endpoint
+}
+
+// SetEndpointResolver makes requests about existing swaps go to the endpoint the swap was created with
+func (boltz *Boltz) SetEndpointResolver(resolver EndpointResolver) {
+	boltz.endpointLock.Lock()
+	defer boltz.endpointLock.Unlock()
+
+	boltz.resolver = resolver
+}
+
+func (boltz *Boltz) swapEndpoint(swapId string) string {
+	boltz.endpointLock.RLock()
+	resolver := boltz.resolver
+	boltz.endpointLock.RUnlock()
+
+	if resolver == nil {
+		return ""
+	}
+	return resolver(swapId)
+}
+
+// CheckHealth switches to the most preferred endpoint which responds to version requests
+func (boltz *Boltz) CheckHealth() error {
+	for _, endpoint := range boltz.Endpoints() {
+		var response GetVersionResponse
+		if err := boltz.getRequest(endpoint+"/version", &response); err != nil {
+			logger.Warnf("Boltz endpoint %s is not healthy: %v", endpoint, err)
+			continue
+		}
+		boltz.setCurrentEndpoint(endpoint)
+		return nil
+	}
+	return errors.New("none of the Boltz endpoints is healthy")
+}
+
+// MonitorHealth checks the health of the endpoints in the given interval until stop receives a value
+func (boltz *Boltz) MonitorHealth(interval time.Duration, stop <-chan bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := boltz.CheckHealth(); err != nil {
+				logger.Error(err.Error())
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+func isConnectionError(err error) bool {
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// isDialError returns whether the request failed before a connection to the endpoint was established,
+// which means that the endpoint did not receive it
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		return false
+	}
+	// connections through a SOCKS5 proxy fail with "socks connect" when the proxy could not reach the endpoint
+	return opErr.Op == "dial" || opErr.Op == "socks connect"
+}
+
+// withFailover sends a request to the current endpoint and, if it can not be reached, to the other ones in order of
+// preference. The first endpoint that could be reached becomes the current one and is returned.
+// shouldFailover decides whether an error means that the request can be sent to another endpoint.
+func (boltz *Boltz) withFailover(send func(endpoint string) error, shouldFailover func(err error) bool) (string, error) {
+	current := boltz.CurrentEndpoint()
+	err := send(current)
+	if err == nil || !shouldFailover(err) {
+		return current, err
+	}
+
+	for _, endpoint := range boltz.Endpoints() {
+		if endpoint == current {
+			continue
+		}
+		logger.Warnf("Could not reach Boltz endpoint %s, trying %s: %v", current, endpoint, err)
+		if fallbackErr := send(endpoint); fallbackErr == nil || !shouldFailover(fallbackErr) {
+			boltz.setCurrentEndpoint(endpoint)
+			return endpoint, fallbackErr
+		}
+	}
+
+	return current, err
+}
+
+func (boltz *Boltz) sendGetRequest(path string, response interface{}) error {
+	_, err := boltz.withFailover(func(endpoint string) error {
+		return boltz.getRequest(endpoint+path, response)
+	}, isConnectionError)
+	return err
+}
+
+func (boltz *Boltz) sendPostRequest(path string, requestBody interface{}, response interface{}) error {
+	_, err := boltz.withFailover(func(endpoint string) error {
+		return boltz.postRequest(endpoint+path, requestBody, response)
+	}, isConnectionError)
+	return err
+}
+
+// sendCreateRequest creates a swap and returns the endpoint which the swap is pinned to.
+// It only fails over when the endpoint did not receive the request, since the swap might have been created otherwise.
+func (boltz *Boltz) sendCreateRequest(path string, requestBody interface{}, response interface{}) (string, error) {
+	return boltz.withFailover(func(endpoint string) error {
+		return boltz.postRequest(endpoint+path, requestBody, response)
+	}, isDialError)
+}
+
+func (boltz *Boltz) sendSwapGetRequest(swapId string, path string, response interface{}) error {
+	if endpoint := boltz.swapEndpoint(swapId); endpoint != "" {
+		return boltz.getRequest(endpoint+path, response)
+	}
+	return boltz.sendGetRequest(path, response)
+}
+
+func (boltz *Boltz) sendSwapPostRequest(swapId string, path string, requestBody interface{}, response interface{}) error {
+	if endpoint := boltz.swapEndpoint(swapId); endpoint != "" {
+		return boltz.postRequest(endpoint+path, requestBody, response)
+	}
+	return boltz.sendPostRequest(path, requestBody, response)
+}
+
+// SetProxy routes all requests and the websocket connection through the given proxy
+func (boltz *Boltz) SetProxy(p *proxy.Proxy) {
+	boltz.proxy = p
+	// a copy, since the client without a proxy is the default one of the http package
+	client := *p.HTTPClient("boltz")
+	client.Timeout = requestTimeout
+	boltz.client = &client
+}
+
+func (boltz *Boltz) httpClient() *http.Client {
+	if boltz.client == nil {
+		return defaultClient
+	}
+	return boltz.client
+}
+
+func (boltz *Boltz) getRequest(requestUrl string, response interface{}) error {
+	res, err := boltz.httpClient().Get(requestUrl)
+
+	if err != nil {
+		return err
+	}
+
+	return unmarshalJson(res.Body, &response)
+}
+
+func (boltz *Boltz) postRequest(requestUrl string, requestBody interface{}, response interface{}) error {
+	rawBody, err := json.Marshal(requestBody)
+
+	if err != nil {
+		return err
+	}
+
+	res, err := boltz.httpClient().Post(requestUrl, "application/json", bytes.NewBuffer(rawBody))
+
+	if err != nil {
+		return err
+	}
+
+	if err := unmarshalJson(res.Body, &response); err != nil {
+		return fmt.Errorf("could not parse boltz response with status %d: %v", res.StatusCode, err)
+	}
+	return nil
+}
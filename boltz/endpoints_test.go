@@ -0,0 +1,119 @@
+package boltz
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newVersionServer(t *testing.T, version string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"version":"` + version + `"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestFailover(t *testing.T) {
+	down := newVersionServer(t, "")
+	down.Close()
+	fallback := newVersionServer(t, "fallback")
+
+	api := &Boltz{URL: down.URL, FallbackURLs: []string{fallback.URL}}
+
+	version, err := api.GetVersion()
+	require.NoError(t, err)
+	require.Equal(t, "fallback", version.Version)
+	require.Equal(t, fallback.URL, api.CurrentEndpoint())
+
+	// requests about a swap only go to the endpoint it is pinned to
+	api.SetEndpointResolver(func(swapId string) string {
+		return down.URL
+	})
+	var response GetVersionResponse
+	require.Error(t, api.sendSwapGetRequest("swap", "/version", &response))
+}
+
+func TestCheckHealth(t *testing.T) {
+	primary := newVersionServer(t, "primary")
+	fallback := newVersionServer(t, "fallback")
+
+	api := &Boltz{URL: primary.URL, FallbackURLs: []string{fallback.URL}}
+	api.setCurrentEndpoint(fallback.URL)
+
+	require.NoError(t, api.CheckHealth())
+	require.Equal(t, primary.URL, api.CurrentEndpoint())
+
+	primary.Close()
+	fallback.Close()
+	require.Error(t, api.CheckHealth())
+}
+
+func TestCreateFailover(t *testing.T) {
+	down := newVersionServer(t, "")
+	down.Close()
+	fallback := newVersionServer(t, "fallback")
+	// receives the request, but closes the connection before answering
+	hangup := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, _, err := w.(http.Hijacker).Hijack()
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+	}))
+	t.Cleanup(hangup.Close)
+
+	var response GetVersionResponse
+
+	api := &Boltz{URL: down.URL, FallbackURLs: []string{fallback.URL}}
+	endpoint, err := api.sendCreateRequest("/version", nil, &response)
+	require.NoError(t, err)
+	require.Equal(t, fallback.URL, endpoint)
+
+	// the swap might have been created already, so it must not be created with another endpoint
+	api = &Boltz{URL: hangup.URL, FallbackURLs: []string{fallback.URL}}
+	endpoint, err = api.sendCreateRequest("/version", nil, &response)
+	require.Error(t, err)
+	require.Equal(t, hangup.URL, endpoint)
+	require.Equal(t, hangup.URL, api.CurrentEndpoint())
+
+	// other requests can be sent again
+	require.NoError(t, api.sendPostRequest("/version", nil, &response))
+	require.Equal(t, fallback.URL, api.CurrentEndpoint())
+}
+
+func TestMonitorHealth(t *testing.T) {
+	primary := newVersionServer(t, "primary")
+	fallback := newVersionServer(t, "fallback")
+
+	api := &Boltz{URL: primary.URL, FallbackURLs: []string{fallback.URL}}
+	api.setCurrentEndpoint(fallback.URL)
+
+	stop := make(chan bool)
+	stopped := make(chan struct{})
+	go func() {
+		api.MonitorHealth(10*time.Millisecond, stop)
+		close(stopped)
+	}()
+
+	require.Eventually(t, func() bool {
+		return api.CurrentEndpoint() == primary.URL
+	}, 5*time.Second, 10*time.Millisecond)
+
+	stop <- true
+	select {
+	case <-stopped:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "health monitor did not stop")
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	api := &Boltz{}
+	require.Equal(t, requestTimeout, api.httpClient().Timeout)
+
+	api.SetProxy(nil)
+	require.Equal(t, requestTimeout, api.httpClient().Timeout)
+	require.Zero(t, http.DefaultClient.Timeout)
+}
@@ -21,6 +21,9 @@ const (
 	TransactionConfirmed
 	TransactionLockupFailed
 	TransactionClaimPending
+
+	TransactionServerMempool
+	TransactionServerConfirmed
 )
 
 var swapUpdateEventStrings = map[string]SwapUpdateEvent{
@@ -42,6 +45,9 @@ var swapUpdateEventStrings = map[string]SwapUpdateEvent{
 	"transaction.confirmed":     TransactionConfirmed,
 	"transaction.lockupFailed":  TransactionLockupFailed,
 	"transaction.claim.pending": TransactionClaimPending,
+
+	"transaction.server.mempool":   TransactionServerMempool,
+	"transaction.server.confirmed": TransactionServerConfirmed,
 }
 
 var CompletedStatus = []string{
@@ -0,0 +1,8 @@
+package boltz
+
+import "time"
+
+func init() {
+	// the tests of the websocket do not wait for the production interval
+	reconnectInterval = 50 * time.Millisecond
+}
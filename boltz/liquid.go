@@ -70,6 +70,20 @@ func (transaction *LiquidTransaction) FindVout(network *Network, addressToFind s
 
 }
 
+// findOutputIndex looks for an output paying to addressToFind without unblinding it
+func (transaction *LiquidTransaction) findOutputIndex(addressToFind string) (uint32, error) {
+	script, err := address.ToOutputScript(addressToFind)
+	if err != nil {
+		return 0, err
+	}
+	for vout, output := range transaction.Outputs {
+		if bytes.Equal(script, output.Script) {
+			return uint32(vout), nil
+		}
+	}
+	return 0, errors.New("could not find output")
+}
+
 func (transaction *LiquidTransaction) VSize() uint64 {
 	witnessSize := transaction.SerializeSize(true, true) - transaction.SerializeSize(false, true)
 	return uint64(transaction.SerializeSize(false, true)) + uint64(math.Ceil(float64(witnessSize)/4))
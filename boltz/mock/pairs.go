@@ -0,0 +1,216 @@
+package mock
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+)
+
+// Fees are charged for all swaps of the server
+type Fees struct {
+	Percentage float64
+	// miner fees of every transaction of boltz, and the ones the client is expected to pay
+	MinerFees uint64
+}
+
+// Limits apply to the amounts the client sends
+type Limits struct {
+	Minimal  uint64
+	Maximal  uint64
+	ZeroConf uint64
+}
+
+const defaultBlockHeight = 1000
+
+var defaultFees = Fees{
+	Percentage: 0.1,
+	MinerFees:  500,
+}
+
+var defaultLimits = Limits{
+	Minimal:  10000,
+	Maximal:  10000000,
+	ZeroConf: 1000000,
+}
+
+var timeoutDeltas = map[boltz.Currency]uint32{
+	boltz.CurrencyBtc:    144,
+	boltz.CurrencyLiquid: 1440,
+}
+
+func pairHash(pair any) string {
+	encoded, _ := json.Marshal(pair)
+	hash := sha256.Sum256(encoded)
+	return hex.EncodeToString(hash[:])
+}
+
+func percentageFee(amount uint64, percentage float64) uint64 {
+	return uint64(math.Ceil(float64(amount) * percentage / 100))
+}
+
+// SetFees changes the fees of all pairs, which also changes their hashes
+func (server *Server) SetFees(fees Fees) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.fees = fees
+}
+
+// SetLimits changes the limits of all pairs, which also changes their hashes
+func (server *Server) SetLimits(limits Limits) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.limits = limits
+}
+
+func (server *Server) checkLimits(amount uint64) error {
+	if amount < server.limits.Minimal {
+		return fmt.Errorf("%d is less than minimal of %d", amount, server.limits.Minimal)
+	}
+	if amount > server.limits.Maximal {
+		return fmt.Errorf("%d is more than maximal of %d", amount, server.limits.Maximal)
+	}
+	return nil
+}
+
+func checkPairHash(expected string, actual string) error {
+	if expected != "" && expected != actual {
+		return errors.New("invalid pair hash")
+	}
+	return nil
+}
+
+func (server *Server) submarinePair(from boltz.Currency, to boltz.Currency) (pair boltz.SubmarinePair, err error) {
+	if to != boltz.CurrencyBtc || timeoutDeltas[from] == 0 {
+		return pair, fmt.Errorf("could not find pair %s/%s", from, to)
+	}
+	pair.Rate = 1
+	pair.Limits.Minimal = server.limits.Minimal
+	pair.Limits.Maximal = server.limits.Maximal
+	pair.Limits.MaximalZeroConfAmount = server.limits.ZeroConf
+	pair.Fees.Percentage = server.fees.Percentage
+	pair.Fees.MinerFees = server.fees.MinerFees
+	pair.Hash = pairHash(pair)
+	return pair, nil
+}
+
+func (server *Server) reversePair(from boltz.Currency, to boltz.Currency) (pair boltz.ReversePair, err error) {
+	if from != boltz.CurrencyBtc || timeoutDeltas[to] == 0 {
+		return pair, fmt.Errorf("could not find pair %s/%s", from, to)
+	}
+	pair.Rate = 1
+	pair.Limits.Minimal = server.limits.Minimal
+	pair.Limits.Maximal = server.limits.Maximal
+	pair.Fees.Percentage = server.fees.Percentage
+	pair.Fees.MinerFees.Lockup = server.fees.MinerFees
+	pair.Fees.MinerFees.Claim = server.fees.MinerFees
+	pair.Hash = pairHash(pair)
+	return pair, nil
+}
+
+func (server *Server) chainPair(from boltz.Currency, to boltz.Currency) (pair boltz.ChainPair, err error) {
+	if from == to || timeoutDeltas[from] == 0 || timeoutDeltas[to] == 0 {
+		return pair, fmt.Errorf("could not find pair %s/%s", from, to)
+	}
+	pair.Rate = 1
+	pair.Limits.Minimal = server.limits.Minimal
+	pair.Limits.Maximal = server.limits.Maximal
+	pair.Limits.MaximalZeroConfAmount = server.limits.ZeroConf
+	pair.Fees.Percentage = server.fees.Percentage
+	pair.Fees.MinerFees.Server = server.fees.MinerFees
+	pair.Fees.MinerFees.User.Claim = server.fees.MinerFees
+	pair.Fees.MinerFees.User.Lockup = server.fees.MinerFees
+	pair.Hash = pairHash(pair)
+	return pair, nil
+}
+
+func (server *Server) getSubmarinePairs(*http.Request, []string) (any, error) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	pairs := boltz.SubmarinePairs{}
+	for from := range timeoutDeltas {
+		pair, _ := server.submarinePair(from, boltz.CurrencyBtc)
+		pairs[from] = map[boltz.Currency]boltz.SubmarinePair{boltz.CurrencyBtc: pair}
+	}
+	return pairs, nil
+}
+
+func (server *Server) getReversePairs(*http.Request, []string) (any, error) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	pairs := boltz.ReversePairs{boltz.CurrencyBtc: {}}
+	for to := range timeoutDeltas {
+		pairs[boltz.CurrencyBtc][to], _ = server.reversePair(boltz.CurrencyBtc, to)
+	}
+	return pairs, nil
+}
+
+func (server *Server) getChainPairs(*http.Request, []string) (any, error) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	pairs := boltz.ChainPairs{}
+	for from := range timeoutDeltas {
+		pairs[from] = map[boltz.Currency]boltz.ChainPair{}
+		for to := range timeoutDeltas {
+			if pair, err := server.chainPair(from, to); err == nil {
+				pairs[from][to] = pair
+			}
+		}
+	}
+	return pairs, nil
+}
+
+type legacyMinerFees struct {
+	Normal  uint64 `json:"normal"`
+	Reverse struct {
+		Lockup uint64 `json:"lockup"`
+		Claim  uint64 `json:"claim"`
+	} `json:"reverse"`
+}
+
+type legacyPair struct {
+	Rate   float64 `json:"rate"`
+	Limits struct {
+		Maximal uint64 `json:"maximal"`
+		Minimal uint64 `json:"minimal"`
+	} `json:"limits"`
+	Fees struct {
+		Percentage float64 `json:"percentage"`
+		MinerFees  struct {
+			BaseAsset  legacyMinerFees `json:"baseAsset"`
+			QuoteAsset legacyMinerFees `json:"quoteAsset"`
+		} `json:"minerFees"`
+	} `json:"fees"`
+}
+
+func (server *Server) getLegacyPairs(*http.Request, []string) (any, error) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	var minerFees legacyMinerFees
+	minerFees.Normal = server.fees.MinerFees
+	minerFees.Reverse.Lockup = server.fees.MinerFees
+	minerFees.Reverse.Claim = server.fees.MinerFees
+
+	var pair legacyPair
+	pair.Rate = 1
+	pair.Limits.Minimal = server.limits.Minimal
+	pair.Limits.Maximal = server.limits.Maximal
+	pair.Fees.Percentage = server.fees.Percentage
+	pair.Fees.MinerFees.BaseAsset = minerFees
+	pair.Fees.MinerFees.QuoteAsset = minerFees
+
+	pairs := make(map[string]legacyPair)
+	for currency := range timeoutDeltas {
+		pairs[string(currency)+"/"+string(boltz.CurrencyBtc)] = pair
+	}
+	return map[string]any{"warnings": []string{}, "pairs": pairs}, nil
+}
@@ -0,0 +1,206 @@
+package mock
+
+import (
+	"crypto/sha256"
+	"errors"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const invoiceExpiry = time.Hour
+
+// NewInvoice creates an invoice of the lightning node of the server, which tests can also use as the invoice of submarine swaps
+func (server *Server) NewInvoice(preimageHash []byte, amount uint64) (string, error) {
+	if len(preimageHash) != sha256.Size {
+		return "", errors.New("invalid preimage hash")
+	}
+	invoice, err := zpay32.NewInvoice(
+		server.network.Btc,
+		[32]byte(preimageHash),
+		time.Now(),
+		zpay32.Amount(lnwire.NewMSatFromSatoshis(btcutil.Amount(amount))),
+		zpay32.Description("Send to BTC address"),
+		zpay32.Expiry(invoiceExpiry),
+	)
+	if err != nil {
+		return "", err
+	}
+	return invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(msg []byte) ([]byte, error) {
+			return ecdsa.SignCompact(server.nodeKey, chainhash.HashB(msg), true)
+		},
+	})
+}
+
+// OnCreate registers a function which is called for every swap that is created, for example to script its updates.
+// It is called in a separate goroutine, so that it can call the other methods of the server.
+func (server *Server) OnCreate(onCreate func(id string, swapType boltz.SwapType)) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.onCreate = onCreate
+}
+
+// RefuseCosigning makes the server refuse to sign cooperative claims and refunds of the client
+func (server *Server) RefuseCosigning(refuse bool) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.refuseCosigning = refuse
+}
+
+// SetBlockHeight changes the block height which the timeouts of new swaps are based on
+func (server *Server) SetBlockHeight(currency boltz.Currency, height uint32) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	server.blockHeights[currency] = height
+}
+
+// Status returns the current status of a swap
+func (server *Server) Status(id string) (string, error) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	swap, err := server.getSwap(id)
+	if err != nil {
+		return "", err
+	}
+	return swap.status.Status, nil
+}
+
+// Swaps returns the ids of all swaps of the given type
+func (server *Server) Swaps(swapType boltz.SwapType) []string {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	var ids []string
+	for id, swap := range server.swaps {
+		if swap.swapType == swapType {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// update changes a swap and sends the new status to the websocket clients which are subscribed to it
+func (server *Server) update(id string, change func(swap *swap) error) error {
+	server.lock.Lock()
+	swap, err := server.getSwap(id)
+	if err == nil {
+		err = change(swap)
+	}
+	server.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	server.notify(id)
+	return nil
+}
+
+// SetStatus sets an arbitrary status of a swap
+func (server *Server) SetStatus(id string, status boltz.SwapUpdateEvent) error {
+	return server.update(id, func(swap *swap) error {
+		swap.setStatus(status, "", "")
+		return nil
+	})
+}
+
+// Expire lets a swap time out
+func (server *Server) Expire(id string) error {
+	return server.SetStatus(id, boltz.SwapExpired)
+}
+
+// FailLockup rejects the lockup of the client; for reverse swaps, the lockup of boltz fails instead
+func (server *Server) FailLockup(id string) error {
+	return server.update(id, func(swap *swap) error {
+		if swap.swapType == boltz.ReverseSwap {
+			swap.setStatus(boltz.TransactionFailed, "", "")
+			return nil
+		}
+		lockup := swap.userLockup
+		swap.setStatus(boltz.TransactionLockupFailed, lockup.transactionId, lockup.transactionHex)
+		return nil
+	})
+}
+
+// Lockup creates the lockup transaction of boltz of a reverse or chain swap and returns its id.
+// Only lockups on the bitcoin chain are supported.
+func (server *Server) Lockup(id string) (string, error) {
+	var transactionId string
+	err := server.update(id, func(swap *swap) error {
+		lockup := swap.serverLockup
+		if lockup == nil {
+			return errors.New("boltz does not lock up coins in submarine swaps")
+		}
+		if lockup.transactionId != "" {
+			return errors.New("boltz locked up coins already")
+		}
+		var transactionHex string
+		var err error
+		transactionId, transactionHex, err = newLockupTransaction(lockup, lockup.amount)
+		if err != nil {
+			return err
+		}
+		lockup.transactionId = transactionId
+		lockup.transactionHex = transactionHex
+		lockup.lockedAmount = lockup.amount
+		server.transactions[lockup.currency][transactionId] = transactionHex
+
+		status := boltz.TransactionMempool
+		if swap.swapType == boltz.ChainSwap {
+			status = boltz.TransactionServerMempool
+		}
+		swap.setStatus(status, transactionId, transactionHex)
+		return nil
+	})
+	return transactionId, err
+}
+
+// Confirm confirms the latest lockup transaction of a swap
+func (server *Server) Confirm(id string) error {
+	return server.update(id, func(swap *swap) error {
+		if lockup := swap.serverLockup; lockup != nil && lockup.transactionId != "" {
+			status := boltz.TransactionConfirmed
+			if swap.swapType == boltz.ChainSwap {
+				status = boltz.TransactionServerConfirmed
+			}
+			swap.setStatus(status, lockup.transactionId, lockup.transactionHex)
+			return nil
+		}
+		if lockup := swap.userLockup; lockup != nil && lockup.transactionId != "" {
+			swap.setStatus(boltz.TransactionConfirmed, lockup.transactionId, lockup.transactionHex)
+			return nil
+		}
+		return errors.New("no lockup transaction found")
+	})
+}
+
+// PayInvoice pays the invoice of a submarine swap and asks the client to sign the claim of its lockup cooperatively
+func (server *Server) PayInvoice(id string, preimage []byte) error {
+	return server.update(id, func(swap *swap) error {
+		if swap.swapType != boltz.NormalSwap {
+			return errors.New("only invoices of submarine swaps can be paid")
+		}
+		preimageHash := sha256.Sum256(preimage)
+		if string(preimageHash[:]) != string(swap.preimageHash) {
+			return errors.New("invalid preimage")
+		}
+		if swap.userLockup.transactionId == "" {
+			return errors.New("no lockup transaction found")
+		}
+
+		claim, err := newClaimSession(swap.userLockup.tree)
+		if err != nil {
+			return err
+		}
+		swap.preimage = preimage
+		swap.claim = claim
+		swap.setStatus(boltz.TransactionClaimPending, "", "")
+		return nil
+	})
+}
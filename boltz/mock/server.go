@@ -0,0 +1,212 @@
+// Package mock implements the parts of the Boltz API which are used by the client, so that swaps can be tested
+// in-process without a regtest setup.
+//
+// Swaps are created with fresh keys of the server and follow the state they are moved to by the test, for example
+// with Lockup, PayInvoice, Expire or FailLockup. Transactions are only checked against the swaps the server knows,
+// lockups of the server are never spendable on a real chain.
+package mock
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/gorilla/websocket"
+)
+
+// Version is the version of the Boltz backend the server reports
+const Version = "3.7.0"
+
+type handler func(r *http.Request, args []string) (any, error)
+
+type route struct {
+	method  string
+	pattern []string
+	handle  handler
+}
+
+// Server is an in-process Boltz backend
+type Server struct {
+	network    *boltz.Network
+	httpServer *httptest.Server
+	routes     []route
+	upgrader   websocket.Upgrader
+
+	nodeKey *btcec.PrivateKey
+
+	lock            sync.Mutex
+	fees            Fees
+	limits          Limits
+	blockHeights    map[boltz.Currency]uint32
+	swaps           map[string]*swap
+	transactions    map[boltz.Currency]map[string]string
+	refuseCosigning bool
+	onCreate        func(id string, swapType boltz.SwapType)
+
+	wsLock    sync.Mutex
+	wsClients map[*wsClient]bool
+}
+
+// NewServer starts a server on a local port for swaps on the given network
+func NewServer(network *boltz.Network) (*Server, error) {
+	nodeKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	server := &Server{
+		network: network,
+		nodeKey: nodeKey,
+		fees:    defaultFees,
+		limits:  defaultLimits,
+		blockHeights: map[boltz.Currency]uint32{
+			boltz.CurrencyBtc:    defaultBlockHeight,
+			boltz.CurrencyLiquid: defaultBlockHeight,
+		},
+		swaps: make(map[string]*swap),
+		transactions: map[boltz.Currency]map[string]string{
+			boltz.CurrencyBtc:    make(map[string]string),
+			boltz.CurrencyLiquid: make(map[string]string),
+		},
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(*http.Request) bool { return true },
+		},
+		wsClients: make(map[*wsClient]bool),
+	}
+	server.routes = []route{
+		{http.MethodGet, []string{"version"}, server.getVersion},
+		{http.MethodGet, []string{"getpairs"}, server.getLegacyPairs},
+		{http.MethodGet, []string{"getfeeestimation"}, server.getFeeEstimation},
+		{http.MethodPost, []string{"getswaptransaction"}, server.getSwapTransaction},
+		{http.MethodGet, []string{"v2", "nodes"}, server.getNodes},
+		{http.MethodGet, []string{"v2", "chain", "*", "contracts"}, server.getContracts},
+		{http.MethodGet, []string{"v2", "chain", "*", "transaction", "*"}, server.getTransaction},
+		{http.MethodPost, []string{"v2", "chain", "*", "transaction"}, server.broadcastTransaction},
+
+		{http.MethodGet, []string{"v2", "swap", "submarine"}, server.getSubmarinePairs},
+		{http.MethodPost, []string{"v2", "swap", "submarine"}, server.createSwap},
+		{http.MethodPost, []string{"v2", "swap", "submarine", "refund"}, server.refundSwap},
+		{http.MethodGet, []string{"v2", "swap", "submarine", "*", "invoice", "amount"}, server.getInvoiceAmount},
+		{http.MethodPost, []string{"v2", "swap", "submarine", "*", "invoice"}, server.setInvoice},
+		{http.MethodGet, []string{"v2", "swap", "submarine", "*", "claim"}, server.getSwapClaimDetails},
+		{http.MethodPost, []string{"v2", "swap", "submarine", "*", "claim"}, server.sendSwapClaimSignature},
+
+		{http.MethodGet, []string{"v2", "swap", "reverse"}, server.getReversePairs},
+		{http.MethodPost, []string{"v2", "swap", "reverse"}, server.createReverseSwap},
+		{http.MethodPost, []string{"v2", "swap", "reverse", "claim"}, server.claimReverseSwap},
+
+		{http.MethodGet, []string{"v2", "swap", "chain"}, server.getChainPairs},
+		{http.MethodPost, []string{"v2", "swap", "chain"}, server.createChainSwap},
+		{http.MethodGet, []string{"v2", "swap", "chain", "*", "transactions"}, server.getChainSwapTransactions},
+		{http.MethodGet, []string{"v2", "swap", "chain", "*", "claim"}, server.getChainSwapClaimDetails},
+		{http.MethodPost, []string{"v2", "swap", "chain", "*", "claim"}, server.claimChainSwap},
+		{http.MethodPost, []string{"v2", "swap", "chain", "*", "refund"}, server.refundChainSwap},
+
+		{http.MethodGet, []string{"v2", "swap", "*"}, server.getSwapStatus},
+	}
+	server.httpServer = httptest.NewServer(server)
+	return server, nil
+}
+
+// URL returns the endpoint of the server which can be used as URL of boltz.Boltz
+func (server *Server) URL() string {
+	return server.httpServer.URL
+}
+
+// Client returns a client of the API of the server
+func (server *Server) Client() *boltz.Boltz {
+	return &boltz.Boltz{URL: server.URL()}
+}
+
+func (server *Server) Close() {
+	server.DisconnectWebsockets()
+	server.httpServer.Close()
+}
+
+// match returns the path segments of the wildcards in pattern, or false if the path does not match
+func match(path []string, pattern []string) ([]string, bool) {
+	if len(path) != len(pattern) {
+		return nil, false
+	}
+	var args []string
+	for i, segment := range pattern {
+		if segment == "*" {
+			args = append(args, path[i])
+		} else if segment != path[i] {
+			return nil, false
+		}
+	}
+	return args, true
+}
+
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if _, ok := match(path, []string{"v2", "ws"}); ok {
+		server.handleWebsocket(w, r)
+		return
+	}
+
+	for _, route := range server.routes {
+		if route.method != r.Method {
+			continue
+		}
+		if args, ok := match(path, route.pattern); ok {
+			response, err := route.handle(r, args)
+			if err != nil {
+				writeJson(w, http.StatusBadRequest, boltz.ErrorMessage{Error: err.Error()})
+				return
+			}
+			writeJson(w, http.StatusOK, response)
+			return
+		}
+	}
+	writeJson(w, http.StatusNotFound, boltz.ErrorMessage{Error: "not found"})
+}
+
+func writeJson(w http.ResponseWriter, status int, response any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func decodeRequest(r *http.Request, request any) error {
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+		return errors.New("invalid request: " + err.Error())
+	}
+	return nil
+}
+
+func newSwapId() string {
+	id := make([]byte, 6)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+func (server *Server) getVersion(*http.Request, []string) (any, error) {
+	return boltz.GetVersionResponse{Version: Version}, nil
+}
+
+func (server *Server) getNodes(*http.Request, []string) (any, error) {
+	return boltz.Nodes{
+		string(boltz.CurrencyBtc): {
+			"LND": {PublicKey: hex.EncodeToString(server.nodeKey.PubKey().SerializeCompressed())},
+		},
+	}, nil
+}
+
+func (server *Server) getContracts(_ *http.Request, args []string) (any, error) {
+	return nil, errors.New("no contracts for currency " + args[0])
+}
+
+func (server *Server) getFeeEstimation(*http.Request, []string) (any, error) {
+	return map[string]uint64{
+		string(boltz.CurrencyBtc):    2,
+		string(boltz.CurrencyLiquid): 1,
+	}, nil
+}
@@ -0,0 +1,389 @@
+package mock
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+var network = boltz.Regtest
+
+func newServer(t *testing.T) *Server {
+	server, err := NewServer(network)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newKey(t *testing.T) *btcec.PrivateKey {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	return key
+}
+
+func newPreimage(t *testing.T) ([]byte, []byte) {
+	preimage := make([]byte, 32)
+	_, err := rand.Read(preimage)
+	require.NoError(t, err)
+	hash := sha256.Sum256(preimage)
+	return preimage, hash[:]
+}
+
+func newAddress(t *testing.T) string {
+	address, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(newKey(t).PubKey().SerializeCompressed()), network.Btc)
+	require.NoError(t, err)
+	return address.EncodeAddress()
+}
+
+// payTo creates a transaction from a made up input to address
+func payTo(t *testing.T, address string, amount uint64) string {
+	decoded, err := btcutil.DecodeAddress(address, network.Btc)
+	require.NoError(t, err)
+	script, err := txscript.PayToAddrScript(decoded)
+	require.NoError(t, err)
+
+	var funding chainhash.Hash
+	_, err = rand.Read(funding[:])
+	require.NoError(t, err)
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&funding, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(int64(amount), script))
+
+	var serialized bytes.Buffer
+	require.NoError(t, tx.Serialize(&serialized))
+	return hex.EncodeToString(serialized.Bytes())
+}
+
+// spend creates a cooperatively signed transaction of the output and checks that it is valid
+func spend(t *testing.T, client *boltz.Boltz, output boltz.OutputDetails) error {
+	output.Address = newAddress(t)
+	output.Cooperative = true
+	transaction, _, err := boltz.ConstructTransaction(network, boltz.CurrencyBtc, []boltz.OutputDetails{output}, 1, client)
+	if err != nil {
+		return err
+	}
+
+	tx := transaction.(*boltz.BtcTransaction).MsgTx()
+	prevOut := output.LockupTransaction.(*boltz.BtcTransaction).MsgTx().TxOut[output.Vout]
+	fetcher := txscript.NewCannedPrevOutputFetcher(prevOut.PkScript, prevOut.Value)
+	engine, err := txscript.NewEngine(
+		prevOut.PkScript, tx, 0, txscript.StandardVerifyFlags, nil,
+		txscript.NewTxSigHashes(tx, fetcher), prevOut.Value, fetcher,
+	)
+	require.NoError(t, err)
+	require.NoError(t, engine.Execute())
+	return nil
+}
+
+func requireStatus(t *testing.T, client *boltz.Boltz, id string, expected boltz.SwapUpdateEvent) {
+	status, err := client.SwapStatus(id)
+	require.NoError(t, err)
+	require.Equal(t, expected.String(), status.Status)
+}
+
+type submarineSwap struct {
+	*boltz.CreateSwapResponse
+	key      *btcec.PrivateKey
+	preimage []byte
+	tree     *boltz.SwapTree
+}
+
+func createSwap(t *testing.T, server *Server, client *boltz.Boltz) *submarineSwap {
+	key := newKey(t)
+	preimage, preimageHash := newPreimage(t)
+	invoice, err := server.NewInvoice(preimageHash, 100000)
+	require.NoError(t, err)
+
+	response, err := client.CreateSwap(boltz.CreateSwapRequest{
+		From:            boltz.CurrencyBtc,
+		To:              boltz.CurrencyBtc,
+		RefundPublicKey: key.PubKey().SerializeCompressed(),
+		Invoice:         invoice,
+	})
+	require.NoError(t, err)
+	require.Equal(t, server.submarineExpectedAmount(100000), response.ExpectedAmount)
+
+	claimKey, err := btcec.ParsePubKey(response.ClaimPublicKey)
+	require.NoError(t, err)
+	tree := response.SwapTree.Deserialize()
+	require.NoError(t, tree.Init(false, key, claimKey))
+	require.NoError(t, tree.Check(false, response.TimeoutBlockHeight, preimageHash))
+	require.NoError(t, tree.CheckAddress(response.Address, network, nil))
+
+	return &submarineSwap{CreateSwapResponse: response, key: key, preimage: preimage, tree: tree}
+}
+
+func (swap *submarineSwap) lockup(t *testing.T, client *boltz.Boltz, amount uint64) boltz.OutputDetails {
+	lockupHex := payTo(t, swap.Address, amount)
+	_, err := client.BroadcastTransaction(lockupHex, boltz.CurrencyBtc)
+	require.NoError(t, err)
+
+	lockupTx, err := boltz.NewBtcTxFromHex(lockupHex)
+	require.NoError(t, err)
+	return boltz.OutputDetails{
+		LockupTransaction:  lockupTx,
+		PrivateKey:         swap.key,
+		Preimage:           []byte{},
+		TimeoutBlockHeight: swap.TimeoutBlockHeight,
+		SwapTree:           swap.tree,
+		SwapId:             swap.Id,
+		SwapType:           boltz.NormalSwap,
+	}
+}
+
+func TestPairs(t *testing.T) {
+	server := newServer(t)
+	client := server.Client()
+
+	version, err := client.GetVersion()
+	require.NoError(t, err)
+	require.Equal(t, Version, version.Version)
+
+	pairs, err := client.GetSubmarinePairs()
+	require.NoError(t, err)
+	pair := pairs[boltz.CurrencyLiquid][boltz.CurrencyBtc]
+	require.Equal(t, defaultFees.Percentage, pair.Fees.Percentage)
+
+	server.SetFees(Fees{Percentage: 0.5, MinerFees: 1000})
+	pairs, err = client.GetSubmarinePairs()
+	require.NoError(t, err)
+	require.NotEqual(t, pair.Hash, pairs[boltz.CurrencyLiquid][boltz.CurrencyBtc].Hash)
+
+	_, err = client.CreateSwap(boltz.CreateSwapRequest{
+		From:            boltz.CurrencyLiquid,
+		To:              boltz.CurrencyBtc,
+		PairHash:        pair.Hash,
+		RefundPublicKey: newKey(t).PubKey().SerializeCompressed(),
+		PreimageHash:    make([]byte, 32),
+	})
+	require.ErrorIs(t, err, boltz.ErrInvalidPairHash)
+}
+
+func TestScenario(t *testing.T) {
+	server := newServer(t)
+	client := server.Client()
+
+	type created struct {
+		id       string
+		swapType boltz.SwapType
+	}
+	swaps := make(chan created, 1)
+	server.OnCreate(func(id string, swapType boltz.SwapType) {
+		swaps <- created{id: id, swapType: swapType}
+		// the other methods of the server can be used to script the swap
+		require.NoError(t, server.Expire(id))
+	})
+
+	swap := createSwap(t, server, client)
+	select {
+	case created := <-swaps:
+		require.Equal(t, swap.Id, created.id)
+		require.Equal(t, boltz.NormalSwap, created.swapType)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "swap was not created")
+	}
+	require.Eventually(t, func() bool {
+		status, err := server.Status(swap.Id)
+		return err == nil && status == boltz.SwapExpired.String()
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{swap.Id}, server.Swaps(boltz.NormalSwap))
+	require.Empty(t, server.Swaps(boltz.ReverseSwap))
+
+	server.OnCreate(nil)
+	server.SetLimits(Limits{Minimal: 200000, Maximal: 300000})
+	_, preimageHash := newPreimage(t)
+	invoice, err := server.NewInvoice(preimageHash, 100000)
+	require.NoError(t, err)
+	_, err = client.CreateSwap(boltz.CreateSwapRequest{
+		From:            boltz.CurrencyBtc,
+		To:              boltz.CurrencyBtc,
+		RefundPublicKey: newKey(t).PubKey().SerializeCompressed(),
+		Invoice:         invoice,
+	})
+	require.ErrorContains(t, err, "less than minimal")
+}
+
+func TestSubmarineSwap(t *testing.T) {
+	server := newServer(t)
+	client := server.Client()
+
+	swap := createSwap(t, server, client)
+	requireStatus(t, client, swap.Id, boltz.InvoiceSet)
+
+	swap.lockup(t, client, swap.ExpectedAmount)
+	requireStatus(t, client, swap.Id, boltz.TransactionMempool)
+
+	_, err := client.GetSwapClaimDetails(swap.Id)
+	require.Error(t, err)
+
+	require.NoError(t, server.PayInvoice(swap.Id, swap.preimage))
+	requireStatus(t, client, swap.Id, boltz.TransactionClaimPending)
+
+	details, err := client.GetSwapClaimDetails(swap.Id)
+	require.NoError(t, err)
+	require.Equal(t, swap.preimage, []byte(details.Preimage))
+
+	session, err := boltz.NewSigningSession(swap.tree)
+	require.NoError(t, err)
+	partial, err := session.Sign(details.TransactionHash, details.PubNonce)
+	require.NoError(t, err)
+	require.NoError(t, client.SendSwapClaimSignature(swap.Id, partial))
+	requireStatus(t, client, swap.Id, boltz.TransactionClaimed)
+}
+
+func TestSubmarineRefund(t *testing.T) {
+	server := newServer(t)
+	client := server.Client()
+
+	t.Run("LockupFailed", func(t *testing.T) {
+		swap := createSwap(t, server, client)
+		output := swap.lockup(t, client, swap.ExpectedAmount-1)
+		requireStatus(t, client, swap.Id, boltz.TransactionLockupFailed)
+		require.NoError(t, spend(t, client, output))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		swap := createSwap(t, server, client)
+		output := swap.lockup(t, client, swap.ExpectedAmount)
+
+		err := spend(t, client, output)
+		require.ErrorContains(t, err, "not eligible")
+
+		require.NoError(t, server.Expire(swap.Id))
+		require.NoError(t, spend(t, client, output))
+	})
+
+	t.Run("RefuseCosigning", func(t *testing.T) {
+		swap := createSwap(t, server, client)
+		output := swap.lockup(t, client, swap.ExpectedAmount)
+		require.NoError(t, server.FailLockup(swap.Id))
+
+		server.RefuseCosigning(true)
+		defer server.RefuseCosigning(false)
+
+		var cooperativeErr *boltz.CooperativeError
+		require.ErrorAs(t, spend(t, client, output), &cooperativeErr)
+	})
+}
+
+func TestReverseSwap(t *testing.T) {
+	server := newServer(t)
+	client := server.Client()
+
+	key := newKey(t)
+	preimage, preimageHash := newPreimage(t)
+	response, err := client.CreateReverseSwap(boltz.CreateReverseSwapRequest{
+		From:           boltz.CurrencyBtc,
+		To:             boltz.CurrencyBtc,
+		PreimageHash:   preimageHash,
+		ClaimPublicKey: key.PubKey().SerializeCompressed(),
+		OnchainAmount:  50000,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(50000), response.OnchainAmount)
+
+	refundKey, err := btcec.ParsePubKey(response.RefundPublicKey)
+	require.NoError(t, err)
+	tree := response.SwapTree.Deserialize()
+	require.NoError(t, tree.Init(false, key, refundKey))
+	require.NoError(t, tree.Check(true, response.TimeoutBlockHeight, preimageHash))
+	require.NoError(t, tree.CheckAddress(response.LockupAddress, network, nil))
+
+	ws := boltz.NewBoltzWebsocket(client)
+	require.NoError(t, ws.Connect())
+	t.Cleanup(func() { _ = ws.Close() })
+	require.NoError(t, ws.Subscribe([]string{response.Id}))
+
+	nextUpdate := func() boltz.SwapUpdate {
+		select {
+		case update := <-ws.Updates:
+			require.Equal(t, response.Id, update.Id)
+			return update
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "no swap update")
+			return boltz.SwapUpdate{}
+		}
+	}
+	require.Equal(t, boltz.SwapCreated.String(), nextUpdate().Status)
+
+	_, err = server.Lockup(response.Id)
+	require.NoError(t, err)
+	update := nextUpdate()
+	require.Equal(t, boltz.TransactionMempool.String(), update.Status)
+
+	lockupTx, err := boltz.NewBtcTxFromHex(update.Transaction.Hex)
+	require.NoError(t, err)
+	vout, amount, err := lockupTx.FindVout(network, response.LockupAddress)
+	require.NoError(t, err)
+	require.Equal(t, response.OnchainAmount, amount)
+
+	output := boltz.OutputDetails{
+		LockupTransaction: lockupTx,
+		Vout:              vout,
+		PrivateKey:        key,
+		SwapTree:          tree,
+		SwapId:            response.Id,
+		SwapType:          boltz.ReverseSwap,
+	}
+
+	output.Preimage = make([]byte, 32)
+	require.ErrorContains(t, spend(t, client, output), "invalid preimage")
+
+	output.Preimage = preimage
+	require.NoError(t, spend(t, client, output))
+	require.Equal(t, boltz.InvoiceSettled.String(), nextUpdate().Status)
+}
+
+func TestChainSwap(t *testing.T) {
+	server := newServer(t)
+	client := server.Client()
+
+	claimKey, refundKey := newKey(t), newKey(t)
+	_, preimageHash := newPreimage(t)
+	response, err := client.CreateChainSwap(boltz.ChainRequest{
+		From:            boltz.CurrencyLiquid,
+		To:              boltz.CurrencyBtc,
+		PreimageHash:    preimageHash,
+		ClaimPublicKey:  claimKey.PubKey().SerializeCompressed(),
+		RefundPublicKey: refundKey.PubKey().SerializeCompressed(),
+		UserLockAmount:  100000,
+	})
+	require.NoError(t, err)
+	require.Greater(t, response.LockupDetails.TimeoutBlockHeight, response.ClaimDetails.TimeoutBlockHeight)
+
+	check := func(details *boltz.ChainSwapData, key *btcec.PrivateKey, isClaim bool, isLiquid bool) {
+		serverKey, err := btcec.ParsePubKey(details.ServerPublicKey)
+		require.NoError(t, err)
+		tree := details.SwapTree.Deserialize()
+		require.NoError(t, tree.Init(isLiquid, key, serverKey))
+		require.NoError(t, tree.CheckChain(isClaim, details.TimeoutBlockHeight, preimageHash))
+
+		var blindingKey *btcec.PublicKey
+		if isLiquid {
+			private, _ := btcec.PrivKeyFromBytes(details.BlindingKey)
+			blindingKey = private.PubKey()
+		}
+		require.NoError(t, tree.CheckAddress(details.LockupAddress, network, blindingKey))
+	}
+	check(response.ClaimDetails, claimKey, true, false)
+	check(response.LockupDetails, refundKey, false, true)
+
+	_, err = server.Lockup(response.Id)
+	require.NoError(t, err)
+	transactions, err := client.GetChainSwapTransactions(response.Id)
+	require.NoError(t, err)
+	require.Nil(t, transactions.UserLock)
+	require.NotNil(t, transactions.ServerLock)
+	require.Equal(t, response.ClaimDetails.TimeoutBlockHeight, transactions.ServerLock.Timeout.BlockHeight)
+}
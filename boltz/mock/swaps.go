@@ -0,0 +1,714 @@
+package mock
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// lockup is one of the onchain outputs of a swap
+type lockup struct {
+	currency           boltz.Currency
+	tree               *tree
+	address            string
+	blindingKey        *btcec.PrivateKey
+	timeoutBlockHeight uint32
+	// amount which is expected to be locked up
+	amount uint64
+
+	transactionId  string
+	transactionHex string
+	lockedAmount   uint64
+}
+
+type swap struct {
+	id           string
+	swapType     boltz.SwapType
+	status       boltz.SwapStatusResponse
+	preimageHash []byte
+	preimage     []byte
+
+	invoice       string
+	invoiceAmount uint64
+
+	// lockup of the client which is claimed by boltz; set for submarine and chain swaps
+	userLockup *lockup
+	// lockup of boltz which is claimed by the client; set for reverse and chain swaps
+	serverLockup *lockup
+
+	claim *claimSession
+}
+
+func (swap *swap) setStatus(status boltz.SwapUpdateEvent, transactionId string, transactionHex string) {
+	swap.status.Status = status.String()
+	swap.status.Transaction.Id = transactionId
+	swap.status.Transaction.Hex = transactionHex
+}
+
+// refundable returns whether boltz agrees to a cooperative refund of the lockup of the client
+func (swap *swap) refundable() bool {
+	return slices.Contains(boltz.FailedStatus, swap.status.Status)
+}
+
+func (server *Server) newLockup(
+	currency boltz.Currency,
+	clientKey []byte,
+	serverClaims bool,
+	checkPreimageSize bool,
+	preimageHash []byte,
+	timeoutDelta uint32,
+) (*lockup, error) {
+	if len(preimageHash) != sha256.Size {
+		return nil, errors.New("invalid preimage hash")
+	}
+	clientPublicKey, err := btcec.ParsePubKey(clientKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	serverKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	isLiquid := currency == boltz.CurrencyLiquid
+	timeoutBlockHeight := server.blockHeights[currency] + timeoutDelta
+	swapTree, err := newTree(isLiquid, serverKey, clientPublicKey, serverClaims, checkPreimageSize, preimageHash, timeoutBlockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &lockup{
+		currency:           currency,
+		tree:               swapTree,
+		timeoutBlockHeight: timeoutBlockHeight,
+	}
+	var blindingPubKey *btcec.PublicKey
+	if isLiquid {
+		result.blindingKey, err = btcec.NewPrivateKey()
+		if err != nil {
+			return nil, err
+		}
+		blindingPubKey = result.blindingKey.PubKey()
+	}
+	result.address, err = swapTree.address(server.network, blindingPubKey)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (lockup *lockup) serializedBlindingKey() boltz.HexString {
+	if lockup.blindingKey == nil {
+		return nil
+	}
+	return lockup.blindingKey.Serialize()
+}
+
+func (lockup *lockup) serverPublicKey() boltz.HexString {
+	return lockup.tree.serverKey.PubKey().SerializeCompressed()
+}
+
+func (lockup *lockup) bip21() string {
+	if lockup.amount == 0 {
+		return ""
+	}
+	scheme := "bitcoin"
+	if lockup.currency == boltz.CurrencyLiquid {
+		scheme = "liquidnetwork"
+	}
+	return fmt.Sprintf("%s:%s?amount=%s", scheme, lockup.address, btcutil.Amount(lockup.amount).Format(btcutil.AmountBTC))
+}
+
+func (lockup *lockup) timeoutEta(currentHeight uint32) uint64 {
+	var blocks uint32
+	if lockup.timeoutBlockHeight > currentHeight {
+		blocks = lockup.timeoutBlockHeight - currentHeight
+	}
+	return uint64(time.Now().Unix()) + uint64(float64(blocks)*boltz.GetBlockTime(lockup.currency)*60)
+}
+
+func (lockup *lockup) chainSwapData() *boltz.ChainSwapData {
+	return &boltz.ChainSwapData{
+		SwapTree:           lockup.tree.leaves,
+		LockupAddress:      lockup.address,
+		ServerPublicKey:    lockup.serverPublicKey(),
+		TimeoutBlockHeight: lockup.timeoutBlockHeight,
+		Amount:             lockup.amount,
+		BlindingKey:        lockup.serializedBlindingKey(),
+		Bip21:              lockup.bip21(),
+	}
+}
+
+func (server *Server) getSwap(id string) (*swap, error) {
+	swap, ok := server.swaps[id]
+	if !ok {
+		return nil, fmt.Errorf("could not find swap with id: %s", id)
+	}
+	return swap, nil
+}
+
+func (server *Server) getSwapOfType(id string, swapType boltz.SwapType) (*swap, error) {
+	swap, err := server.getSwap(id)
+	if err != nil {
+		return nil, err
+	}
+	if swap.swapType != swapType {
+		return nil, fmt.Errorf("swap %s is no %s swap", id, swapType)
+	}
+	return swap, nil
+}
+
+// addSwap stores a new swap and calls the creation hook in the background
+func (server *Server) addSwap(swap *swap) {
+	server.swaps[swap.id] = swap
+	if onCreate := server.onCreate; onCreate != nil {
+		go onCreate(swap.id, swap.swapType)
+	}
+}
+
+func (server *Server) checkCosigning() error {
+	if server.refuseCosigning {
+		return errors.New("boltz refuses to cosign")
+	}
+	return nil
+}
+
+func (server *Server) decodeInvoice(invoice string, preimageHash []byte) (*zpay32.Invoice, uint64, error) {
+	decoded, err := zpay32.Decode(invoice, server.network.Btc)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid invoice: %w", err)
+	}
+	if decoded.MilliSat == nil {
+		return nil, 0, errors.New("invoice has no amount")
+	}
+	if preimageHash != nil && string(decoded.PaymentHash[:]) != string(preimageHash) {
+		return nil, 0, errors.New("invoice has a different preimage hash")
+	}
+	return decoded, uint64(decoded.MilliSat.ToSatoshis()), nil
+}
+
+// submarineExpectedAmount is the amount the client has to lock up for an invoice
+func (server *Server) submarineExpectedAmount(invoiceAmount uint64) uint64 {
+	return invoiceAmount + percentageFee(invoiceAmount, server.fees.Percentage) + server.fees.MinerFees
+}
+
+func (server *Server) createSwap(r *http.Request, _ []string) (any, error) {
+	var request boltz.CreateSwapRequest
+	if err := decodeRequest(r, &request); err != nil {
+		return nil, err
+	}
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	pair, err := server.submarinePair(request.From, request.To)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPairHash(request.PairHash, pair.Hash); err != nil {
+		return nil, err
+	}
+
+	swap := &swap{id: newSwapId(), swapType: boltz.NormalSwap, preimageHash: request.PreimageHash}
+	swap.setStatus(boltz.SwapCreated, "", "")
+	if request.Invoice != "" {
+		invoice, amount, err := server.decodeInvoice(request.Invoice, nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := server.checkLimits(amount); err != nil {
+			return nil, err
+		}
+		swap.preimageHash = invoice.PaymentHash[:]
+		swap.invoice = request.Invoice
+		swap.invoiceAmount = amount
+		swap.setStatus(boltz.InvoiceSet, "", "")
+	}
+
+	swap.userLockup, err = server.newLockup(request.From, request.RefundPublicKey, true, false, swap.preimageHash, timeoutDeltas[request.From])
+	if err != nil {
+		return nil, err
+	}
+	if swap.invoice != "" {
+		swap.userLockup.amount = server.submarineExpectedAmount(swap.invoiceAmount)
+	}
+	server.addSwap(swap)
+
+	lockup := swap.userLockup
+	return &boltz.CreateSwapResponse{
+		Id:                 swap.id,
+		Bip21:              lockup.bip21(),
+		Address:            lockup.address,
+		SwapTree:           lockup.tree.leaves,
+		ClaimPublicKey:     lockup.serverPublicKey(),
+		TimeoutBlockHeight: lockup.timeoutBlockHeight,
+		AcceptZeroConf:     lockup.amount <= server.limits.ZeroConf,
+		ExpectedAmount:     lockup.amount,
+		BlindingKey:        lockup.serializedBlindingKey(),
+	}, nil
+}
+
+func (server *Server) createReverseSwap(r *http.Request, _ []string) (any, error) {
+	var request boltz.CreateReverseSwapRequest
+	if err := decodeRequest(r, &request); err != nil {
+		return nil, err
+	}
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	pair, err := server.reversePair(request.From, request.To)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPairHash(request.PairHash, pair.Hash); err != nil {
+		return nil, err
+	}
+
+	invoiceAmount, onchainAmount := request.InvoiceAmount, request.OnchainAmount
+	if invoiceAmount != 0 && onchainAmount != 0 {
+		return nil, errors.New("invoice and onchain amount are mutually exclusive")
+	} else if onchainAmount != 0 {
+		invoiceAmount = uint64(math.Ceil(float64(onchainAmount+server.fees.MinerFees) / (1 - server.fees.Percentage/100)))
+	} else {
+		fees := percentageFee(invoiceAmount, server.fees.Percentage) + server.fees.MinerFees
+		if invoiceAmount <= fees {
+			return nil, errors.New("invoice amount is too small")
+		}
+		onchainAmount = invoiceAmount - fees
+	}
+	if err := server.checkLimits(invoiceAmount); err != nil {
+		return nil, err
+	}
+
+	swap := &swap{
+		id:            newSwapId(),
+		swapType:      boltz.ReverseSwap,
+		preimageHash:  request.PreimageHash,
+		invoiceAmount: invoiceAmount,
+	}
+	swap.setStatus(boltz.SwapCreated, "", "")
+
+	swap.serverLockup, err = server.newLockup(request.To, request.ClaimPublicKey, false, true, swap.preimageHash, timeoutDeltas[request.To])
+	if err != nil {
+		return nil, err
+	}
+	swap.serverLockup.amount = onchainAmount
+
+	swap.invoice, err = server.NewInvoice(swap.preimageHash, invoiceAmount)
+	if err != nil {
+		return nil, err
+	}
+	server.addSwap(swap)
+
+	lockup := swap.serverLockup
+	return &boltz.CreateReverseSwapResponse{
+		Id:                 swap.id,
+		Invoice:            swap.invoice,
+		SwapTree:           lockup.tree.leaves,
+		RefundPublicKey:    lockup.serverPublicKey(),
+		LockupAddress:      lockup.address,
+		TimeoutBlockHeight: lockup.timeoutBlockHeight,
+		OnchainAmount:      lockup.amount,
+		BlindingKey:        lockup.serializedBlindingKey(),
+	}, nil
+}
+
+func (server *Server) createChainSwap(r *http.Request, _ []string) (any, error) {
+	var request boltz.ChainRequest
+	if err := decodeRequest(r, &request); err != nil {
+		return nil, err
+	}
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	pair, err := server.chainPair(request.From, request.To)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPairHash(request.PairHash, pair.Hash); err != nil {
+		return nil, err
+	}
+
+	userAmount, serverAmount := request.UserLockAmount, request.ServerLockAmount
+	if userAmount != 0 && serverAmount != 0 {
+		return nil, errors.New("user and server lock amount are mutually exclusive")
+	} else if serverAmount != 0 {
+		userAmount = uint64(math.Ceil(float64(serverAmount+server.fees.MinerFees) / (1 - server.fees.Percentage/100)))
+	} else if userAmount != 0 {
+		fees := percentageFee(userAmount, server.fees.Percentage) + server.fees.MinerFees
+		if userAmount <= fees {
+			return nil, errors.New("user lock amount is too small")
+		}
+		serverAmount = userAmount - fees
+	}
+	if userAmount != 0 {
+		if err := server.checkLimits(userAmount); err != nil {
+			return nil, err
+		}
+	}
+
+	swap := &swap{id: newSwapId(), swapType: boltz.ChainSwap, preimageHash: request.PreimageHash}
+	swap.setStatus(boltz.SwapCreated, "", "")
+
+	// the client has more time to refund, so that boltz can not claim after its own lockup was refunded
+	swap.userLockup, err = server.newLockup(request.From, request.RefundPublicKey, true, true, swap.preimageHash, 2*timeoutDeltas[request.From])
+	if err != nil {
+		return nil, err
+	}
+	swap.userLockup.amount = userAmount
+
+	swap.serverLockup, err = server.newLockup(request.To, request.ClaimPublicKey, false, true, swap.preimageHash, timeoutDeltas[request.To])
+	if err != nil {
+		return nil, err
+	}
+	swap.serverLockup.amount = serverAmount
+	server.addSwap(swap)
+
+	return &boltz.ChainResponse{
+		Id:            swap.id,
+		ClaimDetails:  swap.serverLockup.chainSwapData(),
+		LockupDetails: swap.userLockup.chainSwapData(),
+	}, nil
+}
+
+func (server *Server) getSwapStatus(_ *http.Request, args []string) (any, error) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	swap, err := server.getSwap(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return swap.status, nil
+}
+
+func (server *Server) getSwapTransaction(r *http.Request, _ []string) (any, error) {
+	var request boltz.GetSwapTransactionRequest
+	if err := decodeRequest(r, &request); err != nil {
+		return nil, err
+	}
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	swap, err := server.getSwap(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	lockup := swap.userLockup
+	if swap.swapType == boltz.ReverseSwap {
+		lockup = swap.serverLockup
+	}
+	if lockup.transactionId == "" {
+		return nil, errors.New("no lockup transaction found")
+	}
+	return boltz.GetSwapTransactionResponse{
+		TransactionHex:     lockup.transactionHex,
+		TimeoutBlockHeight: lockup.timeoutBlockHeight,
+		TimeoutEta:         lockup.timeoutEta(server.blockHeights[lockup.currency]),
+	}, nil
+}
+
+func (server *Server) refundSwap(r *http.Request, _ []string) (any, error) {
+	var request boltz.RefundSwapRequest
+	if err := decodeRequest(r, &request); err != nil {
+		return nil, err
+	}
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	swap, err := server.getSwapOfType(request.Id, boltz.NormalSwap)
+	if err != nil {
+		return nil, err
+	}
+	if err := server.checkCosigning(); err != nil {
+		return nil, err
+	}
+	if !swap.refundable() {
+		return nil, errors.New("swap is not eligible for a cooperative refund")
+	}
+	hash, err := server.sigHash(swap.userLockup, request.Transaction, request.Index)
+	if err != nil {
+		return nil, err
+	}
+	return swap.userLockup.tree.sign(hash, request.PubNonce)
+}
+
+func (server *Server) getInvoiceAmount(_ *http.Request, args []string) (any, error) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	swap, err := server.getSwapOfType(args[0], boltz.NormalSwap)
+	if err != nil {
+		return nil, err
+	}
+	lockup := swap.userLockup
+	if lockup.transactionId == "" {
+		return nil, errors.New("no lockup transaction found")
+	}
+	if lockup.lockedAmount <= server.fees.MinerFees {
+		return nil, errors.New("locked amount is too small")
+	}
+	invoiceAmount := float64(lockup.lockedAmount-server.fees.MinerFees) / (1 + server.fees.Percentage/100)
+	return boltz.GetInvoiceAmountResponse{InvoiceAmount: uint64(math.Floor(invoiceAmount))}, nil
+}
+
+func (server *Server) setInvoice(r *http.Request, args []string) (any, error) {
+	var request boltz.SetInvoiceRequest
+	if err := decodeRequest(r, &request); err != nil {
+		return nil, err
+	}
+
+	server.lock.Lock()
+	swap, err := server.getSwapOfType(args[0], boltz.NormalSwap)
+	if err != nil {
+		server.lock.Unlock()
+		return nil, err
+	}
+	if swap.invoice != "" {
+		server.lock.Unlock()
+		return nil, errors.New("swap has an invoice already")
+	}
+	_, amount, err := server.decodeInvoice(request.Invoice, swap.preimageHash)
+	if err != nil {
+		server.lock.Unlock()
+		return nil, err
+	}
+
+	lockup := swap.userLockup
+	swap.invoice = request.Invoice
+	swap.invoiceAmount = amount
+	lockup.amount = server.submarineExpectedAmount(amount)
+	if lockup.transactionId == "" {
+		swap.setStatus(boltz.InvoiceSet, "", "")
+	} else if lockup.lockedAmount != 0 && lockup.lockedAmount < lockup.amount {
+		swap.setStatus(boltz.TransactionLockupFailed, lockup.transactionId, lockup.transactionHex)
+	}
+	server.lock.Unlock()
+
+	server.notify(swap.id)
+	return boltz.SetInvoiceResponse{}, nil
+}
+
+func (server *Server) getSwapClaimDetails(_ *http.Request, args []string) (any, error) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	swap, err := server.getSwapOfType(args[0], boltz.NormalSwap)
+	if err != nil {
+		return nil, err
+	}
+	if swap.claim == nil {
+		return nil, errors.New("swap is not eligible for a cooperative claim")
+	}
+	nonce := swap.claim.session.PublicNonce()
+	return boltz.SwapClaimDetails{
+		PubNonce:        nonce[:],
+		TransactionHash: swap.claim.hash,
+		Preimage:        swap.preimage,
+		PublicKey:       swap.userLockup.serverPublicKey(),
+	}, nil
+}
+
+func (server *Server) sendSwapClaimSignature(r *http.Request, args []string) (any, error) {
+	var request boltz.PartialSignature
+	if err := decodeRequest(r, &request); err != nil {
+		return nil, err
+	}
+
+	server.lock.Lock()
+	swap, err := server.getSwapOfType(args[0], boltz.NormalSwap)
+	if err == nil {
+		err = server.finishClaim(swap, &request)
+	}
+	server.lock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	server.notify(swap.id)
+	return boltz.ErrorMessage{}, nil
+}
+
+// finishClaim completes a cooperative claim of boltz with the partial signature of the client
+func (server *Server) finishClaim(swap *swap, signature *boltz.PartialSignature) error {
+	if swap.claim == nil {
+		return errors.New("swap is not eligible for a cooperative claim")
+	}
+	claim := swap.claim
+	swap.claim = nil
+	if err := claim.finish(signature); err != nil {
+		return err
+	}
+	swap.setStatus(boltz.TransactionClaimed, "", "")
+	return nil
+}
+
+func (server *Server) claimReverseSwap(r *http.Request, _ []string) (any, error) {
+	var request boltz.ClaimReverseSwapRequest
+	if err := decodeRequest(r, &request); err != nil {
+		return nil, err
+	}
+
+	server.lock.Lock()
+	swap, err := server.getSwapOfType(request.Id, boltz.ReverseSwap)
+	var signature *boltz.PartialSignature
+	if err == nil {
+		signature, err = server.signClaim(swap, request.Preimage, request.Transaction, request.Index, request.PubNonce)
+	}
+	if err == nil {
+		swap.setStatus(boltz.InvoiceSettled, "", "")
+	}
+	server.lock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	server.notify(swap.id)
+	return signature, nil
+}
+
+// signClaim signs a transaction with which the client claims the lockup of boltz
+func (server *Server) signClaim(swap *swap, preimage []byte, transaction string, index int, pubNonce []byte) (*boltz.PartialSignature, error) {
+	if err := server.checkCosigning(); err != nil {
+		return nil, err
+	}
+	preimageHash := sha256.Sum256(preimage)
+	if string(preimageHash[:]) != string(swap.preimageHash) {
+		return nil, errors.New("invalid preimage")
+	}
+	hash, err := server.sigHash(swap.serverLockup, transaction, index)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := swap.serverLockup.tree.sign(hash, pubNonce)
+	if err != nil {
+		return nil, err
+	}
+	swap.preimage = preimage
+	return signature, nil
+}
+
+func chainSwapTransaction(lockup *lockup, currentHeight uint32) *boltz.ChainSwapTransaction {
+	if lockup.transactionId == "" {
+		return nil
+	}
+	transaction := &boltz.ChainSwapTransaction{}
+	transaction.Transaction.Id = lockup.transactionId
+	transaction.Transaction.Hex = lockup.transactionHex
+	transaction.Timeout = &struct {
+		BlockHeight uint32 `json:"blockHeight"`
+		Eta         uint64 `json:"eta"`
+	}{
+		BlockHeight: lockup.timeoutBlockHeight,
+		Eta:         lockup.timeoutEta(currentHeight),
+	}
+	return transaction
+}
+
+func (server *Server) getChainSwapTransactions(_ *http.Request, args []string) (any, error) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	swap, err := server.getSwapOfType(args[0], boltz.ChainSwap)
+	if err != nil {
+		return nil, err
+	}
+	return boltz.ChainSwapTransactions{
+		UserLock:   chainSwapTransaction(swap.userLockup, server.blockHeights[swap.userLockup.currency]),
+		ServerLock: chainSwapTransaction(swap.serverLockup, server.blockHeights[swap.serverLockup.currency]),
+	}, nil
+}
+
+func (server *Server) getChainSwapClaimDetails(_ *http.Request, args []string) (any, error) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	swap, err := server.getSwapOfType(args[0], boltz.ChainSwap)
+	if err != nil {
+		return nil, err
+	}
+	if swap.userLockup.transactionId == "" || swap.preimage == nil {
+		return nil, errors.New("swap is not eligible for a cooperative claim")
+	}
+	if swap.claim == nil {
+		swap.claim, err = newClaimSession(swap.userLockup.tree)
+		if err != nil {
+			return nil, err
+		}
+	}
+	nonce := swap.claim.session.PublicNonce()
+	return boltz.ChainSwapSigningDetails{
+		PubNonce:        nonce[:],
+		PublicKey:       swap.userLockup.serverPublicKey(),
+		TransactionHash: swap.claim.hash,
+	}, nil
+}
+
+func (server *Server) claimChainSwap(r *http.Request, args []string) (any, error) {
+	var request boltz.ChainSwapSigningRequest
+	if err := decodeRequest(r, &request); err != nil {
+		return nil, err
+	}
+
+	server.lock.Lock()
+	swap, err := server.getSwapOfType(args[0], boltz.ChainSwap)
+	var signature *boltz.PartialSignature
+	if err == nil && request.Signature != nil {
+		err = server.finishClaim(swap, request.Signature)
+	}
+	if err == nil && request.ToSign != nil {
+		signature, err = server.signClaim(swap, request.Preimage, request.ToSign.Transaction, request.ToSign.Index, request.ToSign.PubNonce)
+		if err == nil {
+			swap.setStatus(boltz.TransactionClaimed, "", "")
+		}
+	}
+	server.lock.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	server.notify(swap.id)
+	if signature == nil {
+		return boltz.ErrorMessage{}, nil
+	}
+	return signature, nil
+}
+
+func (server *Server) refundChainSwap(r *http.Request, args []string) (any, error) {
+	var request boltz.RefundChainSwapRequest
+	if err := decodeRequest(r, &request); err != nil {
+		return nil, err
+	}
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	swap, err := server.getSwapOfType(args[0], boltz.ChainSwap)
+	if err != nil {
+		return nil, err
+	}
+	if err := server.checkCosigning(); err != nil {
+		return nil, err
+	}
+	if !swap.refundable() {
+		return nil, errors.New("swap is not eligible for a cooperative refund")
+	}
+	hash, err := server.sigHash(swap.userLockup, request.Transaction, request.Index)
+	if err != nil {
+		return nil, err
+	}
+	return swap.userLockup.tree.sign(hash, request.PubNonce)
+}
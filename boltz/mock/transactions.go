@@ -0,0 +1,280 @@
+package mock
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/vulpemventures/go-elements/elementsutil"
+	liquidtx "github.com/vulpemventures/go-elements/transaction"
+)
+
+type output struct {
+	script []byte
+	// zero for confidential outputs
+	amount uint64
+}
+
+// parseTransaction returns the id and outputs of a transaction
+func parseTransaction(currency boltz.Currency, transactionHex string) (string, []output, error) {
+	if currency == boltz.CurrencyLiquid {
+		tx, err := liquidtx.NewTxFromHex(transactionHex)
+		if err != nil {
+			return "", nil, err
+		}
+		var outputs []output
+		for _, out := range tx.Outputs {
+			var amount uint64
+			if len(out.Value) == 9 && out.Value[0] == 1 {
+				amount, _ = elementsutil.ValueFromBytes(out.Value)
+			}
+			outputs = append(outputs, output{script: out.Script, amount: amount})
+		}
+		return tx.TxHash().String(), outputs, nil
+	}
+
+	tx, err := boltz.NewBtcTxFromHex(transactionHex)
+	if err != nil {
+		return "", nil, err
+	}
+	var outputs []output
+	for _, out := range tx.MsgTx().TxOut {
+		outputs = append(outputs, output{script: out.PkScript, amount: uint64(out.Value)})
+	}
+	return tx.Hash(), outputs, nil
+}
+
+func (server *Server) knownTransaction(currency boltz.Currency, transactionId string) (string, error) {
+	transactions, ok := server.transactions[currency]
+	if !ok {
+		return "", fmt.Errorf("invalid currency: %s", currency)
+	}
+	transactionHex, ok := transactions[transactionId]
+	if !ok {
+		return "", fmt.Errorf("could not find transaction %s", transactionId)
+	}
+	return transactionHex, nil
+}
+
+// AddTransaction makes a transaction known to the server as if it was broadcast.
+// Outputs to the lockup addresses of swaps are detected as lockups of the client.
+func (server *Server) AddTransaction(currency boltz.Currency, transactionHex string) (string, error) {
+	transactionId, outputs, err := parseTransaction(currency, transactionHex)
+	if err != nil {
+		return "", fmt.Errorf("could not parse transaction: %w", err)
+	}
+
+	server.lock.Lock()
+	transactions, ok := server.transactions[currency]
+	if !ok {
+		server.lock.Unlock()
+		return "", fmt.Errorf("invalid currency: %s", currency)
+	}
+	transactions[transactionId] = transactionHex
+
+	var updated []string
+	for _, swap := range server.swaps {
+		lockup := swap.userLockup
+		if lockup == nil || lockup.currency != currency || lockup.transactionId != "" {
+			continue
+		}
+		script, err := lockup.tree.outputScript()
+		if err != nil {
+			continue
+		}
+		for _, out := range outputs {
+			if bytes.Equal(out.script, script) {
+				server.detectLockup(swap, transactionId, transactionHex, out.amount)
+				updated = append(updated, swap.id)
+				break
+			}
+		}
+	}
+	server.lock.Unlock()
+
+	server.notify(updated...)
+	return transactionId, nil
+}
+
+// detectLockup records the lockup of the client and fails the swap if it locked up less than expected
+func (server *Server) detectLockup(swap *swap, transactionId string, transactionHex string, amount uint64) {
+	lockup := swap.userLockup
+	lockup.transactionId = transactionId
+	lockup.transactionHex = transactionHex
+	lockup.lockedAmount = amount
+
+	status := boltz.TransactionMempool
+	// the amount of confidential outputs is not known
+	if amount != 0 && lockup.amount != 0 && amount < lockup.amount {
+		status = boltz.TransactionLockupFailed
+	}
+	swap.setStatus(status, transactionId, transactionHex)
+}
+
+// newLockupTransaction creates a transaction paying amount to the lockup. Its input does not exist, so it can only
+// be used in tests which do not broadcast to a real chain.
+func newLockupTransaction(lockup *lockup, amount uint64) (string, string, error) {
+	if lockup.currency != boltz.CurrencyBtc {
+		return "", "", fmt.Errorf("lockups of boltz are not supported for %s", lockup.currency)
+	}
+	script, err := lockup.tree.outputScript()
+	if err != nil {
+		return "", "", err
+	}
+
+	var funding chainhash.Hash
+	if _, err := rand.Read(funding[:]); err != nil {
+		return "", "", err
+	}
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&funding, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(int64(amount), script))
+
+	var serialized bytes.Buffer
+	if err := tx.Serialize(&serialized); err != nil {
+		return "", "", err
+	}
+	return tx.TxHash().String(), hex.EncodeToString(serialized.Bytes()), nil
+}
+
+// sigHash returns the hash boltz signs for the input at index of a transaction spending lockup cooperatively.
+// All inputs of the transaction have to spend transactions known to the server.
+func (server *Server) sigHash(lockup *lockup, transactionHex string, index int) ([]byte, error) {
+	if lockup.transactionId == "" {
+		return nil, errors.New("no lockup transaction found")
+	}
+	script, err := lockup.tree.outputScript()
+	if err != nil {
+		return nil, err
+	}
+
+	if lockup.currency == boltz.CurrencyLiquid {
+		return server.liquidSigHash(script, transactionHex, index)
+	}
+	return server.btcSigHash(script, transactionHex, index)
+}
+
+func (server *Server) btcSigHash(lockupScript []byte, transactionHex string, index int) ([]byte, error) {
+	transaction, err := boltz.NewBtcTxFromHex(transactionHex)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse transaction: %w", err)
+	}
+	tx := transaction.MsgTx()
+	if index < 0 || index >= len(tx.TxIn) {
+		return nil, fmt.Errorf("invalid input index %d", index)
+	}
+
+	previous := make(map[wire.OutPoint]*wire.TxOut)
+	for _, input := range tx.TxIn {
+		prevOut := input.PreviousOutPoint
+		previousHex, err := server.knownTransaction(boltz.CurrencyBtc, prevOut.Hash.String())
+		if err != nil {
+			return nil, err
+		}
+		previousTx, err := boltz.NewBtcTxFromHex(previousHex)
+		if err != nil {
+			return nil, err
+		}
+		outputs := previousTx.MsgTx().TxOut
+		if int(prevOut.Index) >= len(outputs) {
+			return nil, fmt.Errorf("invalid input %s", prevOut)
+		}
+		previous[prevOut] = outputs[prevOut.Index]
+	}
+
+	if !bytes.Equal(previous[tx.TxIn[index].PreviousOutPoint].PkScript, lockupScript) {
+		return nil, errors.New("input does not spend the lockup of the swap")
+	}
+
+	prevoutFetcher := txscript.NewMultiPrevOutFetcher(previous)
+	return txscript.CalcTaprootSignatureHash(
+		txscript.NewTxSigHashes(tx, prevoutFetcher),
+		txscript.SigHashDefault,
+		tx,
+		index,
+		prevoutFetcher,
+	)
+}
+
+func (server *Server) liquidSigHash(lockupScript []byte, transactionHex string, index int) ([]byte, error) {
+	tx, err := liquidtx.NewTxFromHex(transactionHex)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse transaction: %w", err)
+	}
+	if index < 0 || index >= len(tx.Inputs) {
+		return nil, fmt.Errorf("invalid input index %d", index)
+	}
+
+	var scripts, assets, values [][]byte
+	for _, input := range tx.Inputs {
+		previousHash, err := chainhash.NewHash(input.Hash)
+		if err != nil {
+			return nil, err
+		}
+		previousHex, err := server.knownTransaction(boltz.CurrencyLiquid, previousHash.String())
+		if err != nil {
+			return nil, err
+		}
+		previousTx, err := liquidtx.NewTxFromHex(previousHex)
+		if err != nil {
+			return nil, err
+		}
+		if int(input.Index) >= len(previousTx.Outputs) {
+			return nil, fmt.Errorf("invalid input %s:%d", previousHash, input.Index)
+		}
+		out := previousTx.Outputs[input.Index]
+		scripts = append(scripts, out.Script)
+		assets = append(assets, out.Asset)
+		values = append(values, out.Value)
+	}
+
+	if !bytes.Equal(scripts[index], lockupScript) {
+		return nil, errors.New("input does not spend the lockup of the swap")
+	}
+
+	genesisHash, err := chainhash.NewHashFromStr(server.network.Liquid.GenesisBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	hash := tx.HashForWitnessV1(index, scripts, assets, values, txscript.SigHashDefault, genesisHash, nil, nil)
+	return hash[:], nil
+}
+
+func (server *Server) getTransaction(_ *http.Request, args []string) (any, error) {
+	currency, err := boltz.ParseCurrency(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	transactionHex, err := server.knownTransaction(currency, args[1])
+	if err != nil {
+		return nil, err
+	}
+	return boltz.GetTransactionResponse{Hex: transactionHex}, nil
+}
+
+func (server *Server) broadcastTransaction(r *http.Request, args []string) (any, error) {
+	currency, err := boltz.ParseCurrency(args[0])
+	if err != nil {
+		return nil, err
+	}
+	var request boltz.BroadcastTransactionRequest
+	if err := decodeRequest(r, &request); err != nil {
+		return nil, err
+	}
+	transactionId, err := server.AddTransaction(currency, request.Hex)
+	if err != nil {
+		return nil, err
+	}
+	return boltz.BroadcastTransactionResponse{Id: transactionId}, nil
+}
@@ -0,0 +1,239 @@
+package mock
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/vulpemventures/go-elements/payment"
+	"github.com/vulpemventures/go-elements/taproot"
+)
+
+const leafVersionLiquid = 196
+
+// tree is the swap tree of a lockup as seen by boltz, which holds the server key and only knows the public key of the client
+type tree struct {
+	isLiquid  bool
+	leaves    *boltz.SerializedTree
+	serverKey *btcec.PrivateKey
+	clientKey *btcec.PublicKey
+
+	tweak musig2.KeyTweakDesc
+	key   *btcec.PublicKey
+}
+
+// newTree creates the tree of a lockup the same way boltz does.
+// serverClaims is set for lockups of the client, which boltz claims with the preimage.
+// Reverse and chain swaps also enforce the size of the preimage in the claim leaf.
+func newTree(
+	isLiquid bool,
+	serverKey *btcec.PrivateKey,
+	clientKey *btcec.PublicKey,
+	serverClaims bool,
+	checkPreimageSize bool,
+	preimageHash []byte,
+	timeoutBlockHeight uint32,
+) (*tree, error) {
+	claimKey, refundKey := clientKey, serverKey.PubKey()
+	if serverClaims {
+		claimKey, refundKey = serverKey.PubKey(), clientKey
+	}
+
+	claim := txscript.NewScriptBuilder()
+	if checkPreimageSize {
+		claim.AddOp(txscript.OP_SIZE)
+		claim.AddInt64(32)
+		claim.AddOp(txscript.OP_EQUALVERIFY)
+	}
+	claim.AddOp(txscript.OP_HASH160)
+	claim.AddData(input.Ripemd160H(preimageHash))
+	claim.AddOp(txscript.OP_EQUALVERIFY)
+	claim.AddData(schnorr.SerializePubKey(claimKey))
+	claim.AddOp(txscript.OP_CHECKSIG)
+	claimScript, err := claim.Script()
+	if err != nil {
+		return nil, err
+	}
+
+	refund := txscript.NewScriptBuilder()
+	refund.AddData(schnorr.SerializePubKey(refundKey))
+	refund.AddOp(txscript.OP_CHECKSIGVERIFY)
+	refund.AddInt64(int64(timeoutBlockHeight))
+	refund.AddOp(txscript.OP_CHECKLOCKTIMEVERIFY)
+	refundScript, err := refund.Script()
+	if err != nil {
+		return nil, err
+	}
+
+	version := txscript.BaseLeafVersion
+	if isLiquid {
+		version = leafVersionLiquid
+	}
+	claimLeaf := txscript.NewTapLeaf(version, claimScript)
+	refundLeaf := txscript.NewTapLeaf(version, refundScript)
+
+	var scriptRoot chainhash.Hash
+	tag := chainhash.TagTapTweak
+	if isLiquid {
+		scriptRoot = taproot.AssembleTaprootScriptTree(
+			taproot.TapElementsLeaf{TapLeaf: claimLeaf},
+			taproot.TapElementsLeaf{TapLeaf: refundLeaf},
+		).RootNode.TapHash()
+		tag = taproot.TagTapTweakElements
+	} else {
+		scriptRoot = txscript.AssembleTaprootScriptTree(claimLeaf, refundLeaf).RootNode.TapHash()
+	}
+
+	result := &tree{
+		isLiquid:  isLiquid,
+		serverKey: serverKey,
+		clientKey: clientKey,
+		leaves: &boltz.SerializedTree{
+			ClaimLeaf:  boltz.SerializedLeaf{Version: version, Output: claimScript},
+			RefundLeaf: boltz.SerializedLeaf{Version: version, Output: refundScript},
+		},
+	}
+
+	internalKey, _, _, err := musig2.AggregateKeys(result.signers(), false)
+	if err != nil {
+		return nil, err
+	}
+	result.tweak = musig2.KeyTweakDesc{
+		Tweak:   *chainhash.TaggedHash(tag, schnorr.SerializePubKey(internalKey.FinalKey), scriptRoot[:]),
+		IsXOnly: true,
+	}
+	aggregateKey, _, _, err := musig2.AggregateKeys(result.signers(), false, musig2.WithKeyTweaks(result.tweak))
+	if err != nil {
+		return nil, err
+	}
+	result.key = aggregateKey.FinalKey
+	return result, nil
+}
+
+// signers returns the keys of the musig session; the key of boltz always comes first
+func (tree *tree) signers() []*btcec.PublicKey {
+	return []*btcec.PublicKey{tree.serverKey.PubKey(), tree.clientKey}
+}
+
+func (tree *tree) outputScript() ([]byte, error) {
+	return txscript.PayToTaprootScript(tree.key)
+}
+
+func (tree *tree) address(network *boltz.Network, blindingKey *btcec.PublicKey) (string, error) {
+	if tree.isLiquid {
+		p2tr, err := payment.FromTweakedKey(tree.key, network.Liquid, blindingKey)
+		if err != nil {
+			return "", err
+		}
+		return p2tr.ConfidentialTaprootAddress()
+	}
+	address, err := btcutil.NewAddressTaproot(schnorr.SerializePubKey(tree.key), network.Btc)
+	if err != nil {
+		return "", err
+	}
+	return address.EncodeAddress(), nil
+}
+
+func (tree *tree) newSession() (*musig2.Session, error) {
+	ctx, err := musig2.NewContext(
+		tree.serverKey,
+		false,
+		musig2.WithTweakedContext(tree.tweak),
+		musig2.WithKnownSigners(tree.signers()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return ctx.NewSession()
+}
+
+func encodePartialSignature(nonce [musig2.PubNonceSize]byte, partial *musig2.PartialSignature) (*boltz.PartialSignature, error) {
+	b := bytes.NewBuffer(nil)
+	if err := partial.Encode(b); err != nil {
+		return nil, err
+	}
+	return &boltz.PartialSignature{
+		PubNonce:         nonce[:],
+		PartialSignature: b.Bytes(),
+	}, nil
+}
+
+// sign creates the partial signature of boltz for hash
+func (tree *tree) sign(hash []byte, clientNonce []byte) (*boltz.PartialSignature, error) {
+	if len(hash) != 32 {
+		return nil, fmt.Errorf("invalid hash length %d", len(hash))
+	}
+	if len(clientNonce) != musig2.PubNonceSize {
+		return nil, fmt.Errorf("invalid nonce length %d", len(clientNonce))
+	}
+	session, err := tree.newSession()
+	if err != nil {
+		return nil, err
+	}
+	// the nonces of the session are cleared after signing
+	serverNonce := session.PublicNonce()
+	if _, err := session.RegisterPubNonce([musig2.PubNonceSize]byte(clientNonce)); err != nil {
+		return nil, err
+	}
+	partial, err := session.Sign([32]byte(hash))
+	if err != nil {
+		return nil, err
+	}
+	return encodePartialSignature(serverNonce, partial)
+}
+
+// claimSession is a cooperative claim of boltz which waits for the partial signature of the client
+type claimSession struct {
+	session *musig2.Session
+	hash    []byte
+	tree    *tree
+}
+
+// finish combines the partial signature of the client with the one of boltz and verifies the result
+func (claim *claimSession) finish(signature *boltz.PartialSignature) error {
+	if signature == nil || len(signature.PubNonce) != musig2.PubNonceSize {
+		return errors.New("invalid partial signature")
+	}
+	if _, err := claim.session.RegisterPubNonce([musig2.PubNonceSize]byte(signature.PubNonce)); err != nil {
+		return err
+	}
+	if _, err := claim.session.Sign([32]byte(claim.hash)); err != nil {
+		return err
+	}
+
+	var partial musig2.PartialSignature
+	if err := partial.Decode(bytes.NewReader(signature.PartialSignature)); err != nil {
+		return fmt.Errorf("invalid partial signature: %w", err)
+	}
+	haveFinal, err := claim.session.CombineSig(&partial)
+	if err != nil {
+		return fmt.Errorf("could not combine signatures: %w", err)
+	}
+	if !haveFinal || !claim.session.FinalSig().Verify(claim.hash, claim.tree.key) {
+		return errors.New("invalid partial signature")
+	}
+	return nil
+}
+
+// newClaimSession starts a cooperative claim of a lockup of the client. The claim transaction of boltz is never
+// broadcast, so a random hash stands in for its sighash.
+func newClaimSession(tree *tree) (*claimSession, error) {
+	session, err := tree.newSession()
+	if err != nil {
+		return nil, err
+	}
+	hash := make([]byte, 32)
+	if _, err := rand.Read(hash); err != nil {
+		return nil, err
+	}
+	return &claimSession{session: session, hash: hash, tree: tree}, nil
+}
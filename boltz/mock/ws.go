@@ -0,0 +1,151 @@
+package mock
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/logger"
+	"github.com/gorilla/websocket"
+)
+
+const swapUpdateChannel = "swap.update"
+
+type wsRequest struct {
+	Op      string   `json:"op"`
+	Channel string   `json:"channel"`
+	Args    []string `json:"args"`
+}
+
+type wsMessage struct {
+	Event   string `json:"event"`
+	Error   string `json:"error,omitempty"`
+	Channel string `json:"channel,omitempty"`
+	Args    any    `json:"args,omitempty"`
+}
+
+type wsClient struct {
+	conn      *websocket.Conn
+	writeLock sync.Mutex
+
+	subscriptions     map[string]bool
+	subscriptionsLock sync.Mutex
+}
+
+func (client *wsClient) send(message wsMessage) {
+	client.writeLock.Lock()
+	defer client.writeLock.Unlock()
+
+	if err := client.conn.WriteJSON(message); err != nil {
+		logger.Debugf("Could not send mock websocket message: %v", err)
+	}
+}
+
+func (client *wsClient) subscribed(id string) bool {
+	client.subscriptionsLock.Lock()
+	defer client.subscriptionsLock.Unlock()
+	return client.subscriptions[id]
+}
+
+func (server *Server) handleWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := server.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	client := &wsClient{conn: conn, subscriptions: make(map[string]bool)}
+
+	server.wsLock.Lock()
+	server.wsClients[client] = true
+	server.wsLock.Unlock()
+
+	defer func() {
+		server.wsLock.Lock()
+		delete(server.wsClients, client)
+		server.wsLock.Unlock()
+		_ = conn.Close()
+	}()
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		var request wsRequest
+		if err := json.Unmarshal(message, &request); err != nil {
+			client.send(wsMessage{Event: "error", Error: "invalid message"})
+			continue
+		}
+		if request.Channel != swapUpdateChannel {
+			client.send(wsMessage{Event: "error", Error: "invalid channel"})
+			continue
+		}
+
+		switch request.Op {
+		case "subscribe":
+			client.subscriptionsLock.Lock()
+			for _, id := range request.Args {
+				client.subscriptions[id] = true
+			}
+			client.subscriptionsLock.Unlock()
+
+			client.send(wsMessage{Event: request.Op, Channel: request.Channel, Args: request.Args})
+			// like boltz, the current status is sent right after subscribing
+			if updates := server.swapUpdates(request.Args); len(updates) > 0 {
+				client.send(wsMessage{Event: "update", Channel: swapUpdateChannel, Args: updates})
+			}
+		case "unsubscribe":
+			client.subscriptionsLock.Lock()
+			for _, id := range request.Args {
+				delete(client.subscriptions, id)
+			}
+			client.subscriptionsLock.Unlock()
+
+			client.send(wsMessage{Event: request.Op, Channel: request.Channel, Args: request.Args})
+		default:
+			client.send(wsMessage{Event: "error", Error: "unknown operation"})
+		}
+	}
+}
+
+func (server *Server) swapUpdates(ids []string) []boltz.SwapUpdate {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	var updates []boltz.SwapUpdate
+	for _, id := range ids {
+		if swap, ok := server.swaps[id]; ok {
+			updates = append(updates, boltz.SwapUpdate{SwapStatusResponse: swap.status, Id: id})
+		}
+	}
+	return updates
+}
+
+// notify sends the current status of the swaps to all websocket clients which are subscribed to them
+func (server *Server) notify(ids ...string) {
+	server.wsLock.Lock()
+	var clients []*wsClient
+	for client := range server.wsClients {
+		clients = append(clients, client)
+	}
+	server.wsLock.Unlock()
+
+	for _, update := range server.swapUpdates(ids) {
+		for _, client := range clients {
+			if client.subscribed(update.Id) {
+				client.send(wsMessage{Event: "update", Channel: swapUpdateChannel, Args: []boltz.SwapUpdate{update}})
+			}
+		}
+	}
+}
+
+// DisconnectWebsockets closes the connections of all websocket clients, which makes them reconnect
+func (server *Server) DisconnectWebsockets() {
+	server.wsLock.Lock()
+	defer server.wsLock.Unlock()
+
+	for client := range server.wsClients {
+		_ = client.conn.Close()
+	}
+}
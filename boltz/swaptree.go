@@ -172,42 +172,53 @@ func (tree *SwapTree) Check(
 	isReverse bool,
 	timeoutBlockHeight uint32,
 	preimageHash []byte,
+) error {
+	return tree.check(isReverse, isReverse, timeoutBlockHeight, preimageHash)
+}
+
+// CheckChain verifies one of the two trees of a chain swap.
+// Both of them use the claim script of reverse swaps, isClaim has to be set for the tree of the lockup boltz makes.
+func (tree *SwapTree) CheckChain(
+	isClaim bool,
+	timeoutBlockHeight uint32,
+	preimageHash []byte,
+) error {
+	return tree.check(true, isClaim, timeoutBlockHeight, preimageHash)
+}
+
+func (tree *SwapTree) check(
+	checkPreimageSize bool,
+	isClaim bool,
+	timeoutBlockHeight uint32,
+	preimageHash []byte,
 ) error {
 	if err := tree.checkLeafVersions(); err != nil {
 		return err
 	}
 
-	claim := txscript.NewScriptBuilder()
-	if isReverse {
-		claimPubKey := tree.ourKey.PubKey()
+	claimPubKey := tree.boltzKey
+	refundPublicKey := tree.ourKey.PubKey()
+	if isClaim {
+		claimPubKey = tree.ourKey.PubKey()
+		refundPublicKey = tree.boltzKey
+	}
 
+	claim := txscript.NewScriptBuilder()
+	if checkPreimageSize {
 		claim.AddOp(txscript.OP_SIZE)
 		claim.AddInt64(32)
 		claim.AddOp(txscript.OP_EQUALVERIFY)
-		claim.AddOp(txscript.OP_HASH160)
-		claim.AddData(input.Ripemd160H(preimageHash))
-		claim.AddOp(txscript.OP_EQUALVERIFY)
-		claim.AddData(toXOnly(claimPubKey))
-		claim.AddOp(txscript.OP_CHECKSIG)
-	} else {
-
-		claimPubKey := tree.boltzKey
-		claim.AddOp(txscript.OP_HASH160)
-		claim.AddData(input.Ripemd160H(preimageHash))
-		claim.AddOp(txscript.OP_EQUALVERIFY)
-		claim.AddData(toXOnly(claimPubKey))
-		claim.AddOp(txscript.OP_CHECKSIG)
 	}
+	claim.AddOp(txscript.OP_HASH160)
+	claim.AddData(input.Ripemd160H(preimageHash))
+	claim.AddOp(txscript.OP_EQUALVERIFY)
+	claim.AddData(toXOnly(claimPubKey))
+	claim.AddOp(txscript.OP_CHECKSIG)
 
 	if err := checkScript(tree.ClaimLeaf.Script, claim); err != nil {
 		return err
 	}
 
-	refundPublicKey := tree.ourKey.PubKey()
-	if isReverse {
-		refundPublicKey = tree.boltzKey
-	}
-
 	refund := txscript.NewScriptBuilder()
 	refund.AddData(toXOnly(refundPublicKey))
 	refund.AddOp(txscript.OP_CHECKSIGVERIFY)
@@ -267,6 +278,10 @@ func (leaf *SerializedLeaf) Deserialize() TapLeaf {
 }
 
 func (tree *SerializedTree) Deserialize() *SwapTree {
+	if tree == nil {
+		// swaps on EVM chains have no swap tree
+		return nil
+	}
 	return &SwapTree{
 		ClaimLeaf:  tree.ClaimLeaf.Deserialize(),
 		RefundLeaf: tree.RefundLeaf.Deserialize(),
@@ -124,3 +124,11 @@ func TestSwapTree(t *testing.T) {
 	}
 
 }
+
+func TestSerializeNilTree(t *testing.T) {
+	// swaps on EVM chains have no swap tree
+	var tree *SwapTree
+	require.Nil(t, tree.Serialize())
+	var serialized *SerializedTree
+	require.Nil(t, serialized.Deserialize())
+}
@@ -3,6 +3,8 @@ package boltz
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/txscript"
@@ -38,10 +40,34 @@ type OutputDetails struct {
 	SwapTree    *SwapTree
 	Cooperative bool
 
+	// highest share of the transaction fee this output may pay; nil means no limit
+	MaxFee *uint64
+
+	// lowest amount that has to be left of the output after paying its share of the transaction fee; 0 means no limit
+	MinAmount uint64
+	// value of the output, which is only needed to check MinAmount
+	Value uint64
+
 	SwapId   string
 	SwapType SwapType
 }
 
+// CooperativeError is returned by ConstructTransaction when boltz did not provide
+// a partial signature for some of the cooperative outputs.
+// Errors maps the swap ids of the affected outputs to the error boltz returned.
+type CooperativeError struct {
+	Errors map[string]error
+}
+
+func (err *CooperativeError) Error() string {
+	var messages []string
+	for id, swapErr := range err.Errors {
+		messages = append(messages, fmt.Sprintf("%s: %s", id, swapErr))
+	}
+	slices.Sort(messages)
+	return "could not get partial signature from boltz for " + strings.Join(messages, ", ")
+}
+
 func (output *OutputDetails) IsRefund() bool {
 	return len(output.Preimage) == 0
 }
@@ -57,28 +83,62 @@ func NewTxFromHex(currency Currency, hexString string, ourOutputBlindingKey *btc
 	return NewBtcTxFromHex(hexString)
 }
 
-func ConstructTransaction(network *Network, currency Currency, outputs []OutputDetails, satPerVbyte float64, boltzApi *Boltz) (Transaction, uint64, error) {
-	var construct func(*Network, []OutputDetails, uint64) (Transaction, error)
+// FindOutputIndex returns the index of the output paying to the given address. Unlike FindVout, it does not require
+// the blinding key of liquid outputs
+func FindOutputIndex(network *Network, transaction Transaction, address string) (uint32, error) {
+	if liquidTransaction, ok := transaction.(*LiquidTransaction); ok {
+		return liquidTransaction.findOutputIndex(address)
+	}
+	vout, _, err := transaction.FindVout(network, address)
+	return vout, err
+}
+
+type constructor func(*Network, []OutputDetails, uint64) (Transaction, error)
+
+func getConstructor(currency Currency) (constructor, error) {
 	if currency == CurrencyLiquid {
-		construct = constructLiquidTransaction
+		return constructLiquidTransaction, nil
 	} else if currency == CurrencyBtc {
-		construct = constructBtcTransaction
-	} else {
-		return nil, 0, fmt.Errorf("invalid pair: %v", currency)
+		return constructBtcTransaction, nil
 	}
+	return nil, fmt.Errorf("invalid pair: %v", currency)
+}
 
+func calculateFee(network *Network, construct constructor, outputs []OutputDetails, satPerVbyte float64) (uint64, error) {
 	noFeeTransaction, err := construct(network, outputs, 0)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(float64(noFeeTransaction.VSize()) * satPerVbyte), nil
+}
 
+// CalculateTransactionFee returns the fee ConstructTransaction will pay for the outputs without asking boltz for
+// any signatures, so that it can be checked before cooperating with boltz reveals anything
+func CalculateTransactionFee(network *Network, currency Currency, outputs []OutputDetails, satPerVbyte float64) (uint64, error) {
+	construct, err := getConstructor(currency)
+	if err != nil {
+		return 0, err
+	}
+	return calculateFee(network, construct, outputs, satPerVbyte)
+}
+
+func ConstructTransaction(network *Network, currency Currency, outputs []OutputDetails, satPerVbyte float64, boltzApi *Boltz) (Transaction, uint64, error) {
+	construct, err := getConstructor(currency)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	fee, err := calculateFee(network, construct, outputs, satPerVbyte)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	fee := uint64(float64(noFeeTransaction.VSize()) * satPerVbyte)
 	transaction, err := construct(network, outputs, fee)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	cooperativeErr := &CooperativeError{Errors: make(map[string]error)}
 	for i, output := range outputs {
 		if output.Cooperative {
 			if boltzApi == nil {
@@ -104,6 +164,23 @@ func ConstructTransaction(network *Network, currency Currency, outputs []OutputD
 					Index:       i,
 					Preimage:    output.Preimage,
 				})
+			} else if output.SwapType == ChainSwap {
+				if output.IsRefund() {
+					signature, err = boltzApi.RefundChainSwap(output.SwapId, RefundChainSwapRequest{
+						Transaction: serialized,
+						PubNonce:    pubNonce[:],
+						Index:       i,
+					})
+				} else {
+					signature, err = boltzApi.ExchangeChainSwapClaimSignature(output.SwapId, ChainSwapSigningRequest{
+						Preimage: output.Preimage,
+						ToSign: &ChainSwapToSign{
+							Transaction: serialized,
+							PubNonce:    pubNonce[:],
+							Index:       i,
+						},
+					})
+				}
 			} else {
 				signature, err = boltzApi.RefundSwap(RefundSwapRequest{
 					Id:          output.SwapId,
@@ -113,7 +190,8 @@ func ConstructTransaction(network *Network, currency Currency, outputs []OutputD
 				})
 			}
 			if err != nil {
-				return nil, 0, fmt.Errorf("could not get partial signature from boltz: %w", err)
+				cooperativeErr.Errors[output.SwapId] = err
+				continue
 			}
 
 			if err := session.Finalize(transaction, outputs, network, signature); err != nil {
@@ -122,5 +200,9 @@ func ConstructTransaction(network *Network, currency Currency, outputs []OutputD
 		}
 	}
 
-	return transaction, fee, err
+	if len(cooperativeErr.Errors) > 0 {
+		return nil, 0, cooperativeErr
+	}
+
+	return transaction, fee, nil
 }
@@ -0,0 +1,22 @@
+package boltz
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCooperativeError(t *testing.T) {
+	err := fmt.Errorf("construct transaction: %w", &CooperativeError{Errors: map[string]error{
+		"second": errors.New("refused"),
+		"first":  errors.New("not eligible"),
+	}})
+
+	var cooperativeErr *CooperativeError
+	require.ErrorAs(t, err, &cooperativeErr)
+	require.Len(t, cooperativeErr.Errors, 2)
+	// sorted, so that the message is stable
+	require.Equal(t, "could not get partial signature from boltz for first: not eligible, second: refused", cooperativeErr.Error())
+}
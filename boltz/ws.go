@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/BoltzExchange/boltz-client/logger"
@@ -12,7 +15,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 )
 
-const reconnectInterval = 15 * time.Second
+var reconnectInterval = 15 * time.Second
 
 type SwapUpdate struct {
 	SwapStatusResponse `mapstructure:",squash"`
@@ -21,11 +24,23 @@ type SwapUpdate struct {
 
 type BoltzWebsocket struct {
 	Updates chan SwapUpdate
+	// receives a value every time the connection is established again after it was lost
+	Reconnects chan bool
 
-	apiUrl        string
+	api           *Boltz
+	endpoint      string
 	subscriptions chan bool
 	conn          *websocket.Conn
-	closed        bool
+	connLock      sync.Mutex
+	// the connection supports only one concurrent writer
+	writeLock sync.Mutex
+	closed    atomic.Bool
+	closing   chan struct{}
+	connected atomic.Bool
+
+	// swaps which have to be subscribed to again after reconnecting
+	swapIds     []string
+	swapIdsLock sync.Mutex
 }
 
 type wsResponse struct {
@@ -35,11 +50,13 @@ type wsResponse struct {
 	Args    []any  `json:"args"`
 }
 
-func NewBoltzWebsocket(apiUrl string) *BoltzWebsocket {
+func NewBoltzWebsocket(api *Boltz) *BoltzWebsocket {
 	ws := &BoltzWebsocket{
-		apiUrl:        apiUrl,
-		subscriptions: make(chan bool),
+		api:           api,
+		subscriptions: make(chan bool, 1),
+		closing:       make(chan struct{}),
 		Updates:       make(chan SwapUpdate),
+		Reconnects:    make(chan bool, 1),
 	}
 
 	return ws
@@ -50,14 +67,27 @@ func (boltz *BoltzWebsocket) SendJson(data any) error {
 	if err != nil {
 		return err
 	}
-	return boltz.conn.WriteMessage(websocket.TextMessage, send)
+	boltz.connLock.Lock()
+	conn := boltz.conn
+	boltz.connLock.Unlock()
+	if conn == nil {
+		return errors.New("websocket is not connected")
+	}
+	return boltz.write(conn, websocket.TextMessage, send)
+}
+
+func (boltz *BoltzWebsocket) write(conn *websocket.Conn, messageType int, data []byte) error {
+	boltz.writeLock.Lock()
+	defer boltz.writeLock.Unlock()
+	return conn.WriteMessage(messageType, data)
 }
 
 func (boltz *BoltzWebsocket) Connect() error {
-	if boltz.closed {
+	if boltz.closed.Load() {
 		return errors.New("websocket is closed")
 	}
-	wsUrl, err := url.Parse(boltz.apiUrl)
+	endpoint := boltz.api.CurrentEndpoint()
+	wsUrl, err := url.Parse(endpoint)
 	if err != nil {
 		return err
 	}
@@ -69,31 +99,49 @@ func (boltz *BoltzWebsocket) Connect() error {
 		wsUrl.Scheme = "ws"
 	}
 
-	conn, _, err := websocket.DefaultDialer.Dial(wsUrl.String(), nil)
-	boltz.conn = conn
+	dialer := *websocket.DefaultDialer
+	if boltz.api.proxy != nil {
+		// a separate service so that the websocket does not share a circuit with the http requests
+		dialer.NetDialContext = boltz.api.proxy.Dialer("boltz-ws").DialContext
+	}
+	conn, _, err := dialer.Dial(wsUrl.String(), nil)
 	if err != nil {
 		return fmt.Errorf("could not connect to boltz ws at %s: %w", wsUrl, err)
 	}
 
+	boltz.connLock.Lock()
+	defer boltz.connLock.Unlock()
+	// the websocket might have been closed while dialing
+	if boltz.closed.Load() {
+		_ = conn.Close()
+		return errors.New("websocket is closed")
+	}
+	boltz.conn = conn
+
 	logger.Infof("Connected to Boltz ws at %s", wsUrl)
+	boltz.endpoint = endpoint
+	boltz.connected.Store(true)
 
 	go func() {
 		for {
 			msgType, message, err := conn.ReadMessage()
 			if err != nil {
-				if boltz.closed {
+				boltz.connected.Store(false)
+				if boltz.closed.Load() {
 					close(boltz.Updates)
 					return
 				}
-				logger.Error("could not receive message: " + err.Error())
-				break
+				logger.Errorf("lost connection to boltz ws: %v", err)
+				// the next connection gets its own reader
+				go boltz.Reconnect()
+				return
 			}
 
 			logger.Silly("Received websocket message: " + string(message))
 
 			switch msgType {
 			case websocket.PingMessage:
-				if err := conn.WriteMessage(websocket.PongMessage, nil); err != nil {
+				if err := boltz.write(conn, websocket.PongMessage, nil); err != nil {
 					logger.Errorf("could not send pong: %s", err)
 				}
 			case websocket.TextMessage:
@@ -116,34 +164,124 @@ func (boltz *BoltzWebsocket) Connect() error {
 							if err := mapstructure.Decode(arg, &update); err != nil {
 								logger.Errorf("invalid boltz response: %v", err)
 							}
-							boltz.Updates <- update
+							select {
+							case boltz.Updates <- update:
+							case <-boltz.closing:
+							}
 						}
 					default:
 						logger.Warnf("unknown update channel: %s", response.Channel)
 					}
 				case "subscribe":
-					boltz.subscriptions <- true
+					select {
+					case boltz.subscriptions <- true:
+					default:
+						// the subscription timed out already
+					}
+					continue
+				case "unsubscribe":
 					continue
 				default:
 					logger.Warnf("unknown event: %s", response.Event)
 				}
 			}
 		}
-		for {
-			logger.Errorf("lost connection to boltz ws, reconnecting in %d", reconnectInterval)
-			time.Sleep(reconnectInterval)
-			err := boltz.Connect()
-			if err == nil {
-				return
-			}
-		}
 	}()
 
 	return nil
 }
 
+// Reconnect tries to connect to the websocket until it succeeds or the websocket is closed
+func (boltz *BoltzWebsocket) Reconnect() {
+	for {
+		logger.Infof("Reconnecting to boltz ws in %s", reconnectInterval)
+		select {
+		case <-time.After(reconnectInterval):
+		case <-boltz.closing:
+			return
+		}
+		// the current endpoint might be down as well, in which case we fail over to another one
+		if err := boltz.api.CheckHealth(); err != nil {
+			logger.Errorf("Could not reconnect to boltz ws: %v", err)
+			continue
+		}
+		if err := boltz.Connect(); err != nil {
+			logger.Errorf("Could not reconnect to boltz ws: %v", err)
+			continue
+		}
+		boltz.reconnected()
+		return
+	}
+}
+
+// reconnected restores the subscriptions which were lost with the previous connection
+func (boltz *BoltzWebsocket) reconnected() {
+	boltz.swapIdsLock.Lock()
+	swapIds := slices.Clone(boltz.swapIds)
+	boltz.swapIdsLock.Unlock()
+
+	if err := boltz.subscribe(swapIds); err != nil {
+		logger.Errorf("could not resubscribe to swaps: %v", err)
+	}
+
+	select {
+	case boltz.Reconnects <- true:
+	default:
+	}
+}
+
+// Connected returns whether updates are currently received from the websocket
+func (boltz *BoltzWebsocket) Connected() bool {
+	return boltz.connected.Load()
+}
+
+// Endpoint returns the endpoint of the Boltz API the websocket was last connected to
+func (boltz *BoltzWebsocket) Endpoint() string {
+	boltz.connLock.Lock()
+	defer boltz.connLock.Unlock()
+	return boltz.endpoint
+}
+
 func (boltz *BoltzWebsocket) Subscribe(swapIds []string) error {
-	if len(swapIds) == 0 {
+	boltz.swapIdsLock.Lock()
+	for _, swapId := range swapIds {
+		if !slices.Contains(boltz.swapIds, swapId) {
+			boltz.swapIds = append(boltz.swapIds, swapId)
+		}
+	}
+	boltz.swapIdsLock.Unlock()
+
+	return boltz.subscribe(swapIds)
+}
+
+// Unsubscribe stops the updates of swaps which reached a final status
+func (boltz *BoltzWebsocket) Unsubscribe(swapIds []string) error {
+	boltz.swapIdsLock.Lock()
+	boltz.swapIds = slices.DeleteFunc(boltz.swapIds, func(swapId string) bool {
+		return slices.Contains(swapIds, swapId)
+	})
+	boltz.swapIdsLock.Unlock()
+
+	if len(swapIds) == 0 || !boltz.Connected() {
+		return nil
+	}
+	return boltz.SendJson(map[string]any{
+		"op":      "unsubscribe",
+		"channel": "swap.update",
+		"args":    swapIds,
+	})
+}
+
+// SwapIds returns the swaps which are subscribed to
+func (boltz *BoltzWebsocket) SwapIds() []string {
+	boltz.swapIdsLock.Lock()
+	defer boltz.swapIdsLock.Unlock()
+	return slices.Clone(boltz.swapIds)
+}
+
+func (boltz *BoltzWebsocket) subscribe(swapIds []string) error {
+	if len(swapIds) == 0 || !boltz.Connected() {
+		// subscriptions are sent once the connection is established again
 		return nil
 	}
 	if err := boltz.SendJson(map[string]any{
@@ -162,6 +300,15 @@ func (boltz *BoltzWebsocket) Subscribe(swapIds []string) error {
 }
 
 func (boltz *BoltzWebsocket) Close() error {
-	boltz.closed = true
+	boltz.connLock.Lock()
+	defer boltz.connLock.Unlock()
+
+	if !boltz.closed.CompareAndSwap(false, true) {
+		return nil
+	}
+	close(boltz.closing)
+	if boltz.conn == nil {
+		return nil
+	}
 	return boltz.conn.Close()
 }
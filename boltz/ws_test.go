@@ -0,0 +1,155 @@
+package boltz_test
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltz/mock"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func createReverseSwap(t *testing.T, api *boltz.Boltz) string {
+	preimage := make([]byte, 32)
+	_, err := rand.Read(preimage)
+	require.NoError(t, err)
+	preimageHash := sha256.Sum256(preimage)
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	response, err := api.CreateReverseSwap(boltz.CreateReverseSwapRequest{
+		From:           boltz.CurrencyBtc,
+		To:             boltz.CurrencyBtc,
+		PreimageHash:   preimageHash[:],
+		ClaimPublicKey: key.PubKey().SerializeCompressed(),
+		InvoiceAmount:  100000,
+	})
+	require.NoError(t, err)
+	return response.Id
+}
+
+// updates buffers the updates of the websocket, which blocks until they are read
+type updates chan boltz.SwapUpdate
+
+func (updates updates) require(t *testing.T, id string, status boltz.SwapUpdateEvent) {
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case update := <-updates:
+			// boltz sends the current status right after subscribing
+			if update.Id == id && update.Status == status.String() {
+				return
+			}
+		case <-timeout:
+			require.Fail(t, "no update received", "%s: %s", id, status)
+			return
+		}
+	}
+}
+
+func (updates updates) requireNone(t *testing.T, id string, status boltz.SwapUpdateEvent) {
+	timeout := time.After(200 * time.Millisecond)
+	for {
+		select {
+		case update := <-updates:
+			if update.Id == id {
+				require.NotEqual(t, status.String(), update.Status, "unexpected update")
+			}
+		case <-timeout:
+			return
+		}
+	}
+}
+
+func setup(t *testing.T) (*mock.Server, *boltz.BoltzWebsocket, updates) {
+	server, err := mock.NewServer(boltz.Regtest)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	ws := boltz.NewBoltzWebsocket(server.Client())
+	require.NoError(t, ws.Connect())
+	t.Cleanup(func() { require.NoError(t, ws.Close()) })
+
+	received := make(updates, 100)
+	go func() {
+		for update := range ws.Updates {
+			received <- update
+		}
+	}()
+	return server, ws, received
+}
+
+func TestWebsocketReconnect(t *testing.T) {
+	server, ws, updates := setup(t)
+	id := createReverseSwap(t, server.Client())
+
+	require.NoError(t, ws.Subscribe([]string{id}))
+	require.NoError(t, server.SetStatus(id, boltz.TransactionMempool))
+	updates.require(t, id, boltz.TransactionMempool)
+
+	server.DisconnectWebsockets()
+	require.Eventually(t, func() bool { return !ws.Connected() }, 5*time.Second, 10*time.Millisecond)
+
+	select {
+	case <-ws.Reconnects:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "websocket did not reconnect")
+	}
+	require.True(t, ws.Connected())
+	require.Equal(t, server.URL(), ws.Endpoint())
+
+	// the subscriptions are restored after reconnecting
+	require.NoError(t, server.SetStatus(id, boltz.TransactionConfirmed))
+	updates.require(t, id, boltz.TransactionConfirmed)
+}
+
+func TestWebsocketUnsubscribe(t *testing.T) {
+	server, ws, updates := setup(t)
+	finished, pending := createReverseSwap(t, server.Client()), createReverseSwap(t, server.Client())
+
+	require.NoError(t, ws.Subscribe([]string{finished, pending}))
+	require.NoError(t, ws.Subscribe([]string{pending}))
+	require.Equal(t, []string{finished, pending}, ws.SwapIds())
+
+	require.NoError(t, ws.Unsubscribe([]string{finished}))
+	require.Equal(t, []string{pending}, ws.SwapIds())
+	// boltz answers in order, so the unsubscription is processed once the subscription is confirmed
+	require.NoError(t, ws.Subscribe([]string{pending}))
+
+	require.NoError(t, server.SetStatus(finished, boltz.InvoiceSettled))
+	updates.requireNone(t, finished, boltz.InvoiceSettled)
+
+	// swaps which were unsubscribed from are not subscribed to again after reconnecting
+	server.DisconnectWebsockets()
+	select {
+	case <-ws.Reconnects:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "websocket did not reconnect")
+	}
+	require.NoError(t, server.SetStatus(finished, boltz.TransactionClaimed))
+	updates.requireNone(t, finished, boltz.TransactionClaimed)
+	require.NoError(t, server.SetStatus(pending, boltz.TransactionMempool))
+	updates.require(t, pending, boltz.TransactionMempool)
+}
+
+func TestWebsocketClose(t *testing.T) {
+	server, err := mock.NewServer(boltz.Regtest)
+	require.NoError(t, err)
+	defer server.Close()
+
+	ws := boltz.NewBoltzWebsocket(server.Client())
+	require.NoError(t, ws.Connect())
+
+	// closing while reconnecting stops the reconnect loop
+	server.DisconnectWebsockets()
+	require.Eventually(t, func() bool { return !ws.Connected() }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, ws.Close())
+	require.NoError(t, ws.Close())
+	require.Error(t, ws.Connect())
+
+	time.Sleep(200 * time.Millisecond)
+	require.False(t, ws.Connected())
+}
@@ -88,6 +88,7 @@ type Currency int32
 const (
 	Currency_BTC  Currency = 0
 	Currency_LBTC Currency = 1
+	Currency_RBTC Currency = 2
 )
 
 // Enum value maps for Currency.
@@ -95,10 +96,12 @@ var (
 	Currency_name = map[int32]string{
 		0: "BTC",
 		1: "LBTC",
+		2: "RBTC",
 	}
 	Currency_value = map[string]int32{
 		"BTC":  0,
 		"LBTC": 1,
+		"RBTC": 2,
 	}
 )
 
@@ -129,6 +132,220 @@ func (Currency) EnumDescriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{1}
 }
 
+type SwapType int32
+
+const (
+	SwapType_SUBMARINE SwapType = 0
+	SwapType_REVERSE   SwapType = 1
+	SwapType_CHAIN     SwapType = 2
+)
+
+// Enum value maps for SwapType.
+var (
+	SwapType_name = map[int32]string{
+		0: "SUBMARINE",
+		1: "REVERSE",
+		2: "CHAIN",
+	}
+	SwapType_value = map[string]int32{
+		"SUBMARINE": 0,
+		"REVERSE":   1,
+		"CHAIN":     2,
+	}
+)
+
+func (x SwapType) Enum() *SwapType {
+	p := new(SwapType)
+	*p = x
+	return p
+}
+
+func (x SwapType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SwapType) Descriptor() protoreflect.EnumDescriptor {
+	return file_boltzrpc_proto_enumTypes[2].Descriptor()
+}
+
+func (SwapType) Type() protoreflect.EnumType {
+	return &file_boltzrpc_proto_enumTypes[2]
+}
+
+func (x SwapType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SwapType.Descriptor instead.
+func (SwapType) EnumDescriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{2}
+}
+
+type ExportFormat int32
+
+const (
+	// One row per swap with a header
+	ExportFormat_CSV ExportFormat = 0
+	// One JSON object per swap and line
+	ExportFormat_JSON_LINES ExportFormat = 1
+	// Balanced transactions of successful and refunded swaps for beancount
+	ExportFormat_BEANCOUNT ExportFormat = 2
+	// Balanced transactions of successful and refunded swaps for ledger
+	ExportFormat_LEDGER ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "CSV",
+		1: "JSON_LINES",
+		2: "BEANCOUNT",
+		3: "LEDGER",
+	}
+	ExportFormat_value = map[string]int32{
+		"CSV":        0,
+		"JSON_LINES": 1,
+		"BEANCOUNT":  2,
+		"LEDGER":     3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_boltzrpc_proto_enumTypes[3].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_boltzrpc_proto_enumTypes[3]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{3}
+}
+
+type SortOrder int32
+
+const (
+	// Oldest swaps first
+	SortOrder_ASCENDING SortOrder = 0
+	// Newest swaps first
+	SortOrder_DESCENDING SortOrder = 1
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "ASCENDING",
+		1: "DESCENDING",
+	}
+	SortOrder_value = map[string]int32{
+		"ASCENDING":  0,
+		"DESCENDING": 1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_boltzrpc_proto_enumTypes[4].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_boltzrpc_proto_enumTypes[4]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{4}
+}
+
+type SwapEventType int32
+
+const (
+	// Boltz reported a new status of the swap
+	SwapEventType_STATUS SwapEventType = 0
+	// The state of the swap changed
+	SwapEventType_STATE              SwapEventType = 1
+	SwapEventType_LOCKUP_TRANSACTION SwapEventType = 2
+	SwapEventType_CLAIM_TRANSACTION  SwapEventType = 3
+	SwapEventType_REFUND_TRANSACTION SwapEventType = 4
+	// A pending claim or refund transaction was bumped
+	SwapEventType_FEE_BUMP SwapEventType = 5
+)
+
+// Enum value maps for SwapEventType.
+var (
+	SwapEventType_name = map[int32]string{
+		0: "STATUS",
+		1: "STATE",
+		2: "LOCKUP_TRANSACTION",
+		3: "CLAIM_TRANSACTION",
+		4: "REFUND_TRANSACTION",
+		5: "FEE_BUMP",
+	}
+	SwapEventType_value = map[string]int32{
+		"STATUS":             0,
+		"STATE":              1,
+		"LOCKUP_TRANSACTION": 2,
+		"CLAIM_TRANSACTION":  3,
+		"REFUND_TRANSACTION": 4,
+		"FEE_BUMP":           5,
+	}
+)
+
+func (x SwapEventType) Enum() *SwapEventType {
+	p := new(SwapEventType)
+	*p = x
+	return p
+}
+
+func (x SwapEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SwapEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_boltzrpc_proto_enumTypes[5].Descriptor()
+}
+
+func (SwapEventType) Type() protoreflect.EnumType {
+	return &file_boltzrpc_proto_enumTypes[5]
+}
+
+func (x SwapEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SwapEventType.Descriptor instead.
+func (SwapEventType) EnumDescriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{5}
+}
+
 type Pair struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -184,6 +401,83 @@ func (x *Pair) GetTo() Currency {
 	return Currency_BTC
 }
 
+// Determines the fees of the onchain transactions of a swap, which are its lockup (if sent from an internal wallet),
+// claim and refund transactions.
+type FeePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Confirmation target in blocks for fee estimations. Defaults to 2
+	ConfTarget *int32 `protobuf:"varint,1,opt,name=conf_target,json=confTarget,proto3,oneof" json:"conf_target,omitempty"`
+	// Fixed fee rate which is used instead of a fee estimation
+	SatPerVbyte *float64 `protobuf:"fixed64,2,opt,name=sat_per_vbyte,json=satPerVbyte,proto3,oneof" json:"sat_per_vbyte,omitempty"`
+	// Maximal fee in satoshis a single transaction of the swap may pay. Transactions exceeding it are not broadcast
+	MaxFee *uint64 `protobuf:"varint,3,opt,name=max_fee,json=maxFee,proto3,oneof" json:"max_fee,omitempty"`
+	// Maximal fee a single transaction of the swap may pay relative to the amount of the swap, in percent
+	MaxFeePercent *float32 `protobuf:"fixed32,4,opt,name=max_fee_percent,json=maxFeePercent,proto3,oneof" json:"max_fee_percent,omitempty"`
+}
+
+func (x *FeePolicy) Reset() {
+	*x = FeePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeePolicy) ProtoMessage() {}
+
+func (x *FeePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeePolicy.ProtoReflect.Descriptor instead.
+func (*FeePolicy) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeePolicy) GetConfTarget() int32 {
+	if x != nil && x.ConfTarget != nil {
+		return *x.ConfTarget
+	}
+	return 0
+}
+
+func (x *FeePolicy) GetSatPerVbyte() float64 {
+	if x != nil && x.SatPerVbyte != nil {
+		return *x.SatPerVbyte
+	}
+	return 0
+}
+
+func (x *FeePolicy) GetMaxFee() uint64 {
+	if x != nil && x.MaxFee != nil {
+		return *x.MaxFee
+	}
+	return 0
+}
+
+func (x *FeePolicy) GetMaxFeePercent() float32 {
+	if x != nil && x.MaxFeePercent != nil {
+		return *x.MaxFeePercent
+	}
+	return 0
+}
+
 type SwapInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -214,12 +508,21 @@ type SwapInfo struct {
 	OnchainFee          *uint64      `protobuf:"varint,18,opt,name=onchain_fee,json=onchainFee,proto3,oneof" json:"onchain_fee,omitempty"`
 	// internal wallet which was used to pay the swap
 	Wallet *string `protobuf:"bytes,20,opt,name=wallet,proto3,oneof" json:"wallet,omitempty"`
+	// Whether the refund transaction was signed cooperatively with Boltz or spent the timeout leaf. Only set once refunded
+	RefundCooperative *bool `protobuf:"varint,23,opt,name=refund_cooperative,json=refundCooperative,proto3,oneof" json:"refund_cooperative,omitempty"`
+	// Error returned by Boltz when a cooperative refund was attempted. Subsequent refunds will use the timeout leaf
+	CooperativeError *string    `protobuf:"bytes,24,opt,name=cooperative_error,json=cooperativeError,proto3,oneof" json:"cooperative_error,omitempty"`
+	FeePolicy        *FeePolicy `protobuf:"bytes,25,opt,name=fee_policy,json=feePolicy,proto3,oneof" json:"fee_policy,omitempty"`
+	// Estimated unix timestamp at which the timeout block height is reached. Only set for pending swaps
+	TimeoutEta *int64  `protobuf:"varint,26,opt,name=timeout_eta,json=timeoutEta,proto3,oneof" json:"timeout_eta,omitempty"`
+	Label      *string `protobuf:"bytes,27,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	ExternalId *string `protobuf:"bytes,28,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
 }
 
 func (x *SwapInfo) Reset() {
 	*x = SwapInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[1]
+		mi := &file_boltzrpc_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -232,7 +535,7 @@ func (x *SwapInfo) String() string {
 func (*SwapInfo) ProtoMessage() {}
 
 func (x *SwapInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[1]
+	mi := &file_boltzrpc_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -245,7 +548,7 @@ func (x *SwapInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SwapInfo.ProtoReflect.Descriptor instead.
 func (*SwapInfo) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{1}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *SwapInfo) GetId() string {
@@ -395,6 +698,48 @@ func (x *SwapInfo) GetWallet() string {
 	return ""
 }
 
+func (x *SwapInfo) GetRefundCooperative() bool {
+	if x != nil && x.RefundCooperative != nil {
+		return *x.RefundCooperative
+	}
+	return false
+}
+
+func (x *SwapInfo) GetCooperativeError() string {
+	if x != nil && x.CooperativeError != nil {
+		return *x.CooperativeError
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetFeePolicy() *FeePolicy {
+	if x != nil {
+		return x.FeePolicy
+	}
+	return nil
+}
+
+func (x *SwapInfo) GetTimeoutEta() int64 {
+	if x != nil && x.TimeoutEta != nil {
+		return *x.TimeoutEta
+	}
+	return 0
+}
+
+func (x *SwapInfo) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *SwapInfo) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
 // Channel creations are an optional extension to a submarine swap in the data types of boltz-client.
 //
 // Deprecated: Marked as deprecated in boltzrpc.proto.
@@ -415,7 +760,7 @@ type ChannelCreationInfo struct {
 func (x *ChannelCreationInfo) Reset() {
 	*x = ChannelCreationInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[2]
+		mi := &file_boltzrpc_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -428,7 +773,7 @@ func (x *ChannelCreationInfo) String() string {
 func (*ChannelCreationInfo) ProtoMessage() {}
 
 func (x *ChannelCreationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[2]
+	mi := &file_boltzrpc_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +786,7 @@ func (x *ChannelCreationInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelCreationInfo.ProtoReflect.Descriptor instead.
 func (*ChannelCreationInfo) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{2}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ChannelCreationInfo) GetSwapId() string {
@@ -499,7 +844,7 @@ type CombinedChannelSwapInfo struct {
 func (x *CombinedChannelSwapInfo) Reset() {
 	*x = CombinedChannelSwapInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[3]
+		mi := &file_boltzrpc_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +857,7 @@ func (x *CombinedChannelSwapInfo) String() string {
 func (*CombinedChannelSwapInfo) ProtoMessage() {}
 
 func (x *CombinedChannelSwapInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[3]
+	mi := &file_boltzrpc_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +870,7 @@ func (x *CombinedChannelSwapInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CombinedChannelSwapInfo.ProtoReflect.Descriptor instead.
 func (*CombinedChannelSwapInfo) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{3}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CombinedChannelSwapInfo) GetSwap() *SwapInfo {
@@ -569,12 +914,17 @@ type ReverseSwapInfo struct {
 	OnchainFee          *uint64      `protobuf:"varint,19,opt,name=onchain_fee,json=onchainFee,proto3,oneof" json:"onchain_fee,omitempty"`
 	RoutingFeeMsat      *uint64      `protobuf:"varint,20,opt,name=routing_fee_msat,json=routingFeeMsat,proto3,oneof" json:"routing_fee_msat,omitempty"`
 	ExternalPay         bool         `protobuf:"varint,21,opt,name=external_pay,json=externalPay,proto3" json:"external_pay,omitempty"`
+	FeePolicy           *FeePolicy   `protobuf:"bytes,22,opt,name=fee_policy,json=feePolicy,proto3,oneof" json:"fee_policy,omitempty"`
+	// Estimated unix timestamp at which the timeout block height is reached. Only set for pending swaps
+	TimeoutEta *int64  `protobuf:"varint,23,opt,name=timeout_eta,json=timeoutEta,proto3,oneof" json:"timeout_eta,omitempty"`
+	Label      *string `protobuf:"bytes,24,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	ExternalId *string `protobuf:"bytes,25,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
 }
 
 func (x *ReverseSwapInfo) Reset() {
 	*x = ReverseSwapInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[4]
+		mi := &file_boltzrpc_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -587,7 +937,7 @@ func (x *ReverseSwapInfo) String() string {
 func (*ReverseSwapInfo) ProtoMessage() {}
 
 func (x *ReverseSwapInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[4]
+	mi := &file_boltzrpc_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,7 +950,7 @@ func (x *ReverseSwapInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReverseSwapInfo.ProtoReflect.Descriptor instead.
 func (*ReverseSwapInfo) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{4}
+	return file_boltzrpc_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ReverseSwapInfo) GetId() string {
@@ -750,69 +1100,62 @@ func (x *ReverseSwapInfo) GetExternalPay() bool {
 	return false
 }
 
-type BlockHeights struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Btc    uint32  `protobuf:"varint,1,opt,name=btc,proto3" json:"btc,omitempty"`
-	Liquid *uint32 `protobuf:"varint,2,opt,name=liquid,proto3,oneof" json:"liquid,omitempty"`
-}
-
-func (x *BlockHeights) Reset() {
-	*x = BlockHeights{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReverseSwapInfo) GetFeePolicy() *FeePolicy {
+	if x != nil {
+		return x.FeePolicy
 	}
+	return nil
 }
 
-func (x *BlockHeights) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BlockHeights) ProtoMessage() {}
-
-func (x *BlockHeights) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReverseSwapInfo) GetTimeoutEta() int64 {
+	if x != nil && x.TimeoutEta != nil {
+		return *x.TimeoutEta
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use BlockHeights.ProtoReflect.Descriptor instead.
-func (*BlockHeights) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{5}
+	return 0
 }
 
-func (x *BlockHeights) GetBtc() uint32 {
-	if x != nil {
-		return x.Btc
+func (x *ReverseSwapInfo) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
 	}
-	return 0
+	return ""
 }
 
-func (x *BlockHeights) GetLiquid() uint32 {
-	if x != nil && x.Liquid != nil {
-		return *x.Liquid
+func (x *ReverseSwapInfo) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
 	}
-	return 0
+	return ""
 }
 
-type GetInfoRequest struct {
+type ChainSwapInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-}
 
-func (x *GetInfoRequest) Reset() {
-	*x = GetInfoRequest{}
+	Id    string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pair  *Pair     `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	State SwapState `protobuf:"varint,3,opt,name=state,proto3,enum=boltzrpc.SwapState" json:"state,omitempty"`
+	Error string    `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// Latest status message of the Boltz backend
+	Status            string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Preimage          string  `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	IsAuto            bool    `protobuf:"varint,8,opt,name=is_auto,json=isAuto,proto3" json:"is_auto,omitempty"`
+	ServiceFee        *uint64 `protobuf:"varint,9,opt,name=service_fee,json=serviceFee,proto3,oneof" json:"service_fee,omitempty"`
+	ServiceFeePercent float64 `protobuf:"fixed64,10,opt,name=service_fee_percent,json=serviceFeePercent,proto3" json:"service_fee_percent,omitempty"`
+	OnchainFee        *uint64 `protobuf:"varint,11,opt,name=onchain_fee,json=onchainFee,proto3,oneof" json:"onchain_fee,omitempty"`
+	CreatedAt         int64   `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// data about the onchain side the coins are sent from
+	FromData *ChainSwapData `protobuf:"bytes,13,opt,name=from_data,json=fromData,proto3" json:"from_data,omitempty"`
+	// data about the onchain side the coins are received on
+	ToData         *ChainSwapData `protobuf:"bytes,14,opt,name=to_data,json=toData,proto3" json:"to_data,omitempty"`
+	AcceptZeroConf bool           `protobuf:"varint,15,opt,name=accept_zero_conf,json=acceptZeroConf,proto3" json:"accept_zero_conf,omitempty"`
+	Label          *string        `protobuf:"bytes,16,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	ExternalId     *string        `protobuf:"bytes,17,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+}
+
+func (x *ChainSwapInfo) Reset() {
+	*x = ChainSwapInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_boltzrpc_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -820,13 +1163,13 @@ func (x *GetInfoRequest) Reset() {
 	}
 }
 
-func (x *GetInfoRequest) String() string {
+func (x *ChainSwapInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfoRequest) ProtoMessage() {}
+func (*ChainSwapInfo) ProtoMessage() {}
 
-func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *ChainSwapInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_boltzrpc_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -838,177 +1181,163 @@ func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChainSwapInfo.ProtoReflect.Descriptor instead.
+func (*ChainSwapInfo) Descriptor() ([]byte, []int) {
 	return file_boltzrpc_proto_rawDescGZIP(), []int{6}
 }
 
-type GetInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Version    string `protobuf:"bytes,9,opt,name=version,proto3" json:"version,omitempty"`
-	Node       string `protobuf:"bytes,10,opt,name=node,proto3" json:"node,omitempty"`
-	Network    string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
-	NodePubkey string `protobuf:"bytes,7,opt,name=node_pubkey,json=nodePubkey,proto3" json:"node_pubkey,omitempty"`
-	// one of: running, disabled, error
-	AutoSwapStatus string `protobuf:"bytes,11,opt,name=auto_swap_status,json=autoSwapStatus,proto3" json:"auto_swap_status,omitempty"`
-	// mapping of the currency to the latest block height.
-	BlockHeights *BlockHeights `protobuf:"bytes,8,opt,name=block_heights,json=blockHeights,proto3" json:"block_heights,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	LndPubkey string `protobuf:"bytes,3,opt,name=lnd_pubkey,json=lndPubkey,proto3" json:"lnd_pubkey,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	BlockHeight uint32 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	PendingSwaps []string `protobuf:"bytes,5,rep,name=pending_swaps,json=pendingSwaps,proto3" json:"pending_swaps,omitempty"`
-	// Deprecated: Marked as deprecated in boltzrpc.proto.
-	PendingReverseSwaps []string `protobuf:"bytes,6,rep,name=pending_reverse_swaps,json=pendingReverseSwaps,proto3" json:"pending_reverse_swaps,omitempty"`
-}
-
-func (x *GetInfoResponse) Reset() {
-	*x = GetInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ChainSwapInfo) GetId() string {
+	if x != nil {
+		return x.Id
 	}
+	return ""
 }
 
-func (x *GetInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetInfoResponse) ProtoMessage() {}
-
-func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ChainSwapInfo) GetPair() *Pair {
+	if x != nil {
+		return x.Pair
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{7}
+	return nil
 }
 
-func (x *GetInfoResponse) GetVersion() string {
+func (x *ChainSwapInfo) GetState() SwapState {
 	if x != nil {
-		return x.Version
+		return x.State
 	}
-	return ""
+	return SwapState_PENDING
 }
 
-func (x *GetInfoResponse) GetNode() string {
+func (x *ChainSwapInfo) GetError() string {
 	if x != nil {
-		return x.Node
+		return x.Error
 	}
 	return ""
 }
 
-func (x *GetInfoResponse) GetNetwork() string {
+func (x *ChainSwapInfo) GetStatus() string {
 	if x != nil {
-		return x.Network
+		return x.Status
 	}
 	return ""
 }
 
-func (x *GetInfoResponse) GetNodePubkey() string {
+func (x *ChainSwapInfo) GetPreimage() string {
 	if x != nil {
-		return x.NodePubkey
+		return x.Preimage
 	}
 	return ""
 }
 
-func (x *GetInfoResponse) GetAutoSwapStatus() string {
+func (x *ChainSwapInfo) GetIsAuto() bool {
 	if x != nil {
-		return x.AutoSwapStatus
+		return x.IsAuto
 	}
-	return ""
+	return false
 }
 
-func (x *GetInfoResponse) GetBlockHeights() *BlockHeights {
-	if x != nil {
-		return x.BlockHeights
+func (x *ChainSwapInfo) GetServiceFee() uint64 {
+	if x != nil && x.ServiceFee != nil {
+		return *x.ServiceFee
 	}
-	return nil
+	return 0
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *GetInfoResponse) GetSymbol() string {
+func (x *ChainSwapInfo) GetServiceFeePercent() float64 {
 	if x != nil {
-		return x.Symbol
+		return x.ServiceFeePercent
 	}
-	return ""
+	return 0
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *GetInfoResponse) GetLndPubkey() string {
-	if x != nil {
-		return x.LndPubkey
+func (x *ChainSwapInfo) GetOnchainFee() uint64 {
+	if x != nil && x.OnchainFee != nil {
+		return *x.OnchainFee
 	}
-	return ""
+	return 0
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *GetInfoResponse) GetBlockHeight() uint32 {
+func (x *ChainSwapInfo) GetCreatedAt() int64 {
 	if x != nil {
-		return x.BlockHeight
+		return x.CreatedAt
 	}
 	return 0
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *GetInfoResponse) GetPendingSwaps() []string {
+func (x *ChainSwapInfo) GetFromData() *ChainSwapData {
 	if x != nil {
-		return x.PendingSwaps
+		return x.FromData
 	}
 	return nil
 }
 
-// Deprecated: Marked as deprecated in boltzrpc.proto.
-func (x *GetInfoResponse) GetPendingReverseSwaps() []string {
+func (x *ChainSwapInfo) GetToData() *ChainSwapData {
 	if x != nil {
-		return x.PendingReverseSwaps
+		return x.ToData
 	}
 	return nil
 }
 
-type Limits struct {
+func (x *ChainSwapInfo) GetAcceptZeroConf() bool {
+	if x != nil {
+		return x.AcceptZeroConf
+	}
+	return false
+}
+
+func (x *ChainSwapInfo) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *ChainSwapInfo) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+type ChainSwapData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Minimal               uint64 `protobuf:"varint,1,opt,name=minimal,proto3" json:"minimal,omitempty"`
-	Maximal               uint64 `protobuf:"varint,2,opt,name=maximal,proto3" json:"maximal,omitempty"`
-	MaximalZeroConfAmount uint64 `protobuf:"varint,3,opt,name=maximal_zero_conf_amount,json=maximalZeroConfAmount,proto3" json:"maximal_zero_conf_amount,omitempty"`
-}
-
-func (x *Limits) Reset() {
-	*x = Limits{}
+	Id                  string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Currency            Currency `protobuf:"varint,2,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
+	PrivateKey          string   `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	TheirPublicKey      string   `protobuf:"bytes,4,opt,name=their_public_key,json=theirPublicKey,proto3" json:"their_public_key,omitempty"`
+	Amount              uint64   `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	TimeoutBlockHeight  uint32   `protobuf:"varint,6,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
+	LockupTransactionId *string  `protobuf:"bytes,7,opt,name=lockup_transaction_id,json=lockupTransactionId,proto3,oneof" json:"lockup_transaction_id,omitempty"`
+	TransactionId       *string  `protobuf:"bytes,8,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	// internal wallet which was used to pay the lockup or receive the coins
+	Wallet *string `protobuf:"bytes,9,opt,name=wallet,proto3,oneof" json:"wallet,omitempty"`
+	// address where the coins are sent to (claim) or returned to (refund)
+	Address       *string `protobuf:"bytes,10,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	BlindingKey   *string `protobuf:"bytes,11,opt,name=blinding_key,json=blindingKey,proto3,oneof" json:"blinding_key,omitempty"`
+	LockupAddress string  `protobuf:"bytes,12,opt,name=lockup_address,json=lockupAddress,proto3" json:"lockup_address,omitempty"`
+	// Estimated unix timestamp at which the timeout block height is reached. Only set for pending swaps
+	TimeoutEta *int64 `protobuf:"varint,13,opt,name=timeout_eta,json=timeoutEta,proto3,oneof" json:"timeout_eta,omitempty"`
+}
+
+func (x *ChainSwapData) Reset() {
+	*x = ChainSwapData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[8]
+		mi := &file_boltzrpc_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Limits) String() string {
+func (x *ChainSwapData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Limits) ProtoMessage() {}
+func (*ChainSwapData) ProtoMessage() {}
 
-func (x *Limits) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[8]
+func (x *ChainSwapData) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,142 +1348,128 @@ func (x *Limits) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Limits.ProtoReflect.Descriptor instead.
-func (*Limits) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ChainSwapData.ProtoReflect.Descriptor instead.
+func (*ChainSwapData) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Limits) GetMinimal() uint64 {
+func (x *ChainSwapData) GetId() string {
 	if x != nil {
-		return x.Minimal
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *Limits) GetMaximal() uint64 {
+func (x *ChainSwapData) GetCurrency() Currency {
 	if x != nil {
-		return x.Maximal
+		return x.Currency
 	}
-	return 0
+	return Currency_BTC
 }
 
-func (x *Limits) GetMaximalZeroConfAmount() uint64 {
+func (x *ChainSwapData) GetPrivateKey() string {
 	if x != nil {
-		return x.MaximalZeroConfAmount
+		return x.PrivateKey
 	}
-	return 0
+	return ""
 }
 
-// Submarine Pair
-type SubmarinePair struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Pair   *Pair               `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
-	Hash   string              `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	Rate   float32             `protobuf:"fixed32,3,opt,name=rate,proto3" json:"rate,omitempty"`
-	Limits *Limits             `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
-	Fees   *SubmarinePair_Fees `protobuf:"bytes,5,opt,name=fees,proto3" json:"fees,omitempty"`
+func (x *ChainSwapData) GetTheirPublicKey() string {
+	if x != nil {
+		return x.TheirPublicKey
+	}
+	return ""
 }
 
-func (x *SubmarinePair) Reset() {
-	*x = SubmarinePair{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ChainSwapData) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
 	}
+	return 0
 }
 
-func (x *SubmarinePair) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ChainSwapData) GetTimeoutBlockHeight() uint32 {
+	if x != nil {
+		return x.TimeoutBlockHeight
+	}
+	return 0
 }
 
-func (*SubmarinePair) ProtoMessage() {}
-
-func (x *SubmarinePair) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ChainSwapData) GetLockupTransactionId() string {
+	if x != nil && x.LockupTransactionId != nil {
+		return *x.LockupTransactionId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SubmarinePair.ProtoReflect.Descriptor instead.
-func (*SubmarinePair) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{9}
+func (x *ChainSwapData) GetTransactionId() string {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return ""
 }
 
-func (x *SubmarinePair) GetPair() *Pair {
-	if x != nil {
-		return x.Pair
+func (x *ChainSwapData) GetWallet() string {
+	if x != nil && x.Wallet != nil {
+		return *x.Wallet
 	}
-	return nil
+	return ""
 }
 
-func (x *SubmarinePair) GetHash() string {
-	if x != nil {
-		return x.Hash
+func (x *ChainSwapData) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
 	}
 	return ""
 }
 
-func (x *SubmarinePair) GetRate() float32 {
-	if x != nil {
-		return x.Rate
+func (x *ChainSwapData) GetBlindingKey() string {
+	if x != nil && x.BlindingKey != nil {
+		return *x.BlindingKey
 	}
-	return 0
+	return ""
 }
 
-func (x *SubmarinePair) GetLimits() *Limits {
+func (x *ChainSwapData) GetLockupAddress() string {
 	if x != nil {
-		return x.Limits
+		return x.LockupAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *SubmarinePair) GetFees() *SubmarinePair_Fees {
-	if x != nil {
-		return x.Fees
+func (x *ChainSwapData) GetTimeoutEta() int64 {
+	if x != nil && x.TimeoutEta != nil {
+		return *x.TimeoutEta
 	}
-	return nil
+	return 0
 }
 
-// Reverse Pair
-type ReversePair struct {
+type BlockHeights struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pair   *Pair             `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
-	Hash   string            `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	Rate   float32           `protobuf:"fixed32,3,opt,name=rate,proto3" json:"rate,omitempty"`
-	Limits *Limits           `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
-	Fees   *ReversePair_Fees `protobuf:"bytes,5,opt,name=fees,proto3" json:"fees,omitempty"`
+	Btc    uint32  `protobuf:"varint,1,opt,name=btc,proto3" json:"btc,omitempty"`
+	Liquid *uint32 `protobuf:"varint,2,opt,name=liquid,proto3,oneof" json:"liquid,omitempty"`
 }
 
-func (x *ReversePair) Reset() {
-	*x = ReversePair{}
+func (x *BlockHeights) Reset() {
+	*x = BlockHeights{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[10]
+		mi := &file_boltzrpc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReversePair) String() string {
+func (x *BlockHeights) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReversePair) ProtoMessage() {}
+func (*BlockHeights) ProtoMessage() {}
 
-func (x *ReversePair) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[10]
+func (x *BlockHeights) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1165,72 +1480,1727 @@ func (x *ReversePair) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReversePair.ProtoReflect.Descriptor instead.
-func (*ReversePair) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{10}
-}
-
+// Deprecated: Use BlockHeights.ProtoReflect.Descriptor instead.
+func (*BlockHeights) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BlockHeights) GetBtc() uint32 {
+	if x != nil {
+		return x.Btc
+	}
+	return 0
+}
+
+func (x *BlockHeights) GetLiquid() uint32 {
+	if x != nil && x.Liquid != nil {
+		return *x.Liquid
+	}
+	return 0
+}
+
+type GetInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoRequest) ProtoMessage() {}
+
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{9}
+}
+
+type GetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version    string `protobuf:"bytes,9,opt,name=version,proto3" json:"version,omitempty"`
+	Node       string `protobuf:"bytes,10,opt,name=node,proto3" json:"node,omitempty"`
+	Network    string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	NodePubkey string `protobuf:"bytes,7,opt,name=node_pubkey,json=nodePubkey,proto3" json:"node_pubkey,omitempty"`
+	// one of: running, disabled, error
+	AutoSwapStatus string `protobuf:"bytes,11,opt,name=auto_swap_status,json=autoSwapStatus,proto3" json:"auto_swap_status,omitempty"`
+	// mapping of the currency to the latest block height.
+	BlockHeights *BlockHeights `protobuf:"bytes,8,opt,name=block_heights,json=blockHeights,proto3" json:"block_heights,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	LndPubkey string `protobuf:"bytes,3,opt,name=lnd_pubkey,json=lndPubkey,proto3" json:"lnd_pubkey,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	BlockHeight uint32 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	PendingSwaps []string `protobuf:"bytes,5,rep,name=pending_swaps,json=pendingSwaps,proto3" json:"pending_swaps,omitempty"`
+	// Deprecated: Marked as deprecated in boltzrpc.proto.
+	PendingReverseSwaps []string `protobuf:"bytes,6,rep,name=pending_reverse_swaps,json=pendingReverseSwaps,proto3" json:"pending_reverse_swaps,omitempty"`
+}
+
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse) ProtoMessage() {}
+
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetNode() string {
+	if x != nil {
+		return x.Node
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetNodePubkey() string {
+	if x != nil {
+		return x.NodePubkey
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetAutoSwapStatus() string {
+	if x != nil {
+		return x.AutoSwapStatus
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetBlockHeights() *BlockHeights {
+	if x != nil {
+		return x.BlockHeights
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *GetInfoResponse) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *GetInfoResponse) GetLndPubkey() string {
+	if x != nil {
+		return x.LndPubkey
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *GetInfoResponse) GetBlockHeight() uint32 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *GetInfoResponse) GetPendingSwaps() []string {
+	if x != nil {
+		return x.PendingSwaps
+	}
+	return nil
+}
+
+// Deprecated: Marked as deprecated in boltzrpc.proto.
+func (x *GetInfoResponse) GetPendingReverseSwaps() []string {
+	if x != nil {
+		return x.PendingReverseSwaps
+	}
+	return nil
+}
+
+type Limits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Minimal               uint64 `protobuf:"varint,1,opt,name=minimal,proto3" json:"minimal,omitempty"`
+	Maximal               uint64 `protobuf:"varint,2,opt,name=maximal,proto3" json:"maximal,omitempty"`
+	MaximalZeroConfAmount uint64 `protobuf:"varint,3,opt,name=maximal_zero_conf_amount,json=maximalZeroConfAmount,proto3" json:"maximal_zero_conf_amount,omitempty"`
+}
+
+func (x *Limits) Reset() {
+	*x = Limits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Limits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Limits) ProtoMessage() {}
+
+func (x *Limits) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Limits.ProtoReflect.Descriptor instead.
+func (*Limits) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Limits) GetMinimal() uint64 {
+	if x != nil {
+		return x.Minimal
+	}
+	return 0
+}
+
+func (x *Limits) GetMaximal() uint64 {
+	if x != nil {
+		return x.Maximal
+	}
+	return 0
+}
+
+func (x *Limits) GetMaximalZeroConfAmount() uint64 {
+	if x != nil {
+		return x.MaximalZeroConfAmount
+	}
+	return 0
+}
+
+// Submarine Pair
+type SubmarinePair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair   *Pair               `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Hash   string              `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Rate   float32             `protobuf:"fixed32,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Limits *Limits             `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+	Fees   *SubmarinePair_Fees `protobuf:"bytes,5,opt,name=fees,proto3" json:"fees,omitempty"`
+}
+
+func (x *SubmarinePair) Reset() {
+	*x = SubmarinePair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmarinePair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmarinePair) ProtoMessage() {}
+
+func (x *SubmarinePair) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmarinePair.ProtoReflect.Descriptor instead.
+func (*SubmarinePair) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SubmarinePair) GetPair() *Pair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *SubmarinePair) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *SubmarinePair) GetRate() float32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *SubmarinePair) GetLimits() *Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *SubmarinePair) GetFees() *SubmarinePair_Fees {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+// Reverse Pair
+type ReversePair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair   *Pair             `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Hash   string            `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Rate   float32           `protobuf:"fixed32,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Limits *Limits           `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+	Fees   *ReversePair_Fees `protobuf:"bytes,5,opt,name=fees,proto3" json:"fees,omitempty"`
+}
+
+func (x *ReversePair) Reset() {
+	*x = ReversePair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReversePair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReversePair) ProtoMessage() {}
+
+func (x *ReversePair) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReversePair.ProtoReflect.Descriptor instead.
+func (*ReversePair) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{13}
+}
+
 func (x *ReversePair) GetPair() *Pair {
 	if x != nil {
-		return x.Pair
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ReversePair) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ReversePair) GetRate() float32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ReversePair) GetLimits() *Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *ReversePair) GetFees() *ReversePair_Fees {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+// Chain Pair
+type ChainPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pair   *Pair           `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	Hash   string          `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Rate   float32         `protobuf:"fixed32,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Limits *Limits         `protobuf:"bytes,4,opt,name=limits,proto3" json:"limits,omitempty"`
+	Fees   *ChainPair_Fees `protobuf:"bytes,5,opt,name=fees,proto3" json:"fees,omitempty"`
+}
+
+func (x *ChainPair) Reset() {
+	*x = ChainPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainPair) ProtoMessage() {}
+
+func (x *ChainPair) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainPair.ProtoReflect.Descriptor instead.
+func (*ChainPair) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChainPair) GetPair() *Pair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ChainPair) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *ChainPair) GetRate() float32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ChainPair) GetLimits() *Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *ChainPair) GetFees() *ChainPair_Fees {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+type GetPairsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submarine []*SubmarinePair `protobuf:"bytes,1,rep,name=submarine,proto3" json:"submarine,omitempty"`
+	Reverse   []*ReversePair   `protobuf:"bytes,2,rep,name=reverse,proto3" json:"reverse,omitempty"`
+	Chain     []*ChainPair     `protobuf:"bytes,3,rep,name=chain,proto3" json:"chain,omitempty"`
+}
+
+func (x *GetPairsResponse) Reset() {
+	*x = GetPairsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPairsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPairsResponse) ProtoMessage() {}
+
+func (x *GetPairsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPairsResponse.ProtoReflect.Descriptor instead.
+func (*GetPairsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetPairsResponse) GetSubmarine() []*SubmarinePair {
+	if x != nil {
+		return x.Submarine
+	}
+	return nil
+}
+
+func (x *GetPairsResponse) GetReverse() []*ReversePair {
+	if x != nil {
+		return x.Reverse
+	}
+	return nil
+}
+
+func (x *GetPairsResponse) GetChain() []*ChainPair {
+	if x != nil {
+		return x.Chain
+	}
+	return nil
+}
+
+type GetQuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type SwapType `protobuf:"varint,1,opt,name=type,proto3,enum=boltzrpc.SwapType" json:"type,omitempty"`
+	Pair *Pair    `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// Types that are assignable to Amount:
+	//	*GetQuoteRequest_SendAmount
+	//	*GetQuoteRequest_ReceiveAmount
+	Amount isGetQuoteRequest_Amount `protobuf_oneof:"amount"`
+}
+
+func (x *GetQuoteRequest) Reset() {
+	*x = GetQuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuoteRequest) ProtoMessage() {}
+
+func (x *GetQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetQuoteRequest) GetType() SwapType {
+	if x != nil {
+		return x.Type
+	}
+	return SwapType_SUBMARINE
+}
+
+func (x *GetQuoteRequest) GetPair() *Pair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (m *GetQuoteRequest) GetAmount() isGetQuoteRequest_Amount {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (x *GetQuoteRequest) GetSendAmount() uint64 {
+	if x, ok := x.GetAmount().(*GetQuoteRequest_SendAmount); ok {
+		return x.SendAmount
+	}
+	return 0
+}
+
+func (x *GetQuoteRequest) GetReceiveAmount() uint64 {
+	if x, ok := x.GetAmount().(*GetQuoteRequest_ReceiveAmount); ok {
+		return x.ReceiveAmount
+	}
+	return 0
+}
+
+type isGetQuoteRequest_Amount interface {
+	isGetQuoteRequest_Amount()
+}
+
+type GetQuoteRequest_SendAmount struct {
+	// Amount we send: the lockup for submarine and chain swaps, the invoice for reverse swaps
+	SendAmount uint64 `protobuf:"varint,3,opt,name=send_amount,json=sendAmount,proto3,oneof"`
+}
+
+type GetQuoteRequest_ReceiveAmount struct {
+	// Amount we receive: the invoice for submarine swaps, the claimed output for reverse and chain swaps
+	ReceiveAmount uint64 `protobuf:"varint,4,opt,name=receive_amount,json=receiveAmount,proto3,oneof"`
+}
+
+func (*GetQuoteRequest_SendAmount) isGetQuoteRequest_Amount() {}
+
+func (*GetQuoteRequest_ReceiveAmount) isGetQuoteRequest_Amount() {}
+
+type GetQuoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendAmount    uint64 `protobuf:"varint,1,opt,name=send_amount,json=sendAmount,proto3" json:"send_amount,omitempty"`
+	ReceiveAmount uint64 `protobuf:"varint,2,opt,name=receive_amount,json=receiveAmount,proto3" json:"receive_amount,omitempty"`
+	// Amount of the lightning invoice. Not set for chain swaps
+	InvoiceAmount *uint64 `protobuf:"varint,3,opt,name=invoice_amount,json=invoiceAmount,proto3,oneof" json:"invoice_amount,omitempty"`
+	// Amount of the onchain lockup: ours for submarine swaps, the one of boltz for reverse and chain swaps
+	OnchainAmount uint64 `protobuf:"varint,4,opt,name=onchain_amount,json=onchainAmount,proto3" json:"onchain_amount,omitempty"`
+	ServiceFee    uint64 `protobuf:"varint,5,opt,name=service_fee,json=serviceFee,proto3" json:"service_fee,omitempty"`
+	// Miner fees boltz charges for its own onchain transactions
+	BoltzMinerFee uint64 `protobuf:"varint,6,opt,name=boltz_miner_fee,json=boltzMinerFee,proto3" json:"boltz_miner_fee,omitempty"`
+	// Estimated fee of our claim transaction, or of our refund transaction for submarine swaps.
+	// Only the claim fee is deducted from the received amount
+	NetworkFee uint64 `protobuf:"varint,7,opt,name=network_fee,json=networkFee,proto3" json:"network_fee,omitempty"`
+	// Hash of the pair the quote is based on. Fees might have changed if it does not match the current hash
+	PairHash string `protobuf:"bytes,8,opt,name=pair_hash,json=pairHash,proto3" json:"pair_hash,omitempty"`
+}
+
+func (x *GetQuoteResponse) Reset() {
+	*x = GetQuoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuoteResponse) ProtoMessage() {}
+
+func (x *GetQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetQuoteResponse) GetSendAmount() uint64 {
+	if x != nil {
+		return x.SendAmount
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetReceiveAmount() uint64 {
+	if x != nil {
+		return x.ReceiveAmount
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetInvoiceAmount() uint64 {
+	if x != nil && x.InvoiceAmount != nil {
+		return *x.InvoiceAmount
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetOnchainAmount() uint64 {
+	if x != nil {
+		return x.OnchainAmount
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetServiceFee() uint64 {
+	if x != nil {
+		return x.ServiceFee
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetBoltzMinerFee() uint64 {
+	if x != nil {
+		return x.BoltzMinerFee
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetNetworkFee() uint64 {
+	if x != nil {
+		return x.NetworkFee
+	}
+	return 0
+}
+
+func (x *GetQuoteResponse) GetPairHash() string {
+	if x != nil {
+		return x.PairHash
+	}
+	return ""
+}
+
+type MinerFees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Normal  uint32 `protobuf:"varint,1,opt,name=normal,proto3" json:"normal,omitempty"`
+	Reverse uint32 `protobuf:"varint,2,opt,name=reverse,proto3" json:"reverse,omitempty"`
+}
+
+func (x *MinerFees) Reset() {
+	*x = MinerFees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinerFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinerFees) ProtoMessage() {}
+
+func (x *MinerFees) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinerFees.ProtoReflect.Descriptor instead.
+func (*MinerFees) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MinerFees) GetNormal() uint32 {
+	if x != nil {
+		return x.Normal
+	}
+	return 0
+}
+
+func (x *MinerFees) GetReverse() uint32 {
+	if x != nil {
+		return x.Reverse
+	}
+	return 0
+}
+
+type Fees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Percentage float32    `protobuf:"fixed32,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	Miner      *MinerFees `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
+}
+
+func (x *Fees) Reset() {
+	*x = Fees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Fees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fees) ProtoMessage() {}
+
+func (x *Fees) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fees.ProtoReflect.Descriptor instead.
+func (*Fees) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Fees) GetPercentage() float32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *Fees) GetMiner() *MinerFees {
+	if x != nil {
+		return x.Miner
+	}
+	return nil
+}
+
+type GetServiceInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetServiceInfoRequest) Reset() {
+	*x = GetServiceInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceInfoRequest) ProtoMessage() {}
+
+func (x *GetServiceInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceInfoRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{20}
+}
+
+type GetServiceInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fees   *Fees   `protobuf:"bytes,1,opt,name=fees,proto3" json:"fees,omitempty"`
+	Limits *Limits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (x *GetServiceInfoResponse) Reset() {
+	*x = GetServiceInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServiceInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceInfoResponse) ProtoMessage() {}
+
+func (x *GetServiceInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceInfoResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetServiceInfoResponse) GetFees() *Fees {
+	if x != nil {
+		return x.Fees
+	}
+	return nil
+}
+
+func (x *GetServiceInfoResponse) GetLimits() *Limits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type ListSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From   *Currency  `protobuf:"varint,1,opt,name=from,proto3,enum=boltzrpc.Currency,oneof" json:"from,omitempty"`
+	To     *Currency  `protobuf:"varint,2,opt,name=to,proto3,enum=boltzrpc.Currency,oneof" json:"to,omitempty"`
+	IsAuto *bool      `protobuf:"varint,3,opt,name=is_auto,json=isAuto,proto3,oneof" json:"is_auto,omitempty"`
+	State  *SwapState `protobuf:"varint,4,opt,name=state,proto3,enum=boltzrpc.SwapState,oneof" json:"state,omitempty"`
+	// Only swaps created at or after this unix timestamp
+	Since *int64 `protobuf:"varint,5,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	// Only swaps created at or before this unix timestamp
+	Until *int64 `protobuf:"varint,6,opt,name=until,proto3,oneof" json:"until,omitempty"`
+	// Matches swaps whose id starts with it or whose payment hash, invoice, lockup address or any transaction id equals it
+	Query *string `protobuf:"bytes,7,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	// Maximal number of swaps returned across all swap types. All swaps are returned if not set
+	Limit *uint64 `protobuf:"varint,8,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// The `next_cursor` of the previous page
+	Cursor *string `protobuf:"bytes,9,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// Swaps are sorted by their creation date
+	Order SortOrder `protobuf:"varint,10,opt,name=order,proto3,enum=boltzrpc.SortOrder" json:"order,omitempty"`
+	// Only swaps with exactly this label
+	Label *string `protobuf:"bytes,11,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	// Only swaps with exactly this external id
+	ExternalId *string `protobuf:"bytes,12,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+}
+
+func (x *ListSwapsRequest) Reset() {
+	*x = ListSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapsRequest) ProtoMessage() {}
+
+func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ListSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListSwapsRequest) GetFrom() Currency {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return Currency_BTC
+}
+
+func (x *ListSwapsRequest) GetTo() Currency {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return Currency_BTC
+}
+
+func (x *ListSwapsRequest) GetIsAuto() bool {
+	if x != nil && x.IsAuto != nil {
+		return *x.IsAuto
+	}
+	return false
+}
+
+func (x *ListSwapsRequest) GetState() SwapState {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return SwapState_PENDING
+}
+
+func (x *ListSwapsRequest) GetSince() int64 {
+	if x != nil && x.Since != nil {
+		return *x.Since
+	}
+	return 0
+}
+
+func (x *ListSwapsRequest) GetUntil() int64 {
+	if x != nil && x.Until != nil {
+		return *x.Until
+	}
+	return 0
+}
+
+func (x *ListSwapsRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+func (x *ListSwapsRequest) GetLimit() uint64 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListSwapsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *ListSwapsRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_ASCENDING
+}
+
+func (x *ListSwapsRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *ListSwapsRequest) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+type ListSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swaps            []*SwapInfo                `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
+	ChannelCreations []*CombinedChannelSwapInfo `protobuf:"bytes,2,rep,name=channel_creations,json=channelCreations,proto3" json:"channel_creations,omitempty"`
+	ReverseSwaps     []*ReverseSwapInfo         `protobuf:"bytes,3,rep,name=reverse_swaps,json=reverseSwaps,proto3" json:"reverse_swaps,omitempty"`
+	ChainSwaps       []*ChainSwapInfo           `protobuf:"bytes,4,rep,name=chain_swaps,json=chainSwaps,proto3" json:"chain_swaps,omitempty"`
+	// Cursor for the next page; only set if there are more swaps than the limit
+	NextCursor *string `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+}
+
+func (x *ListSwapsResponse) Reset() {
+	*x = ListSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSwapsResponse) ProtoMessage() {}
+
+func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ListSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListSwapsResponse) GetSwaps() []*SwapInfo {
+	if x != nil {
+		return x.Swaps
+	}
+	return nil
+}
+
+func (x *ListSwapsResponse) GetChannelCreations() []*CombinedChannelSwapInfo {
+	if x != nil {
+		return x.ChannelCreations
+	}
+	return nil
+}
+
+func (x *ListSwapsResponse) GetReverseSwaps() []*ReverseSwapInfo {
+	if x != nil {
+		return x.ReverseSwaps
+	}
+	return nil
+}
+
+func (x *ListSwapsResponse) GetChainSwaps() []*ChainSwapInfo {
+	if x != nil {
+		return x.ChainSwaps
+	}
+	return nil
+}
+
+func (x *ListSwapsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type ExportSwapsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format ExportFormat `protobuf:"varint,1,opt,name=format,proto3,enum=boltzrpc.ExportFormat" json:"format,omitempty"`
+	// Only swaps created at or after this unix timestamp
+	Since *int64 `protobuf:"varint,2,opt,name=since,proto3,oneof" json:"since,omitempty"`
+	// Only swaps created at or before this unix timestamp
+	Until *int64 `protobuf:"varint,3,opt,name=until,proto3,oneof" json:"until,omitempty"`
+}
+
+func (x *ExportSwapsRequest) Reset() {
+	*x = ExportSwapsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSwapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSwapsRequest) ProtoMessage() {}
+
+func (x *ExportSwapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSwapsRequest.ProtoReflect.Descriptor instead.
+func (*ExportSwapsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ExportSwapsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_CSV
+}
+
+func (x *ExportSwapsRequest) GetSince() int64 {
+	if x != nil && x.Since != nil {
+		return *x.Since
+	}
+	return 0
+}
+
+func (x *ExportSwapsRequest) GetUntil() int64 {
+	if x != nil && x.Until != nil {
+		return *x.Until
+	}
+	return 0
+}
+
+type ExportSwapsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Swaps in the requested format, sorted by their creation date.
+	// Amounts are the ones of the invoice for submarine and reverse swaps and the ones we locked up for chain swaps
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *ExportSwapsResponse) Reset() {
+	*x = ExportSwapsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportSwapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportSwapsResponse) ProtoMessage() {}
+
+func (x *ExportSwapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportSwapsResponse.ProtoReflect.Descriptor instead.
+func (*ExportSwapsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExportSwapsResponse) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type RefundSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Replaces the fee policy of the swap, which is also used for subsequent refunds
+	FeePolicy *FeePolicy `protobuf:"bytes,3,opt,name=fee_policy,json=feePolicy,proto3,oneof" json:"fee_policy,omitempty"`
+}
+
+func (x *RefundSwapRequest) Reset() {
+	*x = RefundSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundSwapRequest) ProtoMessage() {}
+
+func (x *RefundSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundSwapRequest.ProtoReflect.Descriptor instead.
+func (*RefundSwapRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RefundSwapRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RefundSwapRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RefundSwapRequest) GetFeePolicy() *FeePolicy {
+	if x != nil {
+		return x.FeePolicy
+	}
+	return nil
+}
+
+type BumpTransactionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// Fee rate of the bumped transaction. Defaults to the current fee estimation
+	SatPerVbyte *float64 `protobuf:"fixed64,2,opt,name=sat_per_vbyte,json=satPerVbyte,proto3,oneof" json:"sat_per_vbyte,omitempty"`
+}
+
+func (x *BumpTransactionRequest) Reset() {
+	*x = BumpTransactionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpTransactionRequest) ProtoMessage() {}
+
+func (x *BumpTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpTransactionRequest.ProtoReflect.Descriptor instead.
+func (*BumpTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BumpTransactionRequest) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *BumpTransactionRequest) GetSatPerVbyte() float64 {
+	if x != nil && x.SatPerVbyte != nil {
+		return *x.SatPerVbyte
+	}
+	return 0
+}
+
+type BumpTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Id of the replacement transaction, or of the original one in case it was bumped via CPFP
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *BumpTransactionResponse) Reset() {
+	*x = BumpTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BumpTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BumpTransactionResponse) ProtoMessage() {}
+
+func (x *BumpTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BumpTransactionResponse.ProtoReflect.Descriptor instead.
+func (*BumpTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BumpTransactionResponse) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+type GetSwapInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetSwapInfoRequest) Reset() {
+	*x = GetSwapInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSwapInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSwapInfoRequest) ProtoMessage() {}
+
+func (x *GetSwapInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSwapInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetSwapInfoRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetSwapInfoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSwapInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swap            *SwapInfo            `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+	ChannelCreation *ChannelCreationInfo `protobuf:"bytes,2,opt,name=channel_creation,json=channelCreation,proto3" json:"channel_creation,omitempty"`
+	ReverseSwap     *ReverseSwapInfo     `protobuf:"bytes,3,opt,name=reverse_swap,json=reverseSwap,proto3" json:"reverse_swap,omitempty"`
+	ChainSwap       *ChainSwapInfo       `protobuf:"bytes,4,opt,name=chain_swap,json=chainSwap,proto3" json:"chain_swap,omitempty"`
+	// Set in swap info streams when a pending swap gets close to its timeout
+	TimeoutWarning *TimeoutWarning `protobuf:"bytes,5,opt,name=timeout_warning,json=timeoutWarning,proto3,oneof" json:"timeout_warning,omitempty"`
+	// Everything that happened to the swap, oldest event first. Only set by GetSwapInfo
+	History []*SwapEvent `protobuf:"bytes,6,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *GetSwapInfoResponse) Reset() {
+	*x = GetSwapInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSwapInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSwapInfoResponse) ProtoMessage() {}
+
+func (x *GetSwapInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSwapInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetSwapInfoResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSwapInfoResponse) GetSwap() *SwapInfo {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+func (x *GetSwapInfoResponse) GetChannelCreation() *ChannelCreationInfo {
+	if x != nil {
+		return x.ChannelCreation
+	}
+	return nil
+}
+
+func (x *GetSwapInfoResponse) GetReverseSwap() *ReverseSwapInfo {
+	if x != nil {
+		return x.ReverseSwap
 	}
 	return nil
 }
 
-func (x *ReversePair) GetHash() string {
+func (x *GetSwapInfoResponse) GetChainSwap() *ChainSwapInfo {
 	if x != nil {
-		return x.Hash
+		return x.ChainSwap
 	}
-	return ""
+	return nil
 }
 
-func (x *ReversePair) GetRate() float32 {
+func (x *GetSwapInfoResponse) GetTimeoutWarning() *TimeoutWarning {
 	if x != nil {
-		return x.Rate
+		return x.TimeoutWarning
 	}
-	return 0
+	return nil
 }
 
-func (x *ReversePair) GetLimits() *Limits {
+func (x *GetSwapInfoResponse) GetHistory() []*SwapEvent {
 	if x != nil {
-		return x.Limits
+		return x.History
 	}
 	return nil
 }
 
-func (x *ReversePair) GetFees() *ReversePair_Fees {
+type UpdateSwapLabelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// New label of the swap; left unchanged if not set and removed if empty
+	Label *string `protobuf:"bytes,2,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	// New external id of the swap; left unchanged if not set and removed if empty
+	ExternalId *string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
+}
+
+func (x *UpdateSwapLabelRequest) Reset() {
+	*x = UpdateSwapLabelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSwapLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSwapLabelRequest) ProtoMessage() {}
+
+func (x *UpdateSwapLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSwapLabelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSwapLabelRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateSwapLabelRequest) GetId() string {
 	if x != nil {
-		return x.Fees
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetPairsResponse struct {
+func (x *UpdateSwapLabelRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *UpdateSwapLabelRequest) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+type SwapEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Submarine []*SubmarinePair `protobuf:"bytes,1,rep,name=submarine,proto3" json:"submarine,omitempty"`
-	Reverse   []*ReversePair   `protobuf:"bytes,2,rep,name=reverse,proto3" json:"reverse,omitempty"`
-}
-
-func (x *GetPairsResponse) Reset() {
-	*x = GetPairsResponse{}
+	Type SwapEventType `protobuf:"varint,1,opt,name=type,proto3,enum=boltzrpc.SwapEventType" json:"type,omitempty"`
+	// Unix timestamp of the event
+	CreatedAt int64 `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Chain the block height and transaction belong to
+	Currency *Currency `protobuf:"varint,3,opt,name=currency,proto3,enum=boltzrpc.Currency,oneof" json:"currency,omitempty"`
+	// Block height of the chain when the event happened; not set if it was not known
+	BlockHeight *uint32 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3,oneof" json:"block_height,omitempty"`
+	// Set for STATUS events
+	Status *string `protobuf:"bytes,5,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	// Set for STATE events
+	State *SwapState `protobuf:"varint,6,opt,name=state,proto3,enum=boltzrpc.SwapState,oneof" json:"state,omitempty"`
+	// Set for STATE events of failed swaps
+	Error *string `protobuf:"bytes,7,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// Set for transaction and FEE_BUMP events
+	TransactionId *string `protobuf:"bytes,8,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	// Fee paid by the transaction in satoshis, if it was paid by the client
+	Fee *uint64 `protobuf:"varint,9,opt,name=fee,proto3,oneof" json:"fee,omitempty"`
+	// Set for FEE_BUMP events
+	SatPerVbyte *float64 `protobuf:"fixed64,10,opt,name=sat_per_vbyte,json=satPerVbyte,proto3,oneof" json:"sat_per_vbyte,omitempty"`
+}
+
+func (x *SwapEvent) Reset() {
+	*x = SwapEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[11]
+		mi := &file_boltzrpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPairsResponse) String() string {
+func (x *SwapEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPairsResponse) ProtoMessage() {}
+func (*SwapEvent) ProtoMessage() {}
 
-func (x *GetPairsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[11]
+func (x *SwapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1241,51 +3211,111 @@ func (x *GetPairsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPairsResponse.ProtoReflect.Descriptor instead.
-func (*GetPairsResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SwapEvent.ProtoReflect.Descriptor instead.
+func (*SwapEvent) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetPairsResponse) GetSubmarine() []*SubmarinePair {
+func (x *SwapEvent) GetType() SwapEventType {
 	if x != nil {
-		return x.Submarine
+		return x.Type
 	}
-	return nil
+	return SwapEventType_STATUS
 }
 
-func (x *GetPairsResponse) GetReverse() []*ReversePair {
+func (x *SwapEvent) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Reverse
+		return x.CreatedAt
 	}
-	return nil
+	return 0
 }
 
-type MinerFees struct {
+func (x *SwapEvent) GetCurrency() Currency {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return Currency_BTC
+}
+
+func (x *SwapEvent) GetBlockHeight() uint32 {
+	if x != nil && x.BlockHeight != nil {
+		return *x.BlockHeight
+	}
+	return 0
+}
+
+func (x *SwapEvent) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetState() SwapState {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return SwapState_PENDING
+}
+
+func (x *SwapEvent) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetTransactionId() string {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetFee() uint64 {
+	if x != nil && x.Fee != nil {
+		return *x.Fee
+	}
+	return 0
+}
+
+func (x *SwapEvent) GetSatPerVbyte() float64 {
+	if x != nil && x.SatPerVbyte != nil {
+		return *x.SatPerVbyte
+	}
+	return 0
+}
+
+type TimeoutWarning struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Normal  uint32 `protobuf:"varint,1,opt,name=normal,proto3" json:"normal,omitempty"`
-	Reverse uint32 `protobuf:"varint,2,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	// Blocks left until the timeout block height of the swap is reached
+	BlocksLeft uint32 `protobuf:"varint,1,opt,name=blocks_left,json=blocksLeft,proto3" json:"blocks_left,omitempty"`
+	// Estimated unix timestamp at which the timeout block height is reached
+	Eta int64 `protobuf:"varint,2,opt,name=eta,proto3" json:"eta,omitempty"`
+	// What is still missing for the swap to succeed
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *MinerFees) Reset() {
-	*x = MinerFees{}
+func (x *TimeoutWarning) Reset() {
+	*x = TimeoutWarning{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[12]
+		mi := &file_boltzrpc_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MinerFees) String() string {
+func (x *TimeoutWarning) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MinerFees) ProtoMessage() {}
+func (*TimeoutWarning) ProtoMessage() {}
 
-func (x *MinerFees) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[12]
+func (x *TimeoutWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,51 +3326,59 @@ func (x *MinerFees) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MinerFees.ProtoReflect.Descriptor instead.
-func (*MinerFees) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use TimeoutWarning.ProtoReflect.Descriptor instead.
+func (*TimeoutWarning) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *MinerFees) GetNormal() uint32 {
+func (x *TimeoutWarning) GetBlocksLeft() uint32 {
 	if x != nil {
-		return x.Normal
+		return x.BlocksLeft
 	}
 	return 0
 }
 
-func (x *MinerFees) GetReverse() uint32 {
+func (x *TimeoutWarning) GetEta() int64 {
 	if x != nil {
-		return x.Reverse
+		return x.Eta
 	}
 	return 0
 }
 
-type Fees struct {
+func (x *TimeoutWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DepositRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Percentage float32    `protobuf:"fixed32,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	Miner      *MinerFees `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
+	// Percentage of inbound liquidity the channel that is opened in case the invoice cannot be paid should have.
+	// 25 by default.
+	InboundLiquidity uint32 `protobuf:"varint,1,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
 }
 
-func (x *Fees) Reset() {
-	*x = Fees{}
+func (x *DepositRequest) Reset() {
+	*x = DepositRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[13]
+		mi := &file_boltzrpc_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Fees) String() string {
+func (x *DepositRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Fees) ProtoMessage() {}
+func (*DepositRequest) ProtoMessage() {}
 
-func (x *Fees) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[13]
+func (x *DepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,48 +3389,130 @@ func (x *Fees) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Fees.ProtoReflect.Descriptor instead.
-func (*Fees) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
+func (*DepositRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *Fees) GetPercentage() float32 {
+func (x *DepositRequest) GetInboundLiquidity() uint32 {
 	if x != nil {
-		return x.Percentage
+		return x.InboundLiquidity
 	}
 	return 0
 }
 
-func (x *Fees) GetMiner() *MinerFees {
+type DepositResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address            string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	TimeoutBlockHeight uint32 `protobuf:"varint,3,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
+}
+
+func (x *DepositResponse) Reset() {
+	*x = DepositResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DepositResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositResponse) ProtoMessage() {}
+
+func (x *DepositResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
+func (*DepositResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DepositResponse) GetId() string {
 	if x != nil {
-		return x.Miner
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetServiceInfoRequest struct {
+func (x *DepositResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *DepositResponse) GetTimeoutBlockHeight() uint32 {
+	if x != nil {
+		return x.TimeoutBlockHeight
+	}
+	return 0
+}
+
+type CreateSwapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Pair   *Pair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// a list of channel ids which should receive the payment of the invoice. can be in either cln or lnd style.
+	// route hints are added to the invoice for them, so they can be private
+	ChanIds []string `protobuf:"bytes,3,rep,name=chan_ids,json=chanIds,proto3" json:"chan_ids,omitempty"`
+	// the daemon will pay the swap using the onchain wallet specified in the `wallet` field or any wallet otherwise.
+	SendFromInternal bool `protobuf:"varint,4,opt,name=send_from_internal,json=sendFromInternal,proto3" json:"send_from_internal,omitempty"`
+	// address where the coins should go if the swap fails. Refunds will go to any of the daemons wallets otherwise.
+	RefundAddress *string `protobuf:"bytes,5,opt,name=refund_address,json=refundAddress,proto3,oneof" json:"refund_address,omitempty"`
+	// wallet to pay swap from. only used if `send_from_internal` is set to true
+	Wallet *string `protobuf:"bytes,6,opt,name=wallet,proto3,oneof" json:"wallet,omitempty"`
+	// invoice to use for the swap. if not set, the daemon will get a new invoice from the lightning node
+	Invoice *string `protobuf:"bytes,7,opt,name=invoice,proto3,oneof" json:"invoice,omitempty"`
+	// fee policy for the lockup and refund transactions
+	FeePolicy *FeePolicy `protobuf:"bytes,8,opt,name=fee_policy,json=feePolicy,proto3,oneof" json:"fee_policy,omitempty"`
+	// Hash of the pair whose fees were accepted, as returned by `GetSubmarinePair` or `GetQuote`.
+	// The swap is rejected with a `FAILED_PRECONDITION` error which contains the current `SubmarinePair` if the fees changed
+	PairHash *string `protobuf:"bytes,9,opt,name=pair_hash,json=pairHash,proto3,oneof" json:"pair_hash,omitempty"`
+	// Highest fee in satoshis boltz may charge for the swap, including its miner fee.
+	// The swap is rejected with a `FAILED_PRECONDITION` error which contains the current `SubmarinePair` if it is exceeded
+	MaxBoltzFee *uint64 `protobuf:"varint,10,opt,name=max_boltz_fee,json=maxBoltzFee,proto3,oneof" json:"max_boltz_fee,omitempty"`
+	// Free form description of the swap
+	Label *string `protobuf:"bytes,11,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	// Reference of the swap in an external system, like an order id
+	ExternalId *string `protobuf:"bytes,12,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
 }
 
-func (x *GetServiceInfoRequest) Reset() {
-	*x = GetServiceInfoRequest{}
+func (x *CreateSwapRequest) Reset() {
+	*x = CreateSwapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[14]
+		mi := &file_boltzrpc_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetServiceInfoRequest) String() string {
+func (x *CreateSwapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServiceInfoRequest) ProtoMessage() {}
+func (*CreateSwapRequest) ProtoMessage() {}
 
-func (x *GetServiceInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[14]
+func (x *CreateSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1403,94 +3523,127 @@ func (x *GetServiceInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServiceInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetServiceInfoRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CreateSwapRequest.ProtoReflect.Descriptor instead.
+func (*CreateSwapRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{36}
 }
 
-type GetServiceInfoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *CreateSwapRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
 
-	Fees   *Fees   `protobuf:"bytes,1,opt,name=fees,proto3" json:"fees,omitempty"`
-	Limits *Limits `protobuf:"bytes,2,opt,name=limits,proto3" json:"limits,omitempty"`
+func (x *CreateSwapRequest) GetPair() *Pair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *GetServiceInfoResponse) Reset() {
-	*x = GetServiceInfoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateSwapRequest) GetChanIds() []string {
+	if x != nil {
+		return x.ChanIds
 	}
+	return nil
 }
 
-func (x *GetServiceInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateSwapRequest) GetSendFromInternal() bool {
+	if x != nil {
+		return x.SendFromInternal
+	}
+	return false
 }
 
-func (*GetServiceInfoResponse) ProtoMessage() {}
+func (x *CreateSwapRequest) GetRefundAddress() string {
+	if x != nil && x.RefundAddress != nil {
+		return *x.RefundAddress
+	}
+	return ""
+}
 
-func (x *GetServiceInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateSwapRequest) GetWallet() string {
+	if x != nil && x.Wallet != nil {
+		return *x.Wallet
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetServiceInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetServiceInfoResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{15}
+func (x *CreateSwapRequest) GetInvoice() string {
+	if x != nil && x.Invoice != nil {
+		return *x.Invoice
+	}
+	return ""
 }
 
-func (x *GetServiceInfoResponse) GetFees() *Fees {
+func (x *CreateSwapRequest) GetFeePolicy() *FeePolicy {
 	if x != nil {
-		return x.Fees
+		return x.FeePolicy
 	}
 	return nil
 }
 
-func (x *GetServiceInfoResponse) GetLimits() *Limits {
-	if x != nil {
-		return x.Limits
+func (x *CreateSwapRequest) GetPairHash() string {
+	if x != nil && x.PairHash != nil {
+		return *x.PairHash
 	}
-	return nil
+	return ""
 }
 
-type ListSwapsRequest struct {
+func (x *CreateSwapRequest) GetMaxBoltzFee() uint64 {
+	if x != nil && x.MaxBoltzFee != nil {
+		return *x.MaxBoltzFee
+	}
+	return 0
+}
+
+func (x *CreateSwapRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *CreateSwapRequest) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+type CreateSwapResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From   *Currency  `protobuf:"varint,1,opt,name=from,proto3,enum=boltzrpc.Currency,oneof" json:"from,omitempty"`
-	To     *Currency  `protobuf:"varint,2,opt,name=to,proto3,enum=boltzrpc.Currency,oneof" json:"to,omitempty"`
-	IsAuto *bool      `protobuf:"varint,3,opt,name=is_auto,json=isAuto,proto3,oneof" json:"is_auto,omitempty"`
-	State  *SwapState `protobuf:"varint,4,opt,name=state,proto3,enum=boltzrpc.SwapState,oneof" json:"state,omitempty"`
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ExpectedAmount int64  `protobuf:"varint,3,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	Bip21          string `protobuf:"bytes,4,opt,name=bip21,proto3" json:"bip21,omitempty"`
+	// lockup transaction id. Only populated when `send_from_internal` was specified in the request
+	TxId               string  `protobuf:"bytes,5,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	TimeoutBlockHeight uint32  `protobuf:"varint,6,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
+	TimeoutHours       float32 `protobuf:"fixed32,7,opt,name=timeout_hours,json=timeoutHours,proto3" json:"timeout_hours,omitempty"`
 }
 
-func (x *ListSwapsRequest) Reset() {
-	*x = ListSwapsRequest{}
+func (x *CreateSwapResponse) Reset() {
+	*x = CreateSwapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[16]
+		mi := &file_boltzrpc_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSwapsRequest) String() string {
+func (x *CreateSwapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSwapsRequest) ProtoMessage() {}
+func (*CreateSwapResponse) ProtoMessage() {}
 
-func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[16]
+func (x *CreateSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1501,66 +3654,89 @@ func (x *ListSwapsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSwapsRequest.ProtoReflect.Descriptor instead.
-func (*ListSwapsRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CreateSwapResponse.ProtoReflect.Descriptor instead.
+func (*CreateSwapResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *ListSwapsRequest) GetFrom() Currency {
-	if x != nil && x.From != nil {
-		return *x.From
+func (x *CreateSwapResponse) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return Currency_BTC
+	return ""
 }
 
-func (x *ListSwapsRequest) GetTo() Currency {
-	if x != nil && x.To != nil {
-		return *x.To
+func (x *CreateSwapResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
 	}
-	return Currency_BTC
+	return ""
 }
 
-func (x *ListSwapsRequest) GetIsAuto() bool {
-	if x != nil && x.IsAuto != nil {
-		return *x.IsAuto
+func (x *CreateSwapResponse) GetExpectedAmount() int64 {
+	if x != nil {
+		return x.ExpectedAmount
 	}
-	return false
+	return 0
 }
 
-func (x *ListSwapsRequest) GetState() SwapState {
-	if x != nil && x.State != nil {
-		return *x.State
+func (x *CreateSwapResponse) GetBip21() string {
+	if x != nil {
+		return x.Bip21
 	}
-	return SwapState_PENDING
+	return ""
 }
 
-type ListSwapsResponse struct {
+func (x *CreateSwapResponse) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *CreateSwapResponse) GetTimeoutBlockHeight() uint32 {
+	if x != nil {
+		return x.TimeoutBlockHeight
+	}
+	return 0
+}
+
+func (x *CreateSwapResponse) GetTimeoutHours() float32 {
+	if x != nil {
+		return x.TimeoutHours
+	}
+	return 0
+}
+
+type CreateChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Swaps            []*SwapInfo                `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps,omitempty"`
-	ChannelCreations []*CombinedChannelSwapInfo `protobuf:"bytes,2,rep,name=channel_creations,json=channelCreations,proto3" json:"channel_creations,omitempty"`
-	ReverseSwaps     []*ReverseSwapInfo         `protobuf:"bytes,3,rep,name=reverse_swaps,json=reverseSwaps,proto3" json:"reverse_swaps,omitempty"`
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Percentage of inbound liquidity the channel that is opened should have.
+	// 25 by default.
+	InboundLiquidity uint32 `protobuf:"varint,2,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
+	Private          bool   `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
 }
 
-func (x *ListSwapsResponse) Reset() {
-	*x = ListSwapsResponse{}
+func (x *CreateChannelRequest) Reset() {
+	*x = CreateChannelRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[17]
+		mi := &file_boltzrpc_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSwapsResponse) String() string {
+func (x *CreateChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSwapsResponse) ProtoMessage() {}
+func (*CreateChannelRequest) ProtoMessage() {}
 
-func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[17]
+func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1571,58 +3747,88 @@ func (x *ListSwapsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSwapsResponse.ProtoReflect.Descriptor instead.
-func (*ListSwapsResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
+func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *ListSwapsResponse) GetSwaps() []*SwapInfo {
+func (x *CreateChannelRequest) GetAmount() int64 {
 	if x != nil {
-		return x.Swaps
+		return x.Amount
 	}
-	return nil
+	return 0
 }
 
-func (x *ListSwapsResponse) GetChannelCreations() []*CombinedChannelSwapInfo {
+func (x *CreateChannelRequest) GetInboundLiquidity() uint32 {
 	if x != nil {
-		return x.ChannelCreations
+		return x.InboundLiquidity
 	}
-	return nil
+	return 0
 }
 
-func (x *ListSwapsResponse) GetReverseSwaps() []*ReverseSwapInfo {
+func (x *CreateChannelRequest) GetPrivate() bool {
 	if x != nil {
-		return x.ReverseSwaps
+		return x.Private
 	}
-	return nil
+	return false
 }
 
-type RefundSwapRequest struct {
+type CreateReverseSwapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// amount of satoshis to swap
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// If no value is set, the daemon will query a new address from the lightning node
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Whether the daemon should broadcast the claim transaction immediately after the lockup transaction is in the mempool.
+	// Should only be used for smaller amounts as it involves trust in boltz.
+	AcceptZeroConf bool  `protobuf:"varint,3,opt,name=accept_zero_conf,json=acceptZeroConf,proto3" json:"accept_zero_conf,omitempty"`
+	Pair           *Pair `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	// a list of channel ids which are allowed for paying the invoice. can be in either cln or lnd style.
+	ChanIds []string `protobuf:"bytes,5,rep,name=chan_ids,json=chanIds,proto3" json:"chan_ids,omitempty"`
+	// wallet from which the onchain address should be generated - only considered if `address` is not set
+	Wallet *string `protobuf:"bytes,6,opt,name=wallet,proto3,oneof" json:"wallet,omitempty"`
+	// Whether the daemon should return immediately after creating the swap or wait until the swap is successful or failed.
+	// It will always return immediately if `accept_zero_conf` is not set.
+	ReturnImmediately *bool `protobuf:"varint,7,opt,name=return_immediately,json=returnImmediately,proto3,oneof" json:"return_immediately,omitempty"`
+	// If set, the daemon will not pay the invoice of the swap and return the invoice to be paid. This implicitly sets `return_immediately` to true.
+	ExternalPay *bool `protobuf:"varint,8,opt,name=external_pay,json=externalPay,proto3,oneof" json:"external_pay,omitempty"`
+	// fee policy for the claim transaction
+	FeePolicy *FeePolicy `protobuf:"bytes,9,opt,name=fee_policy,json=feePolicy,proto3,oneof" json:"fee_policy,omitempty"`
+	// Amount of satoshis which has to arrive at the claim address after all fees. The invoice amount is calculated by the
+	// daemon and `amount` has to be 0. The swap fails instead of claiming less if fees rise above our estimation
+	OnchainAmount *uint64 `protobuf:"varint,10,opt,name=onchain_amount,json=onchainAmount,proto3,oneof" json:"onchain_amount,omitempty"`
+	// Hash of the pair whose fees were accepted, as returned by `GetReversePair` or `GetQuote`.
+	// The swap is rejected with a `FAILED_PRECONDITION` error which contains the current `ReversePair` if the fees changed
+	PairHash *string `protobuf:"bytes,11,opt,name=pair_hash,json=pairHash,proto3,oneof" json:"pair_hash,omitempty"`
+	// Highest fee in satoshis boltz may charge for the swap, including its lockup fee.
+	// The swap is rejected with a `FAILED_PRECONDITION` error which contains the current `ReversePair` if it is exceeded
+	MaxBoltzFee *uint64 `protobuf:"varint,12,opt,name=max_boltz_fee,json=maxBoltzFee,proto3,oneof" json:"max_boltz_fee,omitempty"`
+	// Free form description of the swap
+	Label *string `protobuf:"bytes,13,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	// Reference of the swap in an external system, like an order id
+	ExternalId *string `protobuf:"bytes,14,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
 }
 
-func (x *RefundSwapRequest) Reset() {
-	*x = RefundSwapRequest{}
+func (x *CreateReverseSwapRequest) Reset() {
+	*x = CreateReverseSwapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[18]
+		mi := &file_boltzrpc_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RefundSwapRequest) String() string {
+func (x *CreateReverseSwapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RefundSwapRequest) ProtoMessage() {}
+func (*CreateReverseSwapRequest) ProtoMessage() {}
 
-func (x *RefundSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[18]
+func (x *CreateReverseSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,99 +3839,141 @@ func (x *RefundSwapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RefundSwapRequest.ProtoReflect.Descriptor instead.
-func (*RefundSwapRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use CreateReverseSwapRequest.ProtoReflect.Descriptor instead.
+func (*CreateReverseSwapRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *RefundSwapRequest) GetId() string {
+func (x *CreateReverseSwapRequest) GetAmount() int64 {
 	if x != nil {
-		return x.Id
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *RefundSwapRequest) GetAddress() string {
+func (x *CreateReverseSwapRequest) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-type GetSwapInfoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *CreateReverseSwapRequest) GetAcceptZeroConf() bool {
+	if x != nil {
+		return x.AcceptZeroConf
+	}
+	return false
 }
 
-func (x *GetSwapInfoRequest) Reset() {
-	*x = GetSwapInfoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateReverseSwapRequest) GetPair() *Pair {
+	if x != nil {
+		return x.Pair
 	}
+	return nil
 }
 
-func (x *GetSwapInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateReverseSwapRequest) GetChanIds() []string {
+	if x != nil {
+		return x.ChanIds
+	}
+	return nil
 }
 
-func (*GetSwapInfoRequest) ProtoMessage() {}
+func (x *CreateReverseSwapRequest) GetWallet() string {
+	if x != nil && x.Wallet != nil {
+		return *x.Wallet
+	}
+	return ""
+}
 
-func (x *GetSwapInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateReverseSwapRequest) GetReturnImmediately() bool {
+	if x != nil && x.ReturnImmediately != nil {
+		return *x.ReturnImmediately
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use GetSwapInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetSwapInfoRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{19}
+func (x *CreateReverseSwapRequest) GetExternalPay() bool {
+	if x != nil && x.ExternalPay != nil {
+		return *x.ExternalPay
+	}
+	return false
 }
 
-func (x *GetSwapInfoRequest) GetId() string {
+func (x *CreateReverseSwapRequest) GetFeePolicy() *FeePolicy {
 	if x != nil {
-		return x.Id
+		return x.FeePolicy
+	}
+	return nil
+}
+
+func (x *CreateReverseSwapRequest) GetOnchainAmount() uint64 {
+	if x != nil && x.OnchainAmount != nil {
+		return *x.OnchainAmount
+	}
+	return 0
+}
+
+func (x *CreateReverseSwapRequest) GetPairHash() string {
+	if x != nil && x.PairHash != nil {
+		return *x.PairHash
 	}
 	return ""
 }
 
-type GetSwapInfoResponse struct {
+func (x *CreateReverseSwapRequest) GetMaxBoltzFee() uint64 {
+	if x != nil && x.MaxBoltzFee != nil {
+		return *x.MaxBoltzFee
+	}
+	return 0
+}
+
+func (x *CreateReverseSwapRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *CreateReverseSwapRequest) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
+	}
+	return ""
+}
+
+type CreateReverseSwapResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Swap            *SwapInfo            `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
-	ChannelCreation *ChannelCreationInfo `protobuf:"bytes,2,opt,name=channel_creation,json=channelCreation,proto3" json:"channel_creation,omitempty"`
-	ReverseSwap     *ReverseSwapInfo     `protobuf:"bytes,3,opt,name=reverse_swap,json=reverseSwap,proto3" json:"reverse_swap,omitempty"`
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LockupAddress string `protobuf:"bytes,2,opt,name=lockup_address,json=lockupAddress,proto3" json:"lockup_address,omitempty"`
+	// Only populated when zero-conf is accepted and return_immediately is set to false
+	RoutingFeeMilliSat *uint64 `protobuf:"varint,3,opt,name=routing_fee_milli_sat,json=routingFeeMilliSat,proto3,oneof" json:"routing_fee_milli_sat,omitempty"`
+	// Only populated when zero-conf is accepted and return_immediately is set to false
+	ClaimTransactionId *string `protobuf:"bytes,4,opt,name=claim_transaction_id,json=claimTransactionId,proto3,oneof" json:"claim_transaction_id,omitempty"`
+	// Invoice to be paid. Only populated when `external_pay` is set to true
+	Invoice *string `protobuf:"bytes,5,opt,name=invoice,proto3,oneof" json:"invoice,omitempty"`
 }
 
-func (x *GetSwapInfoResponse) Reset() {
-	*x = GetSwapInfoResponse{}
+func (x *CreateReverseSwapResponse) Reset() {
+	*x = CreateReverseSwapResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[20]
+		mi := &file_boltzrpc_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSwapInfoResponse) String() string {
+func (x *CreateReverseSwapResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSwapInfoResponse) ProtoMessage() {}
+func (*CreateReverseSwapResponse) ProtoMessage() {}
 
-func (x *GetSwapInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[20]
+func (x *CreateReverseSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1736,59 +3984,88 @@ func (x *GetSwapInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSwapInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetSwapInfoResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use CreateReverseSwapResponse.ProtoReflect.Descriptor instead.
+func (*CreateReverseSwapResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetSwapInfoResponse) GetSwap() *SwapInfo {
+func (x *CreateReverseSwapResponse) GetId() string {
 	if x != nil {
-		return x.Swap
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSwapInfoResponse) GetChannelCreation() *ChannelCreationInfo {
+func (x *CreateReverseSwapResponse) GetLockupAddress() string {
 	if x != nil {
-		return x.ChannelCreation
+		return x.LockupAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *GetSwapInfoResponse) GetReverseSwap() *ReverseSwapInfo {
-	if x != nil {
-		return x.ReverseSwap
+func (x *CreateReverseSwapResponse) GetRoutingFeeMilliSat() uint64 {
+	if x != nil && x.RoutingFeeMilliSat != nil {
+		return *x.RoutingFeeMilliSat
 	}
-	return nil
+	return 0
 }
 
-type DepositRequest struct {
+func (x *CreateReverseSwapResponse) GetClaimTransactionId() string {
+	if x != nil && x.ClaimTransactionId != nil {
+		return *x.ClaimTransactionId
+	}
+	return ""
+}
+
+func (x *CreateReverseSwapResponse) GetInvoice() string {
+	if x != nil && x.Invoice != nil {
+		return *x.Invoice
+	}
+	return ""
+}
+
+type CreateChainSwapRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Percentage of inbound liquidity the channel that is opened in case the invoice cannot be paid should have.
-	// 25 by default.
-	InboundLiquidity uint32 `protobuf:"varint,1,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
+	// amount of satoshis to send to the lockup address
+	Amount uint64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Pair   *Pair  `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	// address where the coins should go if the swap succeeds. Claims will go to the `to_wallet` or any of the daemons wallets otherwise.
+	ToAddress *string `protobuf:"bytes,3,opt,name=to_address,json=toAddress,proto3,oneof" json:"to_address,omitempty"`
+	// address where the coins should go if the swap fails. Refunds will go to the `from_wallet` or any of the daemons wallets otherwise.
+	RefundAddress *string `protobuf:"bytes,4,opt,name=refund_address,json=refundAddress,proto3,oneof" json:"refund_address,omitempty"`
+	// wallet from which the lockup should be paid. If not set, the lockup address has to be paid manually
+	FromWallet *string `protobuf:"bytes,5,opt,name=from_wallet,json=fromWallet,proto3,oneof" json:"from_wallet,omitempty"`
+	// wallet in which the coins should be received - only considered if `to_address` is not set
+	ToWallet *string `protobuf:"bytes,6,opt,name=to_wallet,json=toWallet,proto3,oneof" json:"to_wallet,omitempty"`
+	// Whether the daemon should claim the coins immediately after the lockup transaction of boltz is in the mempool.
+	// Should only be used for smaller amounts as it involves trust in boltz.
+	AcceptZeroConf *bool `protobuf:"varint,7,opt,name=accept_zero_conf,json=acceptZeroConf,proto3,oneof" json:"accept_zero_conf,omitempty"`
+	// Free form description of the swap
+	Label *string `protobuf:"bytes,8,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	// Reference of the swap in an external system, like an order id
+	ExternalId *string `protobuf:"bytes,9,opt,name=external_id,json=externalId,proto3,oneof" json:"external_id,omitempty"`
 }
 
-func (x *DepositRequest) Reset() {
-	*x = DepositRequest{}
+func (x *CreateChainSwapRequest) Reset() {
+	*x = CreateChainSwapRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[21]
+		mi := &file_boltzrpc_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepositRequest) String() string {
+func (x *CreateChainSwapRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositRequest) ProtoMessage() {}
+func (*CreateChainSwapRequest) ProtoMessage() {}
 
-func (x *DepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[21]
+func (x *CreateChainSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1799,115 +4076,102 @@ func (x *DepositRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositRequest.ProtoReflect.Descriptor instead.
-func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CreateChainSwapRequest.ProtoReflect.Descriptor instead.
+func (*CreateChainSwapRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *DepositRequest) GetInboundLiquidity() uint32 {
+func (x *CreateChainSwapRequest) GetAmount() uint64 {
 	if x != nil {
-		return x.InboundLiquidity
+		return x.Amount
 	}
 	return 0
 }
 
-type DepositResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address            string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	TimeoutBlockHeight uint32 `protobuf:"varint,3,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
+func (x *CreateChainSwapRequest) GetPair() *Pair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *DepositResponse) Reset() {
-	*x = DepositResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateChainSwapRequest) GetToAddress() string {
+	if x != nil && x.ToAddress != nil {
+		return *x.ToAddress
 	}
+	return ""
 }
 
-func (x *DepositResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateChainSwapRequest) GetRefundAddress() string {
+	if x != nil && x.RefundAddress != nil {
+		return *x.RefundAddress
+	}
+	return ""
 }
 
-func (*DepositResponse) ProtoMessage() {}
-
-func (x *DepositResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateChainSwapRequest) GetFromWallet() string {
+	if x != nil && x.FromWallet != nil {
+		return *x.FromWallet
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DepositResponse.ProtoReflect.Descriptor instead.
-func (*DepositResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{22}
+func (x *CreateChainSwapRequest) GetToWallet() string {
+	if x != nil && x.ToWallet != nil {
+		return *x.ToWallet
+	}
+	return ""
 }
 
-func (x *DepositResponse) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *CreateChainSwapRequest) GetAcceptZeroConf() bool {
+	if x != nil && x.AcceptZeroConf != nil {
+		return *x.AcceptZeroConf
 	}
-	return ""
+	return false
 }
 
-func (x *DepositResponse) GetAddress() string {
-	if x != nil {
-		return x.Address
+func (x *CreateChainSwapRequest) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
 	}
 	return ""
 }
 
-func (x *DepositResponse) GetTimeoutBlockHeight() uint32 {
-	if x != nil {
-		return x.TimeoutBlockHeight
+func (x *CreateChainSwapRequest) GetExternalId() string {
+	if x != nil && x.ExternalId != nil {
+		return *x.ExternalId
 	}
-	return 0
+	return ""
 }
 
-type CreateSwapRequest struct {
+type ChannelId struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Pair   *Pair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	// the daemon will pay the swap using the onchain wallet specified in the `wallet` field or any wallet otherwise.
-	SendFromInternal bool `protobuf:"varint,4,opt,name=send_from_internal,json=sendFromInternal,proto3" json:"send_from_internal,omitempty"`
-	// address where the coins should go if the swap fails. Refunds will go to any of the daemons wallets otherwise.
-	RefundAddress *string `protobuf:"bytes,5,opt,name=refund_address,json=refundAddress,proto3,oneof" json:"refund_address,omitempty"`
-	// wallet to pay swap from. only used if `send_from_internal` is set to true
-	Wallet *string `protobuf:"bytes,6,opt,name=wallet,proto3,oneof" json:"wallet,omitempty"`
-	// invoice to use for the swap. if not set, the daemon will get a new invoice from the lightning node
-	Invoice *string `protobuf:"bytes,7,opt,name=invoice,proto3,oneof" json:"invoice,omitempty"`
+	// cln style: 832347x2473x1
+	Cln string `protobuf:"bytes,1,opt,name=cln,proto3" json:"cln,omitempty"`
+	// lnd style: 915175205006540801
+	Lnd uint64 `protobuf:"varint,2,opt,name=lnd,proto3" json:"lnd,omitempty"`
 }
 
-func (x *CreateSwapRequest) Reset() {
-	*x = CreateSwapRequest{}
+func (x *ChannelId) Reset() {
+	*x = ChannelId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[23]
+		mi := &file_boltzrpc_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSwapRequest) String() string {
+func (x *ChannelId) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSwapRequest) ProtoMessage() {}
+func (*ChannelId) ProtoMessage() {}
 
-func (x *CreateSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[23]
+func (x *ChannelId) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,85 +4182,54 @@ func (x *CreateSwapRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSwapRequest.ProtoReflect.Descriptor instead.
-func (*CreateSwapRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *CreateSwapRequest) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *CreateSwapRequest) GetPair() *Pair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+// Deprecated: Use ChannelId.ProtoReflect.Descriptor instead.
+func (*ChannelId) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *CreateSwapRequest) GetSendFromInternal() bool {
+func (x *ChannelId) GetCln() string {
 	if x != nil {
-		return x.SendFromInternal
-	}
-	return false
-}
-
-func (x *CreateSwapRequest) GetRefundAddress() string {
-	if x != nil && x.RefundAddress != nil {
-		return *x.RefundAddress
-	}
-	return ""
-}
-
-func (x *CreateSwapRequest) GetWallet() string {
-	if x != nil && x.Wallet != nil {
-		return *x.Wallet
+		return x.Cln
 	}
 	return ""
 }
 
-func (x *CreateSwapRequest) GetInvoice() string {
-	if x != nil && x.Invoice != nil {
-		return *x.Invoice
+func (x *ChannelId) GetLnd() uint64 {
+	if x != nil {
+		return x.Lnd
 	}
-	return ""
+	return 0
 }
 
-type CreateSwapResponse struct {
+type LightningChannel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	ExpectedAmount int64  `protobuf:"varint,3,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
-	Bip21          string `protobuf:"bytes,4,opt,name=bip21,proto3" json:"bip21,omitempty"`
-	// lockup transaction id. Only populated when `send_from_internal` was specified in the request
-	TxId               string  `protobuf:"bytes,5,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
-	TimeoutBlockHeight uint32  `protobuf:"varint,6,opt,name=timeout_block_height,json=timeoutBlockHeight,proto3" json:"timeout_block_height,omitempty"`
-	TimeoutHours       float32 `protobuf:"fixed32,7,opt,name=timeout_hours,json=timeoutHours,proto3" json:"timeout_hours,omitempty"`
+	Id        *ChannelId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Capacity  uint64     `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	LocalSat  uint64     `protobuf:"varint,3,opt,name=local_sat,json=localSat,proto3" json:"local_sat,omitempty"`
+	RemoteSat uint64     `protobuf:"varint,4,opt,name=remote_sat,json=remoteSat,proto3" json:"remote_sat,omitempty"`
+	PeerId    string     `protobuf:"bytes,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
 }
 
-func (x *CreateSwapResponse) Reset() {
-	*x = CreateSwapResponse{}
+func (x *LightningChannel) Reset() {
+	*x = LightningChannel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[24]
+		mi := &file_boltzrpc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSwapResponse) String() string {
+func (x *LightningChannel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSwapResponse) ProtoMessage() {}
+func (*LightningChannel) ProtoMessage() {}
 
-func (x *CreateSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[24]
+func (x *LightningChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2007,89 +4240,75 @@ func (x *CreateSwapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSwapResponse.ProtoReflect.Descriptor instead.
-func (*CreateSwapResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use LightningChannel.ProtoReflect.Descriptor instead.
+func (*LightningChannel) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CreateSwapResponse) GetId() string {
+func (x *LightningChannel) GetId() *ChannelId {
 	if x != nil {
 		return x.Id
 	}
-	return ""
-}
-
-func (x *CreateSwapResponse) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+	return nil
 }
 
-func (x *CreateSwapResponse) GetExpectedAmount() int64 {
+func (x *LightningChannel) GetCapacity() uint64 {
 	if x != nil {
-		return x.ExpectedAmount
+		return x.Capacity
 	}
 	return 0
 }
 
-func (x *CreateSwapResponse) GetBip21() string {
-	if x != nil {
-		return x.Bip21
-	}
-	return ""
-}
-
-func (x *CreateSwapResponse) GetTxId() string {
+func (x *LightningChannel) GetLocalSat() uint64 {
 	if x != nil {
-		return x.TxId
+		return x.LocalSat
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateSwapResponse) GetTimeoutBlockHeight() uint32 {
+func (x *LightningChannel) GetRemoteSat() uint64 {
 	if x != nil {
-		return x.TimeoutBlockHeight
+		return x.RemoteSat
 	}
 	return 0
 }
 
-func (x *CreateSwapResponse) GetTimeoutHours() float32 {
+func (x *LightningChannel) GetPeerId() string {
 	if x != nil {
-		return x.TimeoutHours
+		return x.PeerId
 	}
-	return 0
+	return ""
 }
 
-type CreateChannelRequest struct {
+type SwapStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// Percentage of inbound liquidity the channel that is opened should have.
-	// 25 by default.
-	InboundLiquidity uint32 `protobuf:"varint,2,opt,name=inbound_liquidity,json=inboundLiquidity,proto3" json:"inbound_liquidity,omitempty"`
-	Private          bool   `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+	TotalFees   uint64 `protobuf:"varint,1,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
+	TotalAmount uint64 `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	AvgFees     uint64 `protobuf:"varint,3,opt,name=avg_fees,json=avgFees,proto3" json:"avg_fees,omitempty"`
+	AvgAmount   uint64 `protobuf:"varint,4,opt,name=avg_amount,json=avgAmount,proto3" json:"avg_amount,omitempty"`
+	Count       uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *CreateChannelRequest) Reset() {
-	*x = CreateChannelRequest{}
+func (x *SwapStats) Reset() {
+	*x = SwapStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[25]
+		mi := &file_boltzrpc_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateChannelRequest) String() string {
+func (x *SwapStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateChannelRequest) ProtoMessage() {}
+func (*SwapStats) ProtoMessage() {}
 
-func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[25]
+func (x *SwapStats) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2100,73 +4319,74 @@ func (x *CreateChannelRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateChannelRequest.ProtoReflect.Descriptor instead.
-func (*CreateChannelRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SwapStats.ProtoReflect.Descriptor instead.
+func (*SwapStats) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CreateChannelRequest) GetAmount() int64 {
+func (x *SwapStats) GetTotalFees() uint64 {
 	if x != nil {
-		return x.Amount
+		return x.TotalFees
 	}
 	return 0
 }
 
-func (x *CreateChannelRequest) GetInboundLiquidity() uint32 {
+func (x *SwapStats) GetTotalAmount() uint64 {
 	if x != nil {
-		return x.InboundLiquidity
+		return x.TotalAmount
 	}
 	return 0
 }
 
-func (x *CreateChannelRequest) GetPrivate() bool {
+func (x *SwapStats) GetAvgFees() uint64 {
 	if x != nil {
-		return x.Private
+		return x.AvgFees
 	}
-	return false
+	return 0
 }
 
-type CreateReverseSwapRequest struct {
+func (x *SwapStats) GetAvgAmount() uint64 {
+	if x != nil {
+		return x.AvgAmount
+	}
+	return 0
+}
+
+func (x *SwapStats) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Budget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// amount of satoshis to swap
-	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// If no value is set, the daemon will query a new address from the lightning node
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// Whether the daemon should broadcast the claim transaction immediately after the lockup transaction is in the mempool.
-	// Should only be used for smaller amounts as it involves trust in boltz.
-	AcceptZeroConf bool  `protobuf:"varint,3,opt,name=accept_zero_conf,json=acceptZeroConf,proto3" json:"accept_zero_conf,omitempty"`
-	Pair           *Pair `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	// a list of channel ids which are allowed for paying the invoice. can be in either cln or lnd style.
-	ChanIds []string `protobuf:"bytes,5,rep,name=chan_ids,json=chanIds,proto3" json:"chan_ids,omitempty"`
-	// wallet from which the onchain address should be generated - only considered if `address` is not set
-	Wallet *string `protobuf:"bytes,6,opt,name=wallet,proto3,oneof" json:"wallet,omitempty"`
-	// Whether the daemon should return immediately after creating the swap or wait until the swap is successful or failed.
-	// It will always return immediately if `accept_zero_conf` is not set.
-	ReturnImmediately *bool `protobuf:"varint,7,opt,name=return_immediately,json=returnImmediately,proto3,oneof" json:"return_immediately,omitempty"`
-	// If set, the daemon will not pay the invoice of the swap and return the invoice to be paid. This implicitly sets `return_immediately` to true.
-	ExternalPay *bool `protobuf:"varint,8,opt,name=external_pay,json=externalPay,proto3,oneof" json:"external_pay,omitempty"`
+	Total     uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Remaining int64  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	StartDate int64  `protobuf:"varint,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   int64  `protobuf:"varint,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 }
 
-func (x *CreateReverseSwapRequest) Reset() {
-	*x = CreateReverseSwapRequest{}
+func (x *Budget) Reset() {
+	*x = Budget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[26]
+		mi := &file_boltzrpc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateReverseSwapRequest) String() string {
+func (x *Budget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReverseSwapRequest) ProtoMessage() {}
+func (*Budget) ProtoMessage() {}
 
-func (x *CreateReverseSwapRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[26]
+func (x *Budget) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2174,102 +4394,72 @@ func (x *CreateReverseSwapRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateReverseSwapRequest.ProtoReflect.Descriptor instead.
-func (*CreateReverseSwapRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *CreateReverseSwapRequest) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *CreateReverseSwapRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *CreateReverseSwapRequest) GetAcceptZeroConf() bool {
-	if x != nil {
-		return x.AcceptZeroConf
-	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *CreateReverseSwapRequest) GetPair() *Pair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
+func (*Budget) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CreateReverseSwapRequest) GetChanIds() []string {
+func (x *Budget) GetTotal() uint64 {
 	if x != nil {
-		return x.ChanIds
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateReverseSwapRequest) GetWallet() string {
-	if x != nil && x.Wallet != nil {
-		return *x.Wallet
+func (x *Budget) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateReverseSwapRequest) GetReturnImmediately() bool {
-	if x != nil && x.ReturnImmediately != nil {
-		return *x.ReturnImmediately
+func (x *Budget) GetStartDate() int64 {
+	if x != nil {
+		return x.StartDate
 	}
-	return false
+	return 0
 }
 
-func (x *CreateReverseSwapRequest) GetExternalPay() bool {
-	if x != nil && x.ExternalPay != nil {
-		return *x.ExternalPay
+func (x *Budget) GetEndDate() int64 {
+	if x != nil {
+		return x.EndDate
 	}
-	return false
+	return 0
 }
 
-type CreateReverseSwapResponse struct {
+type WalletCredentials struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	LockupAddress string `protobuf:"bytes,2,opt,name=lockup_address,json=lockupAddress,proto3" json:"lockup_address,omitempty"`
-	// Only populated when zero-conf is accepted and return_immediately is set to false
-	RoutingFeeMilliSat *uint64 `protobuf:"varint,3,opt,name=routing_fee_milli_sat,json=routingFeeMilliSat,proto3,oneof" json:"routing_fee_milli_sat,omitempty"`
-	// Only populated when zero-conf is accepted and return_immediately is set to false
-	ClaimTransactionId *string `protobuf:"bytes,4,opt,name=claim_transaction_id,json=claimTransactionId,proto3,oneof" json:"claim_transaction_id,omitempty"`
-	// Invoice to be paid. Only populated when `external_pay` is set to true
-	Invoice *string `protobuf:"bytes,5,opt,name=invoice,proto3,oneof" json:"invoice,omitempty"`
+	// only one of these is allowed to be present
+	Mnemonic       *string `protobuf:"bytes,1,opt,name=mnemonic,proto3,oneof" json:"mnemonic,omitempty"`
+	Xpub           *string `protobuf:"bytes,2,opt,name=xpub,proto3,oneof" json:"xpub,omitempty"`
+	CoreDescriptor *string `protobuf:"bytes,3,opt,name=core_descriptor,json=coreDescriptor,proto3,oneof" json:"core_descriptor,omitempty"`
+	// only used in combination with mnemonic
+	Subaccount *uint64 `protobuf:"varint,4,opt,name=subaccount,proto3,oneof" json:"subaccount,omitempty"`
 }
 
-func (x *CreateReverseSwapResponse) Reset() {
-	*x = CreateReverseSwapResponse{}
+func (x *WalletCredentials) Reset() {
+	*x = WalletCredentials{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[27]
+		mi := &file_boltzrpc_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateReverseSwapResponse) String() string {
+func (x *WalletCredentials) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateReverseSwapResponse) ProtoMessage() {}
+func (*WalletCredentials) ProtoMessage() {}
 
-func (x *CreateReverseSwapResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[27]
+func (x *WalletCredentials) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2280,74 +4470,65 @@ func (x *CreateReverseSwapResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateReverseSwapResponse.ProtoReflect.Descriptor instead.
-func (*CreateReverseSwapResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use WalletCredentials.ProtoReflect.Descriptor instead.
+func (*WalletCredentials) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CreateReverseSwapResponse) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *WalletCredentials) GetMnemonic() string {
+	if x != nil && x.Mnemonic != nil {
+		return *x.Mnemonic
 	}
 	return ""
 }
 
-func (x *CreateReverseSwapResponse) GetLockupAddress() string {
-	if x != nil {
-		return x.LockupAddress
+func (x *WalletCredentials) GetXpub() string {
+	if x != nil && x.Xpub != nil {
+		return *x.Xpub
 	}
 	return ""
 }
 
-func (x *CreateReverseSwapResponse) GetRoutingFeeMilliSat() uint64 {
-	if x != nil && x.RoutingFeeMilliSat != nil {
-		return *x.RoutingFeeMilliSat
-	}
-	return 0
-}
-
-func (x *CreateReverseSwapResponse) GetClaimTransactionId() string {
-	if x != nil && x.ClaimTransactionId != nil {
-		return *x.ClaimTransactionId
+func (x *WalletCredentials) GetCoreDescriptor() string {
+	if x != nil && x.CoreDescriptor != nil {
+		return *x.CoreDescriptor
 	}
 	return ""
 }
 
-func (x *CreateReverseSwapResponse) GetInvoice() string {
-	if x != nil && x.Invoice != nil {
-		return *x.Invoice
+func (x *WalletCredentials) GetSubaccount() uint64 {
+	if x != nil && x.Subaccount != nil {
+		return *x.Subaccount
 	}
-	return ""
+	return 0
 }
 
-type ChannelId struct {
+type WalletInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// cln style: 832347x2473x1
-	Cln string `protobuf:"bytes,1,opt,name=cln,proto3" json:"cln,omitempty"`
-	// lnd style: 915175205006540801
-	Lnd uint64 `protobuf:"varint,2,opt,name=lnd,proto3" json:"lnd,omitempty"`
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Currency Currency `protobuf:"varint,2,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
 }
 
-func (x *ChannelId) Reset() {
-	*x = ChannelId{}
+func (x *WalletInfo) Reset() {
+	*x = WalletInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[28]
+		mi := &file_boltzrpc_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChannelId) String() string {
+func (x *WalletInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChannelId) ProtoMessage() {}
+func (*WalletInfo) ProtoMessage() {}
 
-func (x *ChannelId) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[28]
+func (x *WalletInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2358,54 +4539,53 @@ func (x *ChannelId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChannelId.ProtoReflect.Descriptor instead.
-func (*ChannelId) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use WalletInfo.ProtoReflect.Descriptor instead.
+func (*WalletInfo) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *ChannelId) GetCln() string {
+func (x *WalletInfo) GetName() string {
 	if x != nil {
-		return x.Cln
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ChannelId) GetLnd() uint64 {
+func (x *WalletInfo) GetCurrency() Currency {
 	if x != nil {
-		return x.Lnd
+		return x.Currency
 	}
-	return 0
+	return Currency_BTC
 }
 
-type LightningChannel struct {
+type ImportWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        *ChannelId `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Capacity  uint64     `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	LocalSat  uint64     `protobuf:"varint,3,opt,name=local_sat,json=localSat,proto3" json:"local_sat,omitempty"`
-	RemoteSat uint64     `protobuf:"varint,4,opt,name=remote_sat,json=remoteSat,proto3" json:"remote_sat,omitempty"`
-	PeerId    string     `protobuf:"bytes,5,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Credentials *WalletCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	Info        *WalletInfo        `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
+	// the password to encrypt the wallet with. If there are existing ecnrypted wallets, the same password will have to be used.
+	Password *string `protobuf:"bytes,3,opt,name=password,proto3,oneof" json:"password,omitempty"`
 }
 
-func (x *LightningChannel) Reset() {
-	*x = LightningChannel{}
+func (x *ImportWalletRequest) Reset() {
+	*x = ImportWalletRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[29]
+		mi := &file_boltzrpc_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LightningChannel) String() string {
+func (x *ImportWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LightningChannel) ProtoMessage() {}
+func (*ImportWalletRequest) ProtoMessage() {}
 
-func (x *LightningChannel) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[29]
+func (x *ImportWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2416,75 +4596,59 @@ func (x *LightningChannel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LightningChannel.ProtoReflect.Descriptor instead.
-func (*LightningChannel) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ImportWalletRequest.ProtoReflect.Descriptor instead.
+func (*ImportWalletRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *LightningChannel) GetId() *ChannelId {
+func (x *ImportWalletRequest) GetCredentials() *WalletCredentials {
 	if x != nil {
-		return x.Id
+		return x.Credentials
 	}
 	return nil
 }
 
-func (x *LightningChannel) GetCapacity() uint64 {
-	if x != nil {
-		return x.Capacity
-	}
-	return 0
-}
-
-func (x *LightningChannel) GetLocalSat() uint64 {
-	if x != nil {
-		return x.LocalSat
-	}
-	return 0
-}
-
-func (x *LightningChannel) GetRemoteSat() uint64 {
+func (x *ImportWalletRequest) GetInfo() *WalletInfo {
 	if x != nil {
-		return x.RemoteSat
+		return x.Info
 	}
-	return 0
+	return nil
 }
 
-func (x *LightningChannel) GetPeerId() string {
-	if x != nil {
-		return x.PeerId
+func (x *ImportWalletRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
 	return ""
 }
 
-type SwapStats struct {
+type CreateWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TotalFees   uint64 `protobuf:"varint,1,opt,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty"`
-	TotalAmount uint64 `protobuf:"varint,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	AvgFees     uint64 `protobuf:"varint,3,opt,name=avg_fees,json=avgFees,proto3" json:"avg_fees,omitempty"`
-	AvgAmount   uint64 `protobuf:"varint,4,opt,name=avg_amount,json=avgAmount,proto3" json:"avg_amount,omitempty"`
-	Count       uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Info *WalletInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// the password to encrypt the wallet with. If there are existing ecnrypted wallets, the same password will have to be used.
+	Password *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
 }
 
-func (x *SwapStats) Reset() {
-	*x = SwapStats{}
+func (x *CreateWalletRequest) Reset() {
+	*x = CreateWalletRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[30]
+		mi := &file_boltzrpc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SwapStats) String() string {
+func (x *CreateWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwapStats) ProtoMessage() {}
+func (*CreateWalletRequest) ProtoMessage() {}
 
-func (x *SwapStats) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[30]
+func (x *CreateWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2495,74 +4659,52 @@ func (x *SwapStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwapStats.ProtoReflect.Descriptor instead.
-func (*SwapStats) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *SwapStats) GetTotalFees() uint64 {
-	if x != nil {
-		return x.TotalFees
-	}
-	return 0
-}
-
-func (x *SwapStats) GetTotalAmount() uint64 {
-	if x != nil {
-		return x.TotalAmount
-	}
-	return 0
-}
-
-func (x *SwapStats) GetAvgFees() uint64 {
-	if x != nil {
-		return x.AvgFees
-	}
-	return 0
+// Deprecated: Use CreateWalletRequest.ProtoReflect.Descriptor instead.
+func (*CreateWalletRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *SwapStats) GetAvgAmount() uint64 {
+func (x *CreateWalletRequest) GetInfo() *WalletInfo {
 	if x != nil {
-		return x.AvgAmount
+		return x.Info
 	}
-	return 0
+	return nil
 }
 
-func (x *SwapStats) GetCount() uint64 {
-	if x != nil {
-		return x.Count
+func (x *CreateWalletRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
-	return 0
+	return ""
 }
 
-type Budget struct {
+type SetSubaccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total     uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Remaining int64  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
-	StartDate int64  `protobuf:"varint,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate   int64  `protobuf:"varint,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The subaccount to use. If not set, a new one will be created.
+	Subaccount *uint64 `protobuf:"varint,2,opt,name=subaccount,proto3,oneof" json:"subaccount,omitempty"`
 }
 
-func (x *Budget) Reset() {
-	*x = Budget{}
+func (x *SetSubaccountRequest) Reset() {
+	*x = SetSubaccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[31]
+		mi := &file_boltzrpc_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Budget) String() string {
+func (x *SetSubaccountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Budget) ProtoMessage() {}
+func (*SetSubaccountRequest) ProtoMessage() {}
 
-func (x *Budget) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[31]
+func (x *SetSubaccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2573,69 +4715,89 @@ func (x *Budget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Budget.ProtoReflect.Descriptor instead.
-func (*Budget) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SetSubaccountRequest.ProtoReflect.Descriptor instead.
+func (*SetSubaccountRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Budget) GetTotal() uint64 {
+func (x *SetSubaccountRequest) GetName() string {
 	if x != nil {
-		return x.Total
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Budget) GetRemaining() int64 {
-	if x != nil {
-		return x.Remaining
+func (x *SetSubaccountRequest) GetSubaccount() uint64 {
+	if x != nil && x.Subaccount != nil {
+		return *x.Subaccount
 	}
 	return 0
 }
 
-func (x *Budget) GetStartDate() int64 {
-	if x != nil {
-		return x.StartDate
+type GetSubaccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSubaccountsRequest) Reset() {
+	*x = GetSubaccountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_boltzrpc_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Budget) GetEndDate() int64 {
-	if x != nil {
-		return x.EndDate
+func (x *GetSubaccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubaccountsRequest) ProtoMessage() {}
+
+func (x *GetSubaccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type WalletCredentials struct {
+// Deprecated: Use GetSubaccountsRequest.ProtoReflect.Descriptor instead.
+func (*GetSubaccountsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{51}
+}
+
+type GetSubaccountsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// only one of these is allowed to be present
-	Mnemonic       *string `protobuf:"bytes,1,opt,name=mnemonic,proto3,oneof" json:"mnemonic,omitempty"`
-	Xpub           *string `protobuf:"bytes,2,opt,name=xpub,proto3,oneof" json:"xpub,omitempty"`
-	CoreDescriptor *string `protobuf:"bytes,3,opt,name=core_descriptor,json=coreDescriptor,proto3,oneof" json:"core_descriptor,omitempty"`
-	// only used in combination with mnemonic
-	Subaccount *uint64 `protobuf:"varint,4,opt,name=subaccount,proto3,oneof" json:"subaccount,omitempty"`
+	Current     *uint64       `protobuf:"varint,1,opt,name=current,proto3,oneof" json:"current,omitempty"`
+	Subaccounts []*Subaccount `protobuf:"bytes,2,rep,name=subaccounts,proto3" json:"subaccounts,omitempty"`
 }
 
-func (x *WalletCredentials) Reset() {
-	*x = WalletCredentials{}
+func (x *GetSubaccountsResponse) Reset() {
+	*x = GetSubaccountsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[32]
+		mi := &file_boltzrpc_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletCredentials) String() string {
+func (x *GetSubaccountsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletCredentials) ProtoMessage() {}
+func (*GetSubaccountsResponse) ProtoMessage() {}
 
-func (x *WalletCredentials) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[32]
+func (x *GetSubaccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2646,65 +4808,48 @@ func (x *WalletCredentials) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletCredentials.ProtoReflect.Descriptor instead.
-func (*WalletCredentials) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *WalletCredentials) GetMnemonic() string {
-	if x != nil && x.Mnemonic != nil {
-		return *x.Mnemonic
-	}
-	return ""
-}
-
-func (x *WalletCredentials) GetXpub() string {
-	if x != nil && x.Xpub != nil {
-		return *x.Xpub
-	}
-	return ""
+// Deprecated: Use GetSubaccountsResponse.ProtoReflect.Descriptor instead.
+func (*GetSubaccountsResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *WalletCredentials) GetCoreDescriptor() string {
-	if x != nil && x.CoreDescriptor != nil {
-		return *x.CoreDescriptor
+func (x *GetSubaccountsResponse) GetCurrent() uint64 {
+	if x != nil && x.Current != nil {
+		return *x.Current
 	}
-	return ""
+	return 0
 }
 
-func (x *WalletCredentials) GetSubaccount() uint64 {
-	if x != nil && x.Subaccount != nil {
-		return *x.Subaccount
+func (x *GetSubaccountsResponse) GetSubaccounts() []*Subaccount {
+	if x != nil {
+		return x.Subaccounts
 	}
-	return 0
+	return nil
 }
 
-type WalletInfo struct {
+type ImportWalletResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Currency Currency `protobuf:"varint,2,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
 }
 
-func (x *WalletInfo) Reset() {
-	*x = WalletInfo{}
+func (x *ImportWalletResponse) Reset() {
+	*x = ImportWalletResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[33]
+		mi := &file_boltzrpc_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WalletInfo) String() string {
+func (x *ImportWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletInfo) ProtoMessage() {}
+func (*ImportWalletResponse) ProtoMessage() {}
 
-func (x *WalletInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[33]
+func (x *ImportWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2715,53 +4860,37 @@ func (x *WalletInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletInfo.ProtoReflect.Descriptor instead.
-func (*WalletInfo) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{33}
-}
-
-func (x *WalletInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *WalletInfo) GetCurrency() Currency {
-	if x != nil {
-		return x.Currency
-	}
-	return Currency_BTC
+// Deprecated: Use ImportWalletResponse.ProtoReflect.Descriptor instead.
+func (*ImportWalletResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{53}
 }
 
-type ImportWalletRequest struct {
+type GetWalletsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Credentials *WalletCredentials `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
-	Info        *WalletInfo        `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
-	// the password to encrypt the wallet with. If there are existing ecnrypted wallets, the same password will have to be used.
-	Password *string `protobuf:"bytes,3,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	Currency        *Currency `protobuf:"varint,1,opt,name=currency,proto3,enum=boltzrpc.Currency,oneof" json:"currency,omitempty"`
+	IncludeReadonly *bool     `protobuf:"varint,2,opt,name=include_readonly,json=includeReadonly,proto3,oneof" json:"include_readonly,omitempty"`
 }
 
-func (x *ImportWalletRequest) Reset() {
-	*x = ImportWalletRequest{}
+func (x *GetWalletsRequest) Reset() {
+	*x = GetWalletsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[34]
+		mi := &file_boltzrpc_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportWalletRequest) String() string {
+func (x *GetWalletsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportWalletRequest) ProtoMessage() {}
+func (*GetWalletsRequest) ProtoMessage() {}
 
-func (x *ImportWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[34]
+func (x *GetWalletsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2772,59 +4901,50 @@ func (x *ImportWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportWalletRequest.ProtoReflect.Descriptor instead.
-func (*ImportWalletRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *ImportWalletRequest) GetCredentials() *WalletCredentials {
-	if x != nil {
-		return x.Credentials
-	}
-	return nil
+// Deprecated: Use GetWalletsRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ImportWalletRequest) GetInfo() *WalletInfo {
-	if x != nil {
-		return x.Info
+func (x *GetWalletsRequest) GetCurrency() Currency {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
 	}
-	return nil
+	return Currency_BTC
 }
 
-func (x *ImportWalletRequest) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
+func (x *GetWalletsRequest) GetIncludeReadonly() bool {
+	if x != nil && x.IncludeReadonly != nil {
+		return *x.IncludeReadonly
 	}
-	return ""
+	return false
 }
 
-type CreateWalletRequest struct {
+type GetWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Info *WalletInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
-	// the password to encrypt the wallet with. If there are existing ecnrypted wallets, the same password will have to be used.
-	Password *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *CreateWalletRequest) Reset() {
-	*x = CreateWalletRequest{}
+func (x *GetWalletRequest) Reset() {
+	*x = GetWalletRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[35]
+		mi := &file_boltzrpc_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateWalletRequest) String() string {
+func (x *GetWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateWalletRequest) ProtoMessage() {}
+func (*GetWalletRequest) ProtoMessage() {}
 
-func (x *CreateWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[35]
+func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2835,52 +4955,44 @@ func (x *CreateWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateWalletRequest.ProtoReflect.Descriptor instead.
-func (*CreateWalletRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CreateWalletRequest) GetInfo() *WalletInfo {
+func (x *GetWalletRequest) GetName() string {
 	if x != nil {
-		return x.Info
-	}
-	return nil
-}
-
-func (x *CreateWalletRequest) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
+		return x.Name
 	}
 	return ""
 }
 
-type SetSubaccountRequest struct {
+type GetWalletCredentialsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The subaccount to use. If not set, a new one will be created.
-	Subaccount *uint64 `protobuf:"varint,2,opt,name=subaccount,proto3,oneof" json:"subaccount,omitempty"`
+	Name     string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Password *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
 }
 
-func (x *SetSubaccountRequest) Reset() {
-	*x = SetSubaccountRequest{}
+func (x *GetWalletCredentialsRequest) Reset() {
+	*x = GetWalletCredentialsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[36]
+		mi := &file_boltzrpc_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetSubaccountRequest) String() string {
+func (x *GetWalletCredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSubaccountRequest) ProtoMessage() {}
+func (*GetWalletCredentialsRequest) ProtoMessage() {}
 
-func (x *SetSubaccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[36]
+func (x *GetWalletCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2891,48 +5003,50 @@ func (x *SetSubaccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSubaccountRequest.ProtoReflect.Descriptor instead.
-func (*SetSubaccountRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetWalletCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *SetSubaccountRequest) GetName() string {
+func (x *GetWalletCredentialsRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *SetSubaccountRequest) GetSubaccount() uint64 {
-	if x != nil && x.Subaccount != nil {
-		return *x.Subaccount
+func (x *GetWalletCredentialsRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
-	return 0
+	return ""
 }
 
-type GetSubaccountsRequest struct {
+type RemoveWalletRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *GetSubaccountsRequest) Reset() {
-	*x = GetSubaccountsRequest{}
+func (x *RemoveWalletRequest) Reset() {
+	*x = RemoveWalletRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[37]
+		mi := &file_boltzrpc_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSubaccountsRequest) String() string {
+func (x *RemoveWalletRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubaccountsRequest) ProtoMessage() {}
+func (*RemoveWalletRequest) ProtoMessage() {}
 
-func (x *GetSubaccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[37]
+func (x *RemoveWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2943,37 +5057,46 @@ func (x *GetSubaccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubaccountsRequest.ProtoReflect.Descriptor instead.
-func (*GetSubaccountsRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use RemoveWalletRequest.ProtoReflect.Descriptor instead.
+func (*RemoveWalletRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{57}
 }
 
-type GetSubaccountsResponse struct {
+func (x *RemoveWalletRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Wallet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Current     *uint64       `protobuf:"varint,1,opt,name=current,proto3,oneof" json:"current,omitempty"`
-	Subaccounts []*Subaccount `protobuf:"bytes,2,rep,name=subaccounts,proto3" json:"subaccounts,omitempty"`
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Currency Currency `protobuf:"varint,2,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
+	Readonly bool     `protobuf:"varint,3,opt,name=readonly,proto3" json:"readonly,omitempty"`
+	Balance  *Balance `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
-func (x *GetSubaccountsResponse) Reset() {
-	*x = GetSubaccountsResponse{}
+func (x *Wallet) Reset() {
+	*x = Wallet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[38]
+		mi := &file_boltzrpc_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSubaccountsResponse) String() string {
+func (x *Wallet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSubaccountsResponse) ProtoMessage() {}
+func (*Wallet) ProtoMessage() {}
 
-func (x *GetSubaccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[38]
+func (x *Wallet) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2984,48 +5107,64 @@ func (x *GetSubaccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSubaccountsResponse.ProtoReflect.Descriptor instead.
-func (*GetSubaccountsResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
+func (*Wallet) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetSubaccountsResponse) GetCurrent() uint64 {
-	if x != nil && x.Current != nil {
-		return *x.Current
+func (x *Wallet) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *GetSubaccountsResponse) GetSubaccounts() []*Subaccount {
+func (x *Wallet) GetCurrency() Currency {
 	if x != nil {
-		return x.Subaccounts
+		return x.Currency
+	}
+	return Currency_BTC
+}
+
+func (x *Wallet) GetReadonly() bool {
+	if x != nil {
+		return x.Readonly
+	}
+	return false
+}
+
+func (x *Wallet) GetBalance() *Balance {
+	if x != nil {
+		return x.Balance
 	}
 	return nil
 }
 
-type ImportWalletResponse struct {
+type Wallets struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Wallets []*Wallet `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
 }
 
-func (x *ImportWalletResponse) Reset() {
-	*x = ImportWalletResponse{}
+func (x *Wallets) Reset() {
+	*x = Wallets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[39]
+		mi := &file_boltzrpc_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ImportWalletResponse) String() string {
+func (x *Wallets) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ImportWalletResponse) ProtoMessage() {}
+func (*Wallets) ProtoMessage() {}
 
-func (x *ImportWalletResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[39]
+func (x *Wallets) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3036,37 +5175,45 @@ func (x *ImportWalletResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ImportWalletResponse.ProtoReflect.Descriptor instead.
-func (*ImportWalletResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use Wallets.ProtoReflect.Descriptor instead.
+func (*Wallets) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{59}
 }
 
-type GetWalletsRequest struct {
+func (x *Wallets) GetWallets() []*Wallet {
+	if x != nil {
+		return x.Wallets
+	}
+	return nil
+}
+
+type Balance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Currency        *Currency `protobuf:"varint,1,opt,name=currency,proto3,enum=boltzrpc.Currency,oneof" json:"currency,omitempty"`
-	IncludeReadonly *bool     `protobuf:"varint,2,opt,name=include_readonly,json=includeReadonly,proto3,oneof" json:"include_readonly,omitempty"`
+	Total       uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Confirmed   uint64 `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Unconfirmed uint64 `protobuf:"varint,3,opt,name=unconfirmed,proto3" json:"unconfirmed,omitempty"`
 }
 
-func (x *GetWalletsRequest) Reset() {
-	*x = GetWalletsRequest{}
+func (x *Balance) Reset() {
+	*x = Balance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[40]
+		mi := &file_boltzrpc_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWalletsRequest) String() string {
+func (x *Balance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWalletsRequest) ProtoMessage() {}
+func (*Balance) ProtoMessage() {}
 
-func (x *GetWalletsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[40]
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3077,50 +5224,59 @@ func (x *GetWalletsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWalletsRequest.ProtoReflect.Descriptor instead.
-func (*GetWalletsRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetWalletsRequest) GetCurrency() Currency {
-	if x != nil && x.Currency != nil {
-		return *x.Currency
+func (x *Balance) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
 	}
-	return Currency_BTC
+	return 0
 }
 
-func (x *GetWalletsRequest) GetIncludeReadonly() bool {
-	if x != nil && x.IncludeReadonly != nil {
-		return *x.IncludeReadonly
+func (x *Balance) GetConfirmed() uint64 {
+	if x != nil {
+		return x.Confirmed
 	}
-	return false
+	return 0
 }
 
-type GetWalletRequest struct {
+func (x *Balance) GetUnconfirmed() uint64 {
+	if x != nil {
+		return x.Unconfirmed
+	}
+	return 0
+}
+
+type Subaccount struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Balance *Balance `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	Pointer uint64   `protobuf:"varint,2,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	Type    string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (x *GetWalletRequest) Reset() {
-	*x = GetWalletRequest{}
+func (x *Subaccount) Reset() {
+	*x = Subaccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[41]
+		mi := &file_boltzrpc_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWalletRequest) String() string {
+func (x *Subaccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWalletRequest) ProtoMessage() {}
+func (*Subaccount) ProtoMessage() {}
 
-func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[41]
+func (x *Subaccount) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3131,44 +5287,55 @@ func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
-func (*GetWalletRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use Subaccount.ProtoReflect.Descriptor instead.
+func (*Subaccount) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetWalletRequest) GetName() string {
+func (x *Subaccount) GetBalance() *Balance {
 	if x != nil {
-		return x.Name
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *Subaccount) GetPointer() uint64 {
+	if x != nil {
+		return x.Pointer
+	}
+	return 0
+}
+
+func (x *Subaccount) GetType() string {
+	if x != nil {
+		return x.Type
 	}
 	return ""
 }
 
-type GetWalletCredentialsRequest struct {
+type RemoveWalletResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Name     string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Password *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
 }
 
-func (x *GetWalletCredentialsRequest) Reset() {
-	*x = GetWalletCredentialsRequest{}
+func (x *RemoveWalletResponse) Reset() {
+	*x = RemoveWalletResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[42]
+		mi := &file_boltzrpc_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWalletCredentialsRequest) String() string {
+func (x *RemoveWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWalletCredentialsRequest) ProtoMessage() {}
+func (*RemoveWalletResponse) ProtoMessage() {}
 
-func (x *GetWalletCredentialsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[42]
+func (x *RemoveWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3179,50 +5346,36 @@ func (x *GetWalletCredentialsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWalletCredentialsRequest.ProtoReflect.Descriptor instead.
-func (*GetWalletCredentialsRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *GetWalletCredentialsRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GetWalletCredentialsRequest) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
-	}
-	return ""
+// Deprecated: Use RemoveWalletResponse.ProtoReflect.Descriptor instead.
+func (*RemoveWalletResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{62}
 }
 
-type RemoveWalletRequest struct {
+type UnlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *RemoveWalletRequest) Reset() {
-	*x = RemoveWalletRequest{}
+func (x *UnlockRequest) Reset() {
+	*x = UnlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[43]
+		mi := &file_boltzrpc_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveWalletRequest) String() string {
+func (x *UnlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveWalletRequest) ProtoMessage() {}
+func (*UnlockRequest) ProtoMessage() {}
 
-func (x *RemoveWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[43]
+func (x *UnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3233,46 +5386,43 @@ func (x *RemoveWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveWalletRequest.ProtoReflect.Descriptor instead.
-func (*RemoveWalletRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use UnlockRequest.ProtoReflect.Descriptor instead.
+func (*UnlockRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *RemoveWalletRequest) GetName() string {
+func (x *UnlockRequest) GetPassword() string {
 	if x != nil {
-		return x.Name
+		return x.Password
 	}
 	return ""
 }
 
-type Wallet struct {
+type VerifyWalletPasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Currency Currency `protobuf:"varint,2,opt,name=currency,proto3,enum=boltzrpc.Currency" json:"currency,omitempty"`
-	Readonly bool     `protobuf:"varint,3,opt,name=readonly,proto3" json:"readonly,omitempty"`
-	Balance  *Balance `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *Wallet) Reset() {
-	*x = Wallet{}
+func (x *VerifyWalletPasswordRequest) Reset() {
+	*x = VerifyWalletPasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[44]
+		mi := &file_boltzrpc_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Wallet) String() string {
+func (x *VerifyWalletPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Wallet) ProtoMessage() {}
+func (*VerifyWalletPasswordRequest) ProtoMessage() {}
 
-func (x *Wallet) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[44]
+func (x *VerifyWalletPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3283,64 +5433,43 @@ func (x *Wallet) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
-func (*Wallet) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use VerifyWalletPasswordRequest.ProtoReflect.Descriptor instead.
+func (*VerifyWalletPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *Wallet) GetName() string {
+func (x *VerifyWalletPasswordRequest) GetPassword() string {
 	if x != nil {
-		return x.Name
+		return x.Password
 	}
 	return ""
 }
 
-func (x *Wallet) GetCurrency() Currency {
-	if x != nil {
-		return x.Currency
-	}
-	return Currency_BTC
-}
-
-func (x *Wallet) GetReadonly() bool {
-	if x != nil {
-		return x.Readonly
-	}
-	return false
-}
-
-func (x *Wallet) GetBalance() *Balance {
-	if x != nil {
-		return x.Balance
-	}
-	return nil
-}
-
-type Wallets struct {
+type VerifyWalletPasswordResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Wallets []*Wallet `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets,omitempty"`
+	Correct bool `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
 }
 
-func (x *Wallets) Reset() {
-	*x = Wallets{}
+func (x *VerifyWalletPasswordResponse) Reset() {
+	*x = VerifyWalletPasswordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[45]
+		mi := &file_boltzrpc_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Wallets) String() string {
+func (x *VerifyWalletPasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Wallets) ProtoMessage() {}
+func (*VerifyWalletPasswordResponse) ProtoMessage() {}
 
-func (x *Wallets) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[45]
+func (x *VerifyWalletPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3351,45 +5480,44 @@ func (x *Wallets) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Wallets.ProtoReflect.Descriptor instead.
-func (*Wallets) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use VerifyWalletPasswordResponse.ProtoReflect.Descriptor instead.
+func (*VerifyWalletPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *Wallets) GetWallets() []*Wallet {
+func (x *VerifyWalletPasswordResponse) GetCorrect() bool {
 	if x != nil {
-		return x.Wallets
+		return x.Correct
 	}
-	return nil
+	return false
 }
 
-type Balance struct {
+type ChangeWalletPasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total       uint64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Confirmed   uint64 `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
-	Unconfirmed uint64 `protobuf:"varint,3,opt,name=unconfirmed,proto3" json:"unconfirmed,omitempty"`
+	Old string `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
+	New string `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
 }
 
-func (x *Balance) Reset() {
-	*x = Balance{}
+func (x *ChangeWalletPasswordRequest) Reset() {
+	*x = ChangeWalletPasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[46]
+		mi := &file_boltzrpc_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Balance) String() string {
+func (x *ChangeWalletPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Balance) ProtoMessage() {}
+func (*ChangeWalletPasswordRequest) ProtoMessage() {}
 
-func (x *Balance) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[46]
+func (x *ChangeWalletPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3400,59 +5528,53 @@ func (x *Balance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
-func (*Balance) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *Balance) GetTotal() uint64 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use ChangeWalletPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangeWalletPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Balance) GetConfirmed() uint64 {
+func (x *ChangeWalletPasswordRequest) GetOld() string {
 	if x != nil {
-		return x.Confirmed
+		return x.Old
 	}
-	return 0
+	return ""
 }
 
-func (x *Balance) GetUnconfirmed() uint64 {
+func (x *ChangeWalletPasswordRequest) GetNew() string {
 	if x != nil {
-		return x.Unconfirmed
+		return x.New
 	}
-	return 0
+	return ""
 }
 
-type Subaccount struct {
+type BackupDatabaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Balance *Balance `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	Pointer uint64   `protobuf:"varint,2,opt,name=pointer,proto3" json:"pointer,omitempty"`
-	Type    string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Path of the backup file, which must not exist yet. Defaults to a new file in the configured backup directory
+	Path *string `protobuf:"bytes,1,opt,name=path,proto3,oneof" json:"path,omitempty"`
+	// Encrypts the backup with this password
+	Password *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
 }
 
-func (x *Subaccount) Reset() {
-	*x = Subaccount{}
+func (x *BackupDatabaseRequest) Reset() {
+	*x = BackupDatabaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[47]
+		mi := &file_boltzrpc_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Subaccount) String() string {
+func (x *BackupDatabaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Subaccount) ProtoMessage() {}
+func (*BackupDatabaseRequest) ProtoMessage() {}
 
-func (x *Subaccount) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[47]
+func (x *BackupDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3463,55 +5585,50 @@ func (x *Subaccount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Subaccount.ProtoReflect.Descriptor instead.
-func (*Subaccount) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *Subaccount) GetBalance() *Balance {
-	if x != nil {
-		return x.Balance
-	}
-	return nil
+// Deprecated: Use BackupDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *Subaccount) GetPointer() uint64 {
-	if x != nil {
-		return x.Pointer
+func (x *BackupDatabaseRequest) GetPath() string {
+	if x != nil && x.Path != nil {
+		return *x.Path
 	}
-	return 0
+	return ""
 }
 
-func (x *Subaccount) GetType() string {
-	if x != nil {
-		return x.Type
+func (x *BackupDatabaseRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
 	return ""
 }
 
-type RemoveWalletResponse struct {
+type BackupDatabaseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *RemoveWalletResponse) Reset() {
-	*x = RemoveWalletResponse{}
+func (x *BackupDatabaseResponse) Reset() {
+	*x = BackupDatabaseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[48]
+		mi := &file_boltzrpc_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveWalletResponse) String() string {
+func (x *BackupDatabaseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveWalletResponse) ProtoMessage() {}
+func (*BackupDatabaseResponse) ProtoMessage() {}
 
-func (x *RemoveWalletResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[48]
+func (x *BackupDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3522,36 +5639,46 @@ func (x *RemoveWalletResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveWalletResponse.ProtoReflect.Descriptor instead.
-func (*RemoveWalletResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use BackupDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{68}
 }
 
-type UnlockRequest struct {
+func (x *BackupDatabaseResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RestoreDatabaseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	// Path of the backup file
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Password the backup was encrypted with
+	Password *string `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
 }
 
-func (x *UnlockRequest) Reset() {
-	*x = UnlockRequest{}
+func (x *RestoreDatabaseRequest) Reset() {
+	*x = RestoreDatabaseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[49]
+		mi := &file_boltzrpc_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnlockRequest) String() string {
+func (x *RestoreDatabaseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlockRequest) ProtoMessage() {}
+func (*RestoreDatabaseRequest) ProtoMessage() {}
 
-func (x *UnlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[49]
+func (x *RestoreDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3562,43 +5689,51 @@ func (x *UnlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlockRequest.ProtoReflect.Descriptor instead.
-func (*UnlockRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use RestoreDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*RestoreDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *UnlockRequest) GetPassword() string {
+func (x *RestoreDatabaseRequest) GetPath() string {
 	if x != nil {
-		return x.Password
+		return x.Path
 	}
 	return ""
 }
 
-type VerifyWalletPasswordRequest struct {
+func (x *RestoreDatabaseRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+type SubmarinePair_Fees struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Percentage float32 `protobuf:"fixed32,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	MinerFees  uint64  `protobuf:"varint,2,opt,name=miner_fees,json=minerFees,proto3" json:"miner_fees,omitempty"`
 }
 
-func (x *VerifyWalletPasswordRequest) Reset() {
-	*x = VerifyWalletPasswordRequest{}
+func (x *SubmarinePair_Fees) Reset() {
+	*x = SubmarinePair_Fees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[50]
+		mi := &file_boltzrpc_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VerifyWalletPasswordRequest) String() string {
+func (x *SubmarinePair_Fees) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyWalletPasswordRequest) ProtoMessage() {}
+func (*SubmarinePair_Fees) ProtoMessage() {}
 
-func (x *VerifyWalletPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[50]
+func (x *SubmarinePair_Fees) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3609,43 +5744,51 @@ func (x *VerifyWalletPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyWalletPasswordRequest.ProtoReflect.Descriptor instead.
-func (*VerifyWalletPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use SubmarinePair_Fees.ProtoReflect.Descriptor instead.
+func (*SubmarinePair_Fees) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{12, 0}
 }
 
-func (x *VerifyWalletPasswordRequest) GetPassword() string {
+func (x *SubmarinePair_Fees) GetPercentage() float32 {
 	if x != nil {
-		return x.Password
+		return x.Percentage
 	}
-	return ""
+	return 0
 }
 
-type VerifyWalletPasswordResponse struct {
+func (x *SubmarinePair_Fees) GetMinerFees() uint64 {
+	if x != nil {
+		return x.MinerFees
+	}
+	return 0
+}
+
+type ReversePair_Fees struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Correct bool `protobuf:"varint,1,opt,name=correct,proto3" json:"correct,omitempty"`
+	Percentage float32                     `protobuf:"fixed32,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	MinerFees  *ReversePair_Fees_MinerFees `protobuf:"bytes,2,opt,name=miner_fees,json=minerFees,proto3" json:"miner_fees,omitempty"`
 }
 
-func (x *VerifyWalletPasswordResponse) Reset() {
-	*x = VerifyWalletPasswordResponse{}
+func (x *ReversePair_Fees) Reset() {
+	*x = ReversePair_Fees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[51]
+		mi := &file_boltzrpc_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VerifyWalletPasswordResponse) String() string {
+func (x *ReversePair_Fees) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyWalletPasswordResponse) ProtoMessage() {}
+func (*ReversePair_Fees) ProtoMessage() {}
 
-func (x *VerifyWalletPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[51]
+func (x *ReversePair_Fees) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3656,44 +5799,51 @@ func (x *VerifyWalletPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyWalletPasswordResponse.ProtoReflect.Descriptor instead.
-func (*VerifyWalletPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ReversePair_Fees.ProtoReflect.Descriptor instead.
+func (*ReversePair_Fees) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{13, 0}
 }
 
-func (x *VerifyWalletPasswordResponse) GetCorrect() bool {
+func (x *ReversePair_Fees) GetPercentage() float32 {
 	if x != nil {
-		return x.Correct
+		return x.Percentage
 	}
-	return false
+	return 0
 }
 
-type ChangeWalletPasswordRequest struct {
+func (x *ReversePair_Fees) GetMinerFees() *ReversePair_Fees_MinerFees {
+	if x != nil {
+		return x.MinerFees
+	}
+	return nil
+}
+
+type ReversePair_Fees_MinerFees struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Old string `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
-	New string `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
+	Lockup uint64 `protobuf:"varint,1,opt,name=lockup,proto3" json:"lockup,omitempty"`
+	Claim  uint64 `protobuf:"varint,2,opt,name=claim,proto3" json:"claim,omitempty"`
 }
 
-func (x *ChangeWalletPasswordRequest) Reset() {
-	*x = ChangeWalletPasswordRequest{}
+func (x *ReversePair_Fees_MinerFees) Reset() {
+	*x = ReversePair_Fees_MinerFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[52]
+		mi := &file_boltzrpc_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeWalletPasswordRequest) String() string {
+func (x *ReversePair_Fees_MinerFees) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeWalletPasswordRequest) ProtoMessage() {}
+func (*ReversePair_Fees_MinerFees) ProtoMessage() {}
 
-func (x *ChangeWalletPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[52]
+func (x *ReversePair_Fees_MinerFees) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3704,51 +5854,51 @@ func (x *ChangeWalletPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeWalletPasswordRequest.ProtoReflect.Descriptor instead.
-func (*ChangeWalletPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use ReversePair_Fees_MinerFees.ProtoReflect.Descriptor instead.
+func (*ReversePair_Fees_MinerFees) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{13, 0, 0}
 }
 
-func (x *ChangeWalletPasswordRequest) GetOld() string {
+func (x *ReversePair_Fees_MinerFees) GetLockup() uint64 {
 	if x != nil {
-		return x.Old
+		return x.Lockup
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangeWalletPasswordRequest) GetNew() string {
+func (x *ReversePair_Fees_MinerFees) GetClaim() uint64 {
 	if x != nil {
-		return x.New
+		return x.Claim
 	}
-	return ""
+	return 0
 }
 
-type SubmarinePair_Fees struct {
+type ChainPair_Fees struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Percentage float32 `protobuf:"fixed32,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	MinerFees  uint64  `protobuf:"varint,2,opt,name=miner_fees,json=minerFees,proto3" json:"miner_fees,omitempty"`
+	Percentage float32                   `protobuf:"fixed32,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	MinerFees  *ChainPair_Fees_MinerFees `protobuf:"bytes,2,opt,name=miner_fees,json=minerFees,proto3" json:"miner_fees,omitempty"`
 }
 
-func (x *SubmarinePair_Fees) Reset() {
-	*x = SubmarinePair_Fees{}
+func (x *ChainPair_Fees) Reset() {
+	*x = ChainPair_Fees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[53]
+		mi := &file_boltzrpc_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubmarinePair_Fees) String() string {
+func (x *ChainPair_Fees) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmarinePair_Fees) ProtoMessage() {}
+func (*ChainPair_Fees) ProtoMessage() {}
 
-func (x *SubmarinePair_Fees) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[53]
+func (x *ChainPair_Fees) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3759,51 +5909,51 @@ func (x *SubmarinePair_Fees) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmarinePair_Fees.ProtoReflect.Descriptor instead.
-func (*SubmarinePair_Fees) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use ChainPair_Fees.ProtoReflect.Descriptor instead.
+func (*ChainPair_Fees) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{14, 0}
 }
 
-func (x *SubmarinePair_Fees) GetPercentage() float32 {
+func (x *ChainPair_Fees) GetPercentage() float32 {
 	if x != nil {
 		return x.Percentage
 	}
 	return 0
 }
 
-func (x *SubmarinePair_Fees) GetMinerFees() uint64 {
+func (x *ChainPair_Fees) GetMinerFees() *ChainPair_Fees_MinerFees {
 	if x != nil {
 		return x.MinerFees
 	}
-	return 0
+	return nil
 }
 
-type ReversePair_Fees struct {
+type ChainPair_Fees_MinerFees struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Percentage float32                     `protobuf:"fixed32,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	MinerFees  *ReversePair_Fees_MinerFees `protobuf:"bytes,2,opt,name=miner_fees,json=minerFees,proto3" json:"miner_fees,omitempty"`
+	Server uint64                             `protobuf:"varint,1,opt,name=server,proto3" json:"server,omitempty"`
+	User   *ChainPair_Fees_MinerFees_UserFees `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *ReversePair_Fees) Reset() {
-	*x = ReversePair_Fees{}
+func (x *ChainPair_Fees_MinerFees) Reset() {
+	*x = ChainPair_Fees_MinerFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[54]
+		mi := &file_boltzrpc_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReversePair_Fees) String() string {
+func (x *ChainPair_Fees_MinerFees) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReversePair_Fees) ProtoMessage() {}
+func (*ChainPair_Fees_MinerFees) ProtoMessage() {}
 
-func (x *ReversePair_Fees) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[54]
+func (x *ChainPair_Fees_MinerFees) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3814,51 +5964,51 @@ func (x *ReversePair_Fees) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReversePair_Fees.ProtoReflect.Descriptor instead.
-func (*ReversePair_Fees) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{10, 0}
+// Deprecated: Use ChainPair_Fees_MinerFees.ProtoReflect.Descriptor instead.
+func (*ChainPair_Fees_MinerFees) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{14, 0, 0}
 }
 
-func (x *ReversePair_Fees) GetPercentage() float32 {
+func (x *ChainPair_Fees_MinerFees) GetServer() uint64 {
 	if x != nil {
-		return x.Percentage
+		return x.Server
 	}
 	return 0
 }
 
-func (x *ReversePair_Fees) GetMinerFees() *ReversePair_Fees_MinerFees {
+func (x *ChainPair_Fees_MinerFees) GetUser() *ChainPair_Fees_MinerFees_UserFees {
 	if x != nil {
-		return x.MinerFees
+		return x.User
 	}
 	return nil
 }
 
-type ReversePair_Fees_MinerFees struct {
+type ChainPair_Fees_MinerFees_UserFees struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Lockup uint64 `protobuf:"varint,1,opt,name=lockup,proto3" json:"lockup,omitempty"`
-	Claim  uint64 `protobuf:"varint,2,opt,name=claim,proto3" json:"claim,omitempty"`
+	Claim  uint64 `protobuf:"varint,1,opt,name=claim,proto3" json:"claim,omitempty"`
+	Lockup uint64 `protobuf:"varint,2,opt,name=lockup,proto3" json:"lockup,omitempty"`
 }
 
-func (x *ReversePair_Fees_MinerFees) Reset() {
-	*x = ReversePair_Fees_MinerFees{}
+func (x *ChainPair_Fees_MinerFees_UserFees) Reset() {
+	*x = ChainPair_Fees_MinerFees_UserFees{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_boltzrpc_proto_msgTypes[55]
+		mi := &file_boltzrpc_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReversePair_Fees_MinerFees) String() string {
+func (x *ChainPair_Fees_MinerFees_UserFees) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReversePair_Fees_MinerFees) ProtoMessage() {}
+func (*ChainPair_Fees_MinerFees_UserFees) ProtoMessage() {}
 
-func (x *ReversePair_Fees_MinerFees) ProtoReflect() protoreflect.Message {
-	mi := &file_boltzrpc_proto_msgTypes[55]
+func (x *ChainPair_Fees_MinerFees_UserFees) ProtoReflect() protoreflect.Message {
+	mi := &file_boltzrpc_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3869,21 +6019,21 @@ func (x *ReversePair_Fees_MinerFees) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReversePair_Fees_MinerFees.ProtoReflect.Descriptor instead.
-func (*ReversePair_Fees_MinerFees) Descriptor() ([]byte, []int) {
-	return file_boltzrpc_proto_rawDescGZIP(), []int{10, 0, 0}
+// Deprecated: Use ChainPair_Fees_MinerFees_UserFees.ProtoReflect.Descriptor instead.
+func (*ChainPair_Fees_MinerFees_UserFees) Descriptor() ([]byte, []int) {
+	return file_boltzrpc_proto_rawDescGZIP(), []int{14, 0, 0, 0}
 }
 
-func (x *ReversePair_Fees_MinerFees) GetLockup() uint64 {
+func (x *ChainPair_Fees_MinerFees_UserFees) GetClaim() uint64 {
 	if x != nil {
-		return x.Lockup
+		return x.Claim
 	}
 	return 0
 }
 
-func (x *ReversePair_Fees_MinerFees) GetClaim() uint64 {
+func (x *ChainPair_Fees_MinerFees_UserFees) GetLockup() uint64 {
 	if x != nil {
-		return x.Claim
+		return x.Lockup
 	}
 	return 0
 }
@@ -3899,379 +6049,750 @@ var file_boltzrpc_proto_rawDesc = []byte{
 	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
 	0x79, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xd8, 0x06, 0x0a, 0x08,
-	0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72,
-	0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x29, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65,
-	0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75,
-	0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65,
-	0x63, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x66, 0x75, 0x6e,
-	0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x72,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
-	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x52, 0x07,
-	0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x0b, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24,
-	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x66, 0x65, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0a, 0x6f, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x77, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x66, 0x75,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xe7, 0x01, 0x0a, 0x09,
+	0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x24, 0x0a, 0x0b, 0x63, 0x6f, 0x6e,
+	0x66, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x27, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72,
+	0x56, 0x62, 0x79, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x06, 0x6d, 0x61, 0x78,
+	0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48,
+	0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x76, 0x62, 0x79, 0x74, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65,
+	0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0xc4, 0x09, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72,
+	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a,
+	0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64,
+	0x73, 0x12, 0x26, 0x0a, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x62, 0x6c, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52,
+	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24,
+	0x0a, 0x0b, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0a, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x32, 0x0a, 0x12, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x63, 0x6f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52,
+	0x11, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x63, 0x6f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x06, 0x52, 0x10, 0x63, 0x6f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x48, 0x07, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x65, 0x74, 0x61, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x08, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x45, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x1b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0a, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x66, 0x75,
 	0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62,
 	0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
 	0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x22, 0x81, 0x02, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17,
-	0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69,
-	0x64, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f,
-	0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x5f, 0x63, 0x6f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x63, 0x6f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x65,
+	0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x81, 0x02, 0x0a,
+	0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x77, 0x61, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
+	0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16,
 	0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16,
-	0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x74, 0x3a, 0x02, 0x18, 0x01, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x43,
-	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x77,
-	0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x48,
-	0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
-	0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x02, 0x18, 0x01, 0x22, 0xd9, 0x06, 0x0a,
-	0x0f, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
-	0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72,
-	0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
-	0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69,
-	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e,
-	0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c,
-	0x61, 0x69, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6e,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0d, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6c, 0x61, 0x69, 0x6d,
-	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69,
-	0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
-	0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x2e, 0x0a,
-	0x08, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x64, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x26, 0x0a,
-	0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b,
-	0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x66, 0x65, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0a, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6f, 0x6e,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x48,
-	0x02, 0x52, 0x0a, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x2d, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f,
-	0x6d, 0x73, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x0e, 0x72, 0x6f,
-	0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x18,
-	0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50,
-	0x61, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
-	0x6b, 0x65, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x66, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x66, 0x65, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f,
-	0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x22, 0x48, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x74, 0x63, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x62, 0x74, 0x63, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x69, 0x71, 0x75,
-	0x69, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0xa8, 0x03, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6b, 0x65,
-	0x79, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74,
-	0x6f, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x73, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x21, 0x0a, 0x0a, 0x6c, 0x6e, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6b,
-	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x6c, 0x6e,
-	0x64, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18,
-	0x01, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27,
-	0x0a, 0x0d, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x36, 0x0a, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x22,
-	0x75, 0x0a, 0x06, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e,
-	0x69, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69,
-	0x6d, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x37, 0x0a,
-	0x18, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x15, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xfe, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x6d, 0x61,
-	0x72, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04,
-	0x72, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x30,
-	0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x61, 0x72, 0x69, 0x6e,
-	0x65, 0x50, 0x61, 0x69, 0x72, 0x2e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73,
-	0x1a, 0x45, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65,
-	0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x69,
-	0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x22, 0xdc, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
-	0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72,
-	0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x66, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x75, 0x74, 0x3a, 0x02, 0x18, 0x01,
+	0x22, 0x8f, 0x01, 0x0a, 0x17, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x04,
+	0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x73, 0x77, 0x61, 0x70, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x02,
+	0x18, 0x01, 0x22, 0xb2, 0x08, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77,
+	0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x53, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a,
+	0x14, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70,
+	0x61, 0x69, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x49, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x0c, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x6c, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x01, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0b, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x0a, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x03, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x73,
+	0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x70, 0x61, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x12, 0x37, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x48, 0x04, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x65, 0x74, 0x61,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x45, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69,
+	0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x6c, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x72, 0x6f,
+	0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x65, 0x74, 0x61, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0xf9, 0x04, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x29, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x12, 0x24, 0x0a, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x2e, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x0b, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x0a, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x07,
+	0x74, 0x6f, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77,
+	0x61, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x74, 0x6f, 0x44, 0x61, 0x74, 0x61, 0x12, 0x28,
+	0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6f, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x22, 0xdf, 0x04, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x68, 0x65, 0x69, 0x72, 0x5f,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x68, 0x65, 0x69, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x15, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x13, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x62,
+	0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x63,
+	0x6b, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x65, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x05, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x45, 0x74, 0x61, 0x88, 0x01, 0x01,
+	0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x5f, 0x65, 0x74, 0x61, 0x22, 0x48, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x74, 0x63, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x62, 0x74, 0x63, 0x12, 0x1b, 0x0a, 0x06, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x22,
+	0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xa8, 0x03, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x28,
+	0x0a, 0x10, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x77,
+	0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x21, 0x0a, 0x0a, 0x6c, 0x6e, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x6c, 0x6e, 0x64, 0x50, 0x75,
+	0x62, 0x6b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x0a, 0x0d, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x77, 0x61, 0x70, 0x73, 0x12, 0x36, 0x0a, 0x15, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x22, 0x75, 0x0a, 0x06,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x61, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x18, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x61, 0x6c, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0xfe, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x6d, 0x61, 0x72, 0x69, 0x6e,
+	0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x28, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x04, 0x66,
+	0x65, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x50, 0x61,
+	0x69, 0x72, 0x2e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x1a, 0x45, 0x0a,
+	0x04, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66,
+	0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x46, 0x65, 0x65, 0x73, 0x22, 0xdc, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
+	0x50, 0x61, 0x69, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x28, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x65,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x2e,
+	0x46, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x1a, 0xa6, 0x01, 0x0a, 0x04, 0x46,
+	0x65, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x2e, 0x46,
+	0x65, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52, 0x09, 0x6d,
+	0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x22, 0xbc, 0x03, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x69,
+	0x72, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52,
+	0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a,
+	0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52,
+	0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x2e, 0x46, 0x65, 0x65, 0x73, 0x52,
+	0x04, 0x66, 0x65, 0x65, 0x73, 0x1a, 0x8a, 0x02, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x41,
+	0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x2e, 0x46, 0x65, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65,
+	0x73, 0x1a, 0x9e, 0x01, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x2e, 0x46, 0x65, 0x65, 0x73, 0x2e,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x65,
+	0x65, 0x73, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x38, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
+	0x46, 0x65, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x6b, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b,
+	0x75, 0x70, 0x22, 0xa5, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x61,
+	0x72, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x50,
+	0x61, 0x69, 0x72, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x12, 0x2f,
+	0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12,
+	0x29, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50,
+	0x61, 0x69, 0x72, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x65,
+	0x6e, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x00, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a,
+	0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xc7, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
+	0x0e, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0d, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65,
+	0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72,
+	0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x46, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x69, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x69, 0x72, 0x48, 0x61, 0x73, 0x68, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x09, 0x4d, 0x69,
+	0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x04, 0x46, 0x65, 0x65,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x46, 0x65, 0x65, 0x73, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x17, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x22, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66,
+	0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2e, 0x0a,
-	0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61,
-	0x69, 0x72, 0x2e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x1a, 0xa6, 0x01,
-	0x0a, 0x04, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x66, 0x65, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x69,
-	0x72, 0x2e, 0x46, 0x65, 0x65, 0x73, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73,
-	0x52, 0x09, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x09, 0x4d,
-	0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b,
-	0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x22, 0x7a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x73, 0x75,
-	0x62, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x61, 0x72, 0x69,
-	0x6e, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6d, 0x61, 0x72, 0x69, 0x6e,
-	0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
-	0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x22, 0x3d, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x12,
-	0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72,
-	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x22, 0x51, 0x0a, 0x04, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72,
-	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x70,
-	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x69, 0x6e,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
-	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x46, 0x65, 0x65, 0x73, 0x52, 0x05, 0x6d,
-	0x69, 0x6e, 0x65, 0x72, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a,
-	0x16, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x46, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x06, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77,
-	0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
-	0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x00, 0x52, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x88, 0x01, 0x01,
-	0x12, 0x1c, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x02, 0x52, 0x06, 0x69, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x2e,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x48, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07,
-	0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x22, 0xcd, 0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61,
-	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x77,
-	0x61, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
-	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x73,
-	0x77, 0x61, 0x70, 0x73, 0x12, 0x4e, 0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69,
-	0x6e, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f,
-	0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77,
-	0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53,
-	0x77, 0x61, 0x70, 0x73, 0x22, 0x3d, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x77,
-	0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73,
-	0x77, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
-	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61,
-	0x70, 0x22, 0x3d, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c,
-	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
-	0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x22, 0x6d, 0x0a, 0x0f, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a,
-	0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
-	0x8f, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a,
-	0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69,
-	0x72, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73,
-	0x65, 0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12,
-	0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e,
-	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x07, 0x69, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x66, 0x75,
-	0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x22, 0xe9, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62,
-	0x69, 0x70, 0x32, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x69, 0x70, 0x32,
-	0x31, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x0c, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x75, 0x0a,
-	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a,
-	0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69,
-	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
-	0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x22, 0xe1, 0x02, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x9e, 0x04,
+	0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12,
+	0x27, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x48,
+	0x01, 0x52, 0x02, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61,
+	0x75, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x06, 0x69, 0x73, 0x41,
+	0x75, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x48, 0x03, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x19, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x48, 0x05, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x48, 0x07, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x08, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12,
+	0x29, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0a, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x69, 0x73, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x75, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0xbd,
+	0x02, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x4e,
+	0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x10, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e,
+	0x0a, 0x0d, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x38,
+	0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x8e,
+	0x01, 0x0a, 0x12, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x19, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x01, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x22,
+	0x2f, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x22, 0x85, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x37, 0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x00, 0x52, 0x09, 0x66, 0x65, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x66, 0x65,
+	0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x68, 0x0a, 0x16, 0x42, 0x75, 0x6d, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79,
+	0x74, 0x65, 0x22, 0x2e, 0x0a, 0x17, 0x42, 0x75, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x13, 0x0a,
+	0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
+	0x49, 0x64, 0x22, 0x24, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x88, 0x03, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x26, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x48, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70,
+	0x12, 0x36, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x12, 0x46, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x0e, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01,
+	0x12, 0x2d, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x22, 0x83, 0x01, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77,
+	0x61, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01,
+	0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0xf2, 0x03, 0x0a, 0x09, 0x53, 0x77,
+	0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x48, 0x00, 0x52, 0x08, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x1b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x48, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x05, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x06, 0x52, 0x03, 0x66, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x73,
+	0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x07, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x22, 0x5d,
+	0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x5f, 0x6c, 0x65, 0x66, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x4c, 0x65, 0x66,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x65, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3d, 0x0a,
+	0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x22, 0x6d, 0x0a, 0x0f,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xb8, 0x04, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x7a, 0x65,
-	0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x12, 0x22, 0x0a,
-	0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69,
-	0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x06,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06,
-	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x12, 0x72, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x11, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49,
-	0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a,
-	0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50,
-	0x61, 0x79, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x42, 0x15, 0x0a, 0x13, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6d, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x65, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x22, 0x9f, 0x02, 0x0a, 0x19, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a,
-	0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x69, 0x6c,
-	0x6c, 0x69, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x12,
-	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x53,
-	0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x12, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
-	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
-	0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f,
-	0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c,
-	0x69, 0x5f, 0x73, 0x61, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x2f, 0x0a, 0x09, 0x43, 0x68,
+	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x37,
+	0x0a, 0x0a, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48, 0x03, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x70, 0x61, 0x69, 0x72, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x08, 0x70, 0x61,
+	0x69, 0x72, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
+	0x5f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04,
+	0x48, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x46, 0x65, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x06, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
+	0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x07, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x5f, 0x66, 0x65, 0x65, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0xe9, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x69, 0x70, 0x32, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x62, 0x69, 0x70, 0x32, 0x31, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x22, 0x75, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x69,
+	0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0xae, 0x05, 0x0a, 0x18, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x43, 0x6f,
+	0x6e, 0x66, 0x12, 0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72,
+	0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64,
+	0x73, 0x12, 0x1b, 0x0a, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x32,
+	0x0a, 0x12, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x74, 0x65, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x11, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x49, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x88,
+	0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70,
+	0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x50, 0x61, 0x79, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0a, 0x66, 0x65,
+	0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x48, 0x03, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04, 0x52, 0x0d, 0x6f,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x20, 0x0a, 0x09, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x05, 0x52, 0x08, 0x70, 0x61, 0x69, 0x72, 0x48, 0x61, 0x73, 0x68, 0x88, 0x01,
+	0x01, 0x12, 0x27, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x48, 0x06, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x42,
+	0x6f, 0x6c, 0x74, 0x7a, 0x46, 0x65, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x5f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x6c, 0x79, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x79, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x6f, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x5f, 0x66, 0x65, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x9f, 0x02, 0x0a, 0x19, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x75, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x36, 0x0a, 0x15, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d,
+	0x69, 0x6c, 0x6c, 0x69, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00,
+	0x52, 0x12, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x69, 0x6c, 0x6c,
+	0x69, 0x53, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x14, 0x63, 0x6c, 0x61, 0x69, 0x6d,
+	0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x12, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1d,
+	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x02, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a,
+	0x16, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x69,
+	0x6c, 0x6c, 0x69, 0x5f, 0x73, 0x61, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0xcb, 0x03, 0x0a,
+	0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x22, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x52, 0x04, 0x70,
+	0x61, 0x69, 0x72, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74, 0x6f, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x6f, 0x5f,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x08,
+	0x74, 0x6f, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x61,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5a,
+	0x65, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x74, 0x6f, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x09, 0x43, 0x68,
 	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x6c, 0x6e, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x6c, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6e, 0x64,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6c, 0x6e, 0x64, 0x22, 0xa8, 0x01, 0x0a, 0x10,
@@ -4418,135 +6939,206 @@ var file_boltzrpc_proto_rawDesc = []byte{
 	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
 	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6f,
 	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x6c, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x2a,
-	0x62, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07,
-	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x43,
-	0x43, 0x45, 0x53, 0x53, 0x46, 0x55, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44,
-	0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x42, 0x41, 0x4e, 0x44, 0x4f, 0x4e, 0x45,
-	0x44, 0x10, 0x05, 0x2a, 0x1d, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
-	0x07, 0x0a, 0x03, 0x42, 0x54, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x42, 0x54, 0x43,
-	0x10, 0x01, 0x32, 0xba, 0x0e, 0x0a, 0x05, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x12, 0x3e, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x3b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
-	0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x0e, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x1a, 0x17, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x50,
-	0x61, 0x69, 0x72, 0x12, 0x37, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x50, 0x61, 0x69, 0x72, 0x1a, 0x15, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x3e, 0x0a, 0x08,
-	0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x09,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
-	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70,
-	0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x75,
-	0x6e, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b,
-	0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
-	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53,
-	0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e,
-	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x07,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
-	0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02,
-	0x01, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12,
-	0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77,
-	0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1e, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x5c,
-	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53,
-	0x77, 0x61, 0x70, 0x12, 0x22, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
-	0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0c,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x62,
-	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f,
-	0x72, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
-	0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
-	0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x45, 0x0a, 0x0d, 0x53, 0x65, 0x74,
-	0x53, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x62, 0x6f, 0x6c,
-	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x48, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x12, 0x14, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61,
-	0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
-	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x47, 0x65,
-	0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
-	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x57,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
-	0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c,
-	0x6c, 0x65, 0x74, 0x12, 0x5a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x25, 0x2e, 0x62, 0x6f,
-	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
-	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61,
+	0x03, 0x6e, 0x65, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x22,
+	0x67, 0x0a, 0x15, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x88, 0x01,
+	0x01, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x88,
+	0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x2c, 0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x5a, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x2a, 0x62, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a,
+	0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x46, 0x55, 0x4c, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05,
+	0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x45, 0x52, 0x56, 0x45,
+	0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x46,
+	0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x42, 0x41, 0x4e, 0x44,
+	0x4f, 0x4e, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x27, 0x0a, 0x08, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x54, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4c,
+	0x42, 0x54, 0x43, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x52, 0x42, 0x54, 0x43, 0x10, 0x02, 0x2a,
+	0x31, 0x0a, 0x08, 0x53, 0x77, 0x61, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x53,
+	0x55, 0x42, 0x4d, 0x41, 0x52, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45,
+	0x56, 0x45, 0x52, 0x53, 0x45, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x48, 0x41, 0x49, 0x4e,
+	0x10, 0x02, 0x2a, 0x42, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x53, 0x56, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x4a,
+	0x53, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x4e, 0x45, 0x53, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x42,
+	0x45, 0x41, 0x4e, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x45,
+	0x44, 0x47, 0x45, 0x52, 0x10, 0x03, 0x2a, 0x2a, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x2a, 0x7b, 0x0a, 0x0d, 0x53, 0x77, 0x61, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x4f,
+	0x43, 0x4b, 0x55, 0x50, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x46,
+	0x55, 0x4e, 0x44, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x04, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x45, 0x45, 0x5f, 0x42, 0x55, 0x4d, 0x50, 0x10, 0x05, 0x32,
+	0x93, 0x13, 0x0a, 0x05, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x12, 0x3e, 0x0a, 0x07, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03,
+	0x88, 0x02, 0x01, 0x12, 0x3b, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x6d, 0x61, 0x72,
+	0x69, 0x6e, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x1a, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x61, 0x72, 0x69, 0x6e, 0x65, 0x50, 0x61, 0x69, 0x72,
+	0x12, 0x37, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61,
+	0x69, 0x72, 0x12, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x69, 0x72, 0x1a, 0x15, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12, 0x33, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x0e, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x1a, 0x13, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x12, 0x3e,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x69, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x08, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1a,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77,
+	0x61, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x6f, 0x6c,
+	0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x77, 0x61, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x77, 0x61,
+	0x70, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x0f, 0x42, 0x75, 0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75, 0x6d, 0x70,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x75,
+	0x6d, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53,
+	0x77, 0x61, 0x70, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a,
+	0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x43, 0x0a, 0x07, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x18, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x03, 0x88, 0x02, 0x01, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x12, 0x1e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x03, 0x88, 0x02, 0x01, 0x12, 0x5c, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x12, 0x22, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x53, 0x77, 0x61, 0x70, 0x12, 0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72,
+	0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x77, 0x61, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x4a, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x3f, 0x0a, 0x0c,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x45, 0x0a,
+	0x0d, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x48, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x20, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
+	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x5a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x61,
 	0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12,
-	0x4d, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12,
-	0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
-	0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
-	0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
-	0x12, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x6e, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x12, 0x65, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x62, 0x6f, 0x6c, 0x74,
-	0x7a, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x26, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x25, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
-	0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f,
-	0x6c, 0x74, 0x7a, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2f, 0x62, 0x6f, 0x6c, 0x74,
-	0x7a, 0x2d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
-	0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x25, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61,
+	0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x06, 0x55, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x65, 0x0a, 0x14, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e,
+	0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x14,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x25, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x53, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74,
+	0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70,
+	0x63, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0f, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x62, 0x6f,
+	0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x42, 0x6f, 0x6c, 0x74, 0x7a, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x2f, 0x62, 0x6f, 0x6c, 0x74, 0x7a, 0x2d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x62,
+	0x6f, 0x6c, 0x74, 0x7a, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4561,170 +7153,238 @@ func file_boltzrpc_proto_rawDescGZIP() []byte {
 	return file_boltzrpc_proto_rawDescData
 }
 
-var file_boltzrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_boltzrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 56)
+var file_boltzrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_boltzrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
 var file_boltzrpc_proto_goTypes = []interface{}{
-	(SwapState)(0),                       // 0: boltzrpc.SwapState
-	(Currency)(0),                        // 1: boltzrpc.Currency
-	(*Pair)(nil),                         // 2: boltzrpc.Pair
-	(*SwapInfo)(nil),                     // 3: boltzrpc.SwapInfo
-	(*ChannelCreationInfo)(nil),          // 4: boltzrpc.ChannelCreationInfo
-	(*CombinedChannelSwapInfo)(nil),      // 5: boltzrpc.CombinedChannelSwapInfo
-	(*ReverseSwapInfo)(nil),              // 6: boltzrpc.ReverseSwapInfo
-	(*BlockHeights)(nil),                 // 7: boltzrpc.BlockHeights
-	(*GetInfoRequest)(nil),               // 8: boltzrpc.GetInfoRequest
-	(*GetInfoResponse)(nil),              // 9: boltzrpc.GetInfoResponse
-	(*Limits)(nil),                       // 10: boltzrpc.Limits
-	(*SubmarinePair)(nil),                // 11: boltzrpc.SubmarinePair
-	(*ReversePair)(nil),                  // 12: boltzrpc.ReversePair
-	(*GetPairsResponse)(nil),             // 13: boltzrpc.GetPairsResponse
-	(*MinerFees)(nil),                    // 14: boltzrpc.MinerFees
-	(*Fees)(nil),                         // 15: boltzrpc.Fees
-	(*GetServiceInfoRequest)(nil),        // 16: boltzrpc.GetServiceInfoRequest
-	(*GetServiceInfoResponse)(nil),       // 17: boltzrpc.GetServiceInfoResponse
-	(*ListSwapsRequest)(nil),             // 18: boltzrpc.ListSwapsRequest
-	(*ListSwapsResponse)(nil),            // 19: boltzrpc.ListSwapsResponse
-	(*RefundSwapRequest)(nil),            // 20: boltzrpc.RefundSwapRequest
-	(*GetSwapInfoRequest)(nil),           // 21: boltzrpc.GetSwapInfoRequest
-	(*GetSwapInfoResponse)(nil),          // 22: boltzrpc.GetSwapInfoResponse
-	(*DepositRequest)(nil),               // 23: boltzrpc.DepositRequest
-	(*DepositResponse)(nil),              // 24: boltzrpc.DepositResponse
-	(*CreateSwapRequest)(nil),            // 25: boltzrpc.CreateSwapRequest
-	(*CreateSwapResponse)(nil),           // 26: boltzrpc.CreateSwapResponse
-	(*CreateChannelRequest)(nil),         // 27: boltzrpc.CreateChannelRequest
-	(*CreateReverseSwapRequest)(nil),     // 28: boltzrpc.CreateReverseSwapRequest
-	(*CreateReverseSwapResponse)(nil),    // 29: boltzrpc.CreateReverseSwapResponse
-	(*ChannelId)(nil),                    // 30: boltzrpc.ChannelId
-	(*LightningChannel)(nil),             // 31: boltzrpc.LightningChannel
-	(*SwapStats)(nil),                    // 32: boltzrpc.SwapStats
-	(*Budget)(nil),                       // 33: boltzrpc.Budget
-	(*WalletCredentials)(nil),            // 34: boltzrpc.WalletCredentials
-	(*WalletInfo)(nil),                   // 35: boltzrpc.WalletInfo
-	(*ImportWalletRequest)(nil),          // 36: boltzrpc.ImportWalletRequest
-	(*CreateWalletRequest)(nil),          // 37: boltzrpc.CreateWalletRequest
-	(*SetSubaccountRequest)(nil),         // 38: boltzrpc.SetSubaccountRequest
-	(*GetSubaccountsRequest)(nil),        // 39: boltzrpc.GetSubaccountsRequest
-	(*GetSubaccountsResponse)(nil),       // 40: boltzrpc.GetSubaccountsResponse
-	(*ImportWalletResponse)(nil),         // 41: boltzrpc.ImportWalletResponse
-	(*GetWalletsRequest)(nil),            // 42: boltzrpc.GetWalletsRequest
-	(*GetWalletRequest)(nil),             // 43: boltzrpc.GetWalletRequest
-	(*GetWalletCredentialsRequest)(nil),  // 44: boltzrpc.GetWalletCredentialsRequest
-	(*RemoveWalletRequest)(nil),          // 45: boltzrpc.RemoveWalletRequest
-	(*Wallet)(nil),                       // 46: boltzrpc.Wallet
-	(*Wallets)(nil),                      // 47: boltzrpc.Wallets
-	(*Balance)(nil),                      // 48: boltzrpc.Balance
-	(*Subaccount)(nil),                   // 49: boltzrpc.Subaccount
-	(*RemoveWalletResponse)(nil),         // 50: boltzrpc.RemoveWalletResponse
-	(*UnlockRequest)(nil),                // 51: boltzrpc.UnlockRequest
-	(*VerifyWalletPasswordRequest)(nil),  // 52: boltzrpc.VerifyWalletPasswordRequest
-	(*VerifyWalletPasswordResponse)(nil), // 53: boltzrpc.VerifyWalletPasswordResponse
-	(*ChangeWalletPasswordRequest)(nil),  // 54: boltzrpc.ChangeWalletPasswordRequest
-	(*SubmarinePair_Fees)(nil),           // 55: boltzrpc.SubmarinePair.Fees
-	(*ReversePair_Fees)(nil),             // 56: boltzrpc.ReversePair.Fees
-	(*ReversePair_Fees_MinerFees)(nil),   // 57: boltzrpc.ReversePair.Fees.MinerFees
-	(*empty.Empty)(nil),                  // 58: google.protobuf.Empty
+	(SwapState)(0),                            // 0: boltzrpc.SwapState
+	(Currency)(0),                             // 1: boltzrpc.Currency
+	(SwapType)(0),                             // 2: boltzrpc.SwapType
+	(ExportFormat)(0),                         // 3: boltzrpc.ExportFormat
+	(SortOrder)(0),                            // 4: boltzrpc.SortOrder
+	(SwapEventType)(0),                        // 5: boltzrpc.SwapEventType
+	(*Pair)(nil),                              // 6: boltzrpc.Pair
+	(*FeePolicy)(nil),                         // 7: boltzrpc.FeePolicy
+	(*SwapInfo)(nil),                          // 8: boltzrpc.SwapInfo
+	(*ChannelCreationInfo)(nil),               // 9: boltzrpc.ChannelCreationInfo
+	(*CombinedChannelSwapInfo)(nil),           // 10: boltzrpc.CombinedChannelSwapInfo
+	(*ReverseSwapInfo)(nil),                   // 11: boltzrpc.ReverseSwapInfo
+	(*ChainSwapInfo)(nil),                     // 12: boltzrpc.ChainSwapInfo
+	(*ChainSwapData)(nil),                     // 13: boltzrpc.ChainSwapData
+	(*BlockHeights)(nil),                      // 14: boltzrpc.BlockHeights
+	(*GetInfoRequest)(nil),                    // 15: boltzrpc.GetInfoRequest
+	(*GetInfoResponse)(nil),                   // 16: boltzrpc.GetInfoResponse
+	(*Limits)(nil),                            // 17: boltzrpc.Limits
+	(*SubmarinePair)(nil),                     // 18: boltzrpc.SubmarinePair
+	(*ReversePair)(nil),                       // 19: boltzrpc.ReversePair
+	(*ChainPair)(nil),                         // 20: boltzrpc.ChainPair
+	(*GetPairsResponse)(nil),                  // 21: boltzrpc.GetPairsResponse
+	(*GetQuoteRequest)(nil),                   // 22: boltzrpc.GetQuoteRequest
+	(*GetQuoteResponse)(nil),                  // 23: boltzrpc.GetQuoteResponse
+	(*MinerFees)(nil),                         // 24: boltzrpc.MinerFees
+	(*Fees)(nil),                              // 25: boltzrpc.Fees
+	(*GetServiceInfoRequest)(nil),             // 26: boltzrpc.GetServiceInfoRequest
+	(*GetServiceInfoResponse)(nil),            // 27: boltzrpc.GetServiceInfoResponse
+	(*ListSwapsRequest)(nil),                  // 28: boltzrpc.ListSwapsRequest
+	(*ListSwapsResponse)(nil),                 // 29: boltzrpc.ListSwapsResponse
+	(*ExportSwapsRequest)(nil),                // 30: boltzrpc.ExportSwapsRequest
+	(*ExportSwapsResponse)(nil),               // 31: boltzrpc.ExportSwapsResponse
+	(*RefundSwapRequest)(nil),                 // 32: boltzrpc.RefundSwapRequest
+	(*BumpTransactionRequest)(nil),            // 33: boltzrpc.BumpTransactionRequest
+	(*BumpTransactionResponse)(nil),           // 34: boltzrpc.BumpTransactionResponse
+	(*GetSwapInfoRequest)(nil),                // 35: boltzrpc.GetSwapInfoRequest
+	(*GetSwapInfoResponse)(nil),               // 36: boltzrpc.GetSwapInfoResponse
+	(*UpdateSwapLabelRequest)(nil),            // 37: boltzrpc.UpdateSwapLabelRequest
+	(*SwapEvent)(nil),                         // 38: boltzrpc.SwapEvent
+	(*TimeoutWarning)(nil),                    // 39: boltzrpc.TimeoutWarning
+	(*DepositRequest)(nil),                    // 40: boltzrpc.DepositRequest
+	(*DepositResponse)(nil),                   // 41: boltzrpc.DepositResponse
+	(*CreateSwapRequest)(nil),                 // 42: boltzrpc.CreateSwapRequest
+	(*CreateSwapResponse)(nil),                // 43: boltzrpc.CreateSwapResponse
+	(*CreateChannelRequest)(nil),              // 44: boltzrpc.CreateChannelRequest
+	(*CreateReverseSwapRequest)(nil),          // 45: boltzrpc.CreateReverseSwapRequest
+	(*CreateReverseSwapResponse)(nil),         // 46: boltzrpc.CreateReverseSwapResponse
+	(*CreateChainSwapRequest)(nil),            // 47: boltzrpc.CreateChainSwapRequest
+	(*ChannelId)(nil),                         // 48: boltzrpc.ChannelId
+	(*LightningChannel)(nil),                  // 49: boltzrpc.LightningChannel
+	(*SwapStats)(nil),                         // 50: boltzrpc.SwapStats
+	(*Budget)(nil),                            // 51: boltzrpc.Budget
+	(*WalletCredentials)(nil),                 // 52: boltzrpc.WalletCredentials
+	(*WalletInfo)(nil),                        // 53: boltzrpc.WalletInfo
+	(*ImportWalletRequest)(nil),               // 54: boltzrpc.ImportWalletRequest
+	(*CreateWalletRequest)(nil),               // 55: boltzrpc.CreateWalletRequest
+	(*SetSubaccountRequest)(nil),              // 56: boltzrpc.SetSubaccountRequest
+	(*GetSubaccountsRequest)(nil),             // 57: boltzrpc.GetSubaccountsRequest
+	(*GetSubaccountsResponse)(nil),            // 58: boltzrpc.GetSubaccountsResponse
+	(*ImportWalletResponse)(nil),              // 59: boltzrpc.ImportWalletResponse
+	(*GetWalletsRequest)(nil),                 // 60: boltzrpc.GetWalletsRequest
+	(*GetWalletRequest)(nil),                  // 61: boltzrpc.GetWalletRequest
+	(*GetWalletCredentialsRequest)(nil),       // 62: boltzrpc.GetWalletCredentialsRequest
+	(*RemoveWalletRequest)(nil),               // 63: boltzrpc.RemoveWalletRequest
+	(*Wallet)(nil),                            // 64: boltzrpc.Wallet
+	(*Wallets)(nil),                           // 65: boltzrpc.Wallets
+	(*Balance)(nil),                           // 66: boltzrpc.Balance
+	(*Subaccount)(nil),                        // 67: boltzrpc.Subaccount
+	(*RemoveWalletResponse)(nil),              // 68: boltzrpc.RemoveWalletResponse
+	(*UnlockRequest)(nil),                     // 69: boltzrpc.UnlockRequest
+	(*VerifyWalletPasswordRequest)(nil),       // 70: boltzrpc.VerifyWalletPasswordRequest
+	(*VerifyWalletPasswordResponse)(nil),      // 71: boltzrpc.VerifyWalletPasswordResponse
+	(*ChangeWalletPasswordRequest)(nil),       // 72: boltzrpc.ChangeWalletPasswordRequest
+	(*BackupDatabaseRequest)(nil),             // 73: boltzrpc.BackupDatabaseRequest
+	(*BackupDatabaseResponse)(nil),            // 74: boltzrpc.BackupDatabaseResponse
+	(*RestoreDatabaseRequest)(nil),            // 75: boltzrpc.RestoreDatabaseRequest
+	(*SubmarinePair_Fees)(nil),                // 76: boltzrpc.SubmarinePair.Fees
+	(*ReversePair_Fees)(nil),                  // 77: boltzrpc.ReversePair.Fees
+	(*ReversePair_Fees_MinerFees)(nil),        // 78: boltzrpc.ReversePair.Fees.MinerFees
+	(*ChainPair_Fees)(nil),                    // 79: boltzrpc.ChainPair.Fees
+	(*ChainPair_Fees_MinerFees)(nil),          // 80: boltzrpc.ChainPair.Fees.MinerFees
+	(*ChainPair_Fees_MinerFees_UserFees)(nil), // 81: boltzrpc.ChainPair.Fees.MinerFees.UserFees
+	(*empty.Empty)(nil),                       // 82: google.protobuf.Empty
 }
 var file_boltzrpc_proto_depIdxs = []int32{
-	1,  // 0: boltzrpc.Pair.from:type_name -> boltzrpc.Currency
-	1,  // 1: boltzrpc.Pair.to:type_name -> boltzrpc.Currency
-	2,  // 2: boltzrpc.SwapInfo.pair:type_name -> boltzrpc.Pair
-	0,  // 3: boltzrpc.SwapInfo.state:type_name -> boltzrpc.SwapState
-	30, // 4: boltzrpc.SwapInfo.chan_ids:type_name -> boltzrpc.ChannelId
-	3,  // 5: boltzrpc.CombinedChannelSwapInfo.swap:type_name -> boltzrpc.SwapInfo
-	4,  // 6: boltzrpc.CombinedChannelSwapInfo.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
-	0,  // 7: boltzrpc.ReverseSwapInfo.state:type_name -> boltzrpc.SwapState
-	2,  // 8: boltzrpc.ReverseSwapInfo.pair:type_name -> boltzrpc.Pair
-	30, // 9: boltzrpc.ReverseSwapInfo.chan_ids:type_name -> boltzrpc.ChannelId
-	7,  // 10: boltzrpc.GetInfoResponse.block_heights:type_name -> boltzrpc.BlockHeights
-	2,  // 11: boltzrpc.SubmarinePair.pair:type_name -> boltzrpc.Pair
-	10, // 12: boltzrpc.SubmarinePair.limits:type_name -> boltzrpc.Limits
-	55, // 13: boltzrpc.SubmarinePair.fees:type_name -> boltzrpc.SubmarinePair.Fees
-	2,  // 14: boltzrpc.ReversePair.pair:type_name -> boltzrpc.Pair
-	10, // 15: boltzrpc.ReversePair.limits:type_name -> boltzrpc.Limits
-	56, // 16: boltzrpc.ReversePair.fees:type_name -> boltzrpc.ReversePair.Fees
-	11, // 17: boltzrpc.GetPairsResponse.submarine:type_name -> boltzrpc.SubmarinePair
-	12, // 18: boltzrpc.GetPairsResponse.reverse:type_name -> boltzrpc.ReversePair
-	14, // 19: boltzrpc.Fees.miner:type_name -> boltzrpc.MinerFees
-	15, // 20: boltzrpc.GetServiceInfoResponse.fees:type_name -> boltzrpc.Fees
-	10, // 21: boltzrpc.GetServiceInfoResponse.limits:type_name -> boltzrpc.Limits
-	1,  // 22: boltzrpc.ListSwapsRequest.from:type_name -> boltzrpc.Currency
-	1,  // 23: boltzrpc.ListSwapsRequest.to:type_name -> boltzrpc.Currency
-	0,  // 24: boltzrpc.ListSwapsRequest.state:type_name -> boltzrpc.SwapState
-	3,  // 25: boltzrpc.ListSwapsResponse.swaps:type_name -> boltzrpc.SwapInfo
-	5,  // 26: boltzrpc.ListSwapsResponse.channel_creations:type_name -> boltzrpc.CombinedChannelSwapInfo
-	6,  // 27: boltzrpc.ListSwapsResponse.reverse_swaps:type_name -> boltzrpc.ReverseSwapInfo
-	3,  // 28: boltzrpc.GetSwapInfoResponse.swap:type_name -> boltzrpc.SwapInfo
-	4,  // 29: boltzrpc.GetSwapInfoResponse.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
-	6,  // 30: boltzrpc.GetSwapInfoResponse.reverse_swap:type_name -> boltzrpc.ReverseSwapInfo
-	2,  // 31: boltzrpc.CreateSwapRequest.pair:type_name -> boltzrpc.Pair
-	2,  // 32: boltzrpc.CreateReverseSwapRequest.pair:type_name -> boltzrpc.Pair
-	30, // 33: boltzrpc.LightningChannel.id:type_name -> boltzrpc.ChannelId
-	1,  // 34: boltzrpc.WalletInfo.currency:type_name -> boltzrpc.Currency
-	34, // 35: boltzrpc.ImportWalletRequest.credentials:type_name -> boltzrpc.WalletCredentials
-	35, // 36: boltzrpc.ImportWalletRequest.info:type_name -> boltzrpc.WalletInfo
-	35, // 37: boltzrpc.CreateWalletRequest.info:type_name -> boltzrpc.WalletInfo
-	49, // 38: boltzrpc.GetSubaccountsResponse.subaccounts:type_name -> boltzrpc.Subaccount
-	1,  // 39: boltzrpc.GetWalletsRequest.currency:type_name -> boltzrpc.Currency
-	1,  // 40: boltzrpc.Wallet.currency:type_name -> boltzrpc.Currency
-	48, // 41: boltzrpc.Wallet.balance:type_name -> boltzrpc.Balance
-	46, // 42: boltzrpc.Wallets.wallets:type_name -> boltzrpc.Wallet
-	48, // 43: boltzrpc.Subaccount.balance:type_name -> boltzrpc.Balance
-	57, // 44: boltzrpc.ReversePair.Fees.miner_fees:type_name -> boltzrpc.ReversePair.Fees.MinerFees
-	8,  // 45: boltzrpc.Boltz.GetInfo:input_type -> boltzrpc.GetInfoRequest
-	16, // 46: boltzrpc.Boltz.GetServiceInfo:input_type -> boltzrpc.GetServiceInfoRequest
-	2,  // 47: boltzrpc.Boltz.GetSubmarinePair:input_type -> boltzrpc.Pair
-	2,  // 48: boltzrpc.Boltz.GetReversePair:input_type -> boltzrpc.Pair
-	58, // 49: boltzrpc.Boltz.GetPairs:input_type -> google.protobuf.Empty
-	18, // 50: boltzrpc.Boltz.ListSwaps:input_type -> boltzrpc.ListSwapsRequest
-	20, // 51: boltzrpc.Boltz.RefundSwap:input_type -> boltzrpc.RefundSwapRequest
-	21, // 52: boltzrpc.Boltz.GetSwapInfo:input_type -> boltzrpc.GetSwapInfoRequest
-	21, // 53: boltzrpc.Boltz.GetSwapInfoStream:input_type -> boltzrpc.GetSwapInfoRequest
-	23, // 54: boltzrpc.Boltz.Deposit:input_type -> boltzrpc.DepositRequest
-	25, // 55: boltzrpc.Boltz.CreateSwap:input_type -> boltzrpc.CreateSwapRequest
-	27, // 56: boltzrpc.Boltz.CreateChannel:input_type -> boltzrpc.CreateChannelRequest
-	28, // 57: boltzrpc.Boltz.CreateReverseSwap:input_type -> boltzrpc.CreateReverseSwapRequest
-	37, // 58: boltzrpc.Boltz.CreateWallet:input_type -> boltzrpc.CreateWalletRequest
-	36, // 59: boltzrpc.Boltz.ImportWallet:input_type -> boltzrpc.ImportWalletRequest
-	38, // 60: boltzrpc.Boltz.SetSubaccount:input_type -> boltzrpc.SetSubaccountRequest
-	35, // 61: boltzrpc.Boltz.GetSubaccounts:input_type -> boltzrpc.WalletInfo
-	42, // 62: boltzrpc.Boltz.GetWallets:input_type -> boltzrpc.GetWalletsRequest
-	43, // 63: boltzrpc.Boltz.GetWallet:input_type -> boltzrpc.GetWalletRequest
-	44, // 64: boltzrpc.Boltz.GetWalletCredentials:input_type -> boltzrpc.GetWalletCredentialsRequest
-	45, // 65: boltzrpc.Boltz.RemoveWallet:input_type -> boltzrpc.RemoveWalletRequest
-	58, // 66: boltzrpc.Boltz.Stop:input_type -> google.protobuf.Empty
-	51, // 67: boltzrpc.Boltz.Unlock:input_type -> boltzrpc.UnlockRequest
-	52, // 68: boltzrpc.Boltz.VerifyWalletPassword:input_type -> boltzrpc.VerifyWalletPasswordRequest
-	54, // 69: boltzrpc.Boltz.ChangeWalletPassword:input_type -> boltzrpc.ChangeWalletPasswordRequest
-	9,  // 70: boltzrpc.Boltz.GetInfo:output_type -> boltzrpc.GetInfoResponse
-	17, // 71: boltzrpc.Boltz.GetServiceInfo:output_type -> boltzrpc.GetServiceInfoResponse
-	11, // 72: boltzrpc.Boltz.GetSubmarinePair:output_type -> boltzrpc.SubmarinePair
-	12, // 73: boltzrpc.Boltz.GetReversePair:output_type -> boltzrpc.ReversePair
-	13, // 74: boltzrpc.Boltz.GetPairs:output_type -> boltzrpc.GetPairsResponse
-	19, // 75: boltzrpc.Boltz.ListSwaps:output_type -> boltzrpc.ListSwapsResponse
-	22, // 76: boltzrpc.Boltz.RefundSwap:output_type -> boltzrpc.GetSwapInfoResponse
-	22, // 77: boltzrpc.Boltz.GetSwapInfo:output_type -> boltzrpc.GetSwapInfoResponse
-	22, // 78: boltzrpc.Boltz.GetSwapInfoStream:output_type -> boltzrpc.GetSwapInfoResponse
-	24, // 79: boltzrpc.Boltz.Deposit:output_type -> boltzrpc.DepositResponse
-	26, // 80: boltzrpc.Boltz.CreateSwap:output_type -> boltzrpc.CreateSwapResponse
-	26, // 81: boltzrpc.Boltz.CreateChannel:output_type -> boltzrpc.CreateSwapResponse
-	29, // 82: boltzrpc.Boltz.CreateReverseSwap:output_type -> boltzrpc.CreateReverseSwapResponse
-	34, // 83: boltzrpc.Boltz.CreateWallet:output_type -> boltzrpc.WalletCredentials
-	46, // 84: boltzrpc.Boltz.ImportWallet:output_type -> boltzrpc.Wallet
-	49, // 85: boltzrpc.Boltz.SetSubaccount:output_type -> boltzrpc.Subaccount
-	40, // 86: boltzrpc.Boltz.GetSubaccounts:output_type -> boltzrpc.GetSubaccountsResponse
-	47, // 87: boltzrpc.Boltz.GetWallets:output_type -> boltzrpc.Wallets
-	46, // 88: boltzrpc.Boltz.GetWallet:output_type -> boltzrpc.Wallet
-	34, // 89: boltzrpc.Boltz.GetWalletCredentials:output_type -> boltzrpc.WalletCredentials
-	50, // 90: boltzrpc.Boltz.RemoveWallet:output_type -> boltzrpc.RemoveWalletResponse
-	58, // 91: boltzrpc.Boltz.Stop:output_type -> google.protobuf.Empty
-	58, // 92: boltzrpc.Boltz.Unlock:output_type -> google.protobuf.Empty
-	53, // 93: boltzrpc.Boltz.VerifyWalletPassword:output_type -> boltzrpc.VerifyWalletPasswordResponse
-	58, // 94: boltzrpc.Boltz.ChangeWalletPassword:output_type -> google.protobuf.Empty
-	70, // [70:95] is the sub-list for method output_type
-	45, // [45:70] is the sub-list for method input_type
-	45, // [45:45] is the sub-list for extension type_name
-	45, // [45:45] is the sub-list for extension extendee
-	0,  // [0:45] is the sub-list for field type_name
+	1,   // 0: boltzrpc.Pair.from:type_name -> boltzrpc.Currency
+	1,   // 1: boltzrpc.Pair.to:type_name -> boltzrpc.Currency
+	6,   // 2: boltzrpc.SwapInfo.pair:type_name -> boltzrpc.Pair
+	0,   // 3: boltzrpc.SwapInfo.state:type_name -> boltzrpc.SwapState
+	48,  // 4: boltzrpc.SwapInfo.chan_ids:type_name -> boltzrpc.ChannelId
+	7,   // 5: boltzrpc.SwapInfo.fee_policy:type_name -> boltzrpc.FeePolicy
+	8,   // 6: boltzrpc.CombinedChannelSwapInfo.swap:type_name -> boltzrpc.SwapInfo
+	9,   // 7: boltzrpc.CombinedChannelSwapInfo.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
+	0,   // 8: boltzrpc.ReverseSwapInfo.state:type_name -> boltzrpc.SwapState
+	6,   // 9: boltzrpc.ReverseSwapInfo.pair:type_name -> boltzrpc.Pair
+	48,  // 10: boltzrpc.ReverseSwapInfo.chan_ids:type_name -> boltzrpc.ChannelId
+	7,   // 11: boltzrpc.ReverseSwapInfo.fee_policy:type_name -> boltzrpc.FeePolicy
+	6,   // 12: boltzrpc.ChainSwapInfo.pair:type_name -> boltzrpc.Pair
+	0,   // 13: boltzrpc.ChainSwapInfo.state:type_name -> boltzrpc.SwapState
+	13,  // 14: boltzrpc.ChainSwapInfo.from_data:type_name -> boltzrpc.ChainSwapData
+	13,  // 15: boltzrpc.ChainSwapInfo.to_data:type_name -> boltzrpc.ChainSwapData
+	1,   // 16: boltzrpc.ChainSwapData.currency:type_name -> boltzrpc.Currency
+	14,  // 17: boltzrpc.GetInfoResponse.block_heights:type_name -> boltzrpc.BlockHeights
+	6,   // 18: boltzrpc.SubmarinePair.pair:type_name -> boltzrpc.Pair
+	17,  // 19: boltzrpc.SubmarinePair.limits:type_name -> boltzrpc.Limits
+	76,  // 20: boltzrpc.SubmarinePair.fees:type_name -> boltzrpc.SubmarinePair.Fees
+	6,   // 21: boltzrpc.ReversePair.pair:type_name -> boltzrpc.Pair
+	17,  // 22: boltzrpc.ReversePair.limits:type_name -> boltzrpc.Limits
+	77,  // 23: boltzrpc.ReversePair.fees:type_name -> boltzrpc.ReversePair.Fees
+	6,   // 24: boltzrpc.ChainPair.pair:type_name -> boltzrpc.Pair
+	17,  // 25: boltzrpc.ChainPair.limits:type_name -> boltzrpc.Limits
+	79,  // 26: boltzrpc.ChainPair.fees:type_name -> boltzrpc.ChainPair.Fees
+	18,  // 27: boltzrpc.GetPairsResponse.submarine:type_name -> boltzrpc.SubmarinePair
+	19,  // 28: boltzrpc.GetPairsResponse.reverse:type_name -> boltzrpc.ReversePair
+	20,  // 29: boltzrpc.GetPairsResponse.chain:type_name -> boltzrpc.ChainPair
+	2,   // 30: boltzrpc.GetQuoteRequest.type:type_name -> boltzrpc.SwapType
+	6,   // 31: boltzrpc.GetQuoteRequest.pair:type_name -> boltzrpc.Pair
+	24,  // 32: boltzrpc.Fees.miner:type_name -> boltzrpc.MinerFees
+	25,  // 33: boltzrpc.GetServiceInfoResponse.fees:type_name -> boltzrpc.Fees
+	17,  // 34: boltzrpc.GetServiceInfoResponse.limits:type_name -> boltzrpc.Limits
+	1,   // 35: boltzrpc.ListSwapsRequest.from:type_name -> boltzrpc.Currency
+	1,   // 36: boltzrpc.ListSwapsRequest.to:type_name -> boltzrpc.Currency
+	0,   // 37: boltzrpc.ListSwapsRequest.state:type_name -> boltzrpc.SwapState
+	4,   // 38: boltzrpc.ListSwapsRequest.order:type_name -> boltzrpc.SortOrder
+	8,   // 39: boltzrpc.ListSwapsResponse.swaps:type_name -> boltzrpc.SwapInfo
+	10,  // 40: boltzrpc.ListSwapsResponse.channel_creations:type_name -> boltzrpc.CombinedChannelSwapInfo
+	11,  // 41: boltzrpc.ListSwapsResponse.reverse_swaps:type_name -> boltzrpc.ReverseSwapInfo
+	12,  // 42: boltzrpc.ListSwapsResponse.chain_swaps:type_name -> boltzrpc.ChainSwapInfo
+	3,   // 43: boltzrpc.ExportSwapsRequest.format:type_name -> boltzrpc.ExportFormat
+	7,   // 44: boltzrpc.RefundSwapRequest.fee_policy:type_name -> boltzrpc.FeePolicy
+	8,   // 45: boltzrpc.GetSwapInfoResponse.swap:type_name -> boltzrpc.SwapInfo
+	9,   // 46: boltzrpc.GetSwapInfoResponse.channel_creation:type_name -> boltzrpc.ChannelCreationInfo
+	11,  // 47: boltzrpc.GetSwapInfoResponse.reverse_swap:type_name -> boltzrpc.ReverseSwapInfo
+	12,  // 48: boltzrpc.GetSwapInfoResponse.chain_swap:type_name -> boltzrpc.ChainSwapInfo
+	39,  // 49: boltzrpc.GetSwapInfoResponse.timeout_warning:type_name -> boltzrpc.TimeoutWarning
+	38,  // 50: boltzrpc.GetSwapInfoResponse.history:type_name -> boltzrpc.SwapEvent
+	5,   // 51: boltzrpc.SwapEvent.type:type_name -> boltzrpc.SwapEventType
+	1,   // 52: boltzrpc.SwapEvent.currency:type_name -> boltzrpc.Currency
+	0,   // 53: boltzrpc.SwapEvent.state:type_name -> boltzrpc.SwapState
+	6,   // 54: boltzrpc.CreateSwapRequest.pair:type_name -> boltzrpc.Pair
+	7,   // 55: boltzrpc.CreateSwapRequest.fee_policy:type_name -> boltzrpc.FeePolicy
+	6,   // 56: boltzrpc.CreateReverseSwapRequest.pair:type_name -> boltzrpc.Pair
+	7,   // 57: boltzrpc.CreateReverseSwapRequest.fee_policy:type_name -> boltzrpc.FeePolicy
+	6,   // 58: boltzrpc.CreateChainSwapRequest.pair:type_name -> boltzrpc.Pair
+	48,  // 59: boltzrpc.LightningChannel.id:type_name -> boltzrpc.ChannelId
+	1,   // 60: boltzrpc.WalletInfo.currency:type_name -> boltzrpc.Currency
+	52,  // 61: boltzrpc.ImportWalletRequest.credentials:type_name -> boltzrpc.WalletCredentials
+	53,  // 62: boltzrpc.ImportWalletRequest.info:type_name -> boltzrpc.WalletInfo
+	53,  // 63: boltzrpc.CreateWalletRequest.info:type_name -> boltzrpc.WalletInfo
+	67,  // 64: boltzrpc.GetSubaccountsResponse.subaccounts:type_name -> boltzrpc.Subaccount
+	1,   // 65: boltzrpc.GetWalletsRequest.currency:type_name -> boltzrpc.Currency
+	1,   // 66: boltzrpc.Wallet.currency:type_name -> boltzrpc.Currency
+	66,  // 67: boltzrpc.Wallet.balance:type_name -> boltzrpc.Balance
+	64,  // 68: boltzrpc.Wallets.wallets:type_name -> boltzrpc.Wallet
+	66,  // 69: boltzrpc.Subaccount.balance:type_name -> boltzrpc.Balance
+	78,  // 70: boltzrpc.ReversePair.Fees.miner_fees:type_name -> boltzrpc.ReversePair.Fees.MinerFees
+	80,  // 71: boltzrpc.ChainPair.Fees.miner_fees:type_name -> boltzrpc.ChainPair.Fees.MinerFees
+	81,  // 72: boltzrpc.ChainPair.Fees.MinerFees.user:type_name -> boltzrpc.ChainPair.Fees.MinerFees.UserFees
+	15,  // 73: boltzrpc.Boltz.GetInfo:input_type -> boltzrpc.GetInfoRequest
+	26,  // 74: boltzrpc.Boltz.GetServiceInfo:input_type -> boltzrpc.GetServiceInfoRequest
+	6,   // 75: boltzrpc.Boltz.GetSubmarinePair:input_type -> boltzrpc.Pair
+	6,   // 76: boltzrpc.Boltz.GetReversePair:input_type -> boltzrpc.Pair
+	6,   // 77: boltzrpc.Boltz.GetChainPair:input_type -> boltzrpc.Pair
+	82,  // 78: boltzrpc.Boltz.GetPairs:input_type -> google.protobuf.Empty
+	22,  // 79: boltzrpc.Boltz.GetQuote:input_type -> boltzrpc.GetQuoteRequest
+	28,  // 80: boltzrpc.Boltz.ListSwaps:input_type -> boltzrpc.ListSwapsRequest
+	30,  // 81: boltzrpc.Boltz.ExportSwaps:input_type -> boltzrpc.ExportSwapsRequest
+	32,  // 82: boltzrpc.Boltz.RefundSwap:input_type -> boltzrpc.RefundSwapRequest
+	33,  // 83: boltzrpc.Boltz.BumpTransaction:input_type -> boltzrpc.BumpTransactionRequest
+	35,  // 84: boltzrpc.Boltz.GetSwapInfo:input_type -> boltzrpc.GetSwapInfoRequest
+	35,  // 85: boltzrpc.Boltz.GetSwapInfoStream:input_type -> boltzrpc.GetSwapInfoRequest
+	37,  // 86: boltzrpc.Boltz.UpdateSwapLabel:input_type -> boltzrpc.UpdateSwapLabelRequest
+	40,  // 87: boltzrpc.Boltz.Deposit:input_type -> boltzrpc.DepositRequest
+	42,  // 88: boltzrpc.Boltz.CreateSwap:input_type -> boltzrpc.CreateSwapRequest
+	44,  // 89: boltzrpc.Boltz.CreateChannel:input_type -> boltzrpc.CreateChannelRequest
+	45,  // 90: boltzrpc.Boltz.CreateReverseSwap:input_type -> boltzrpc.CreateReverseSwapRequest
+	47,  // 91: boltzrpc.Boltz.CreateChainSwap:input_type -> boltzrpc.CreateChainSwapRequest
+	55,  // 92: boltzrpc.Boltz.CreateWallet:input_type -> boltzrpc.CreateWalletRequest
+	54,  // 93: boltzrpc.Boltz.ImportWallet:input_type -> boltzrpc.ImportWalletRequest
+	56,  // 94: boltzrpc.Boltz.SetSubaccount:input_type -> boltzrpc.SetSubaccountRequest
+	53,  // 95: boltzrpc.Boltz.GetSubaccounts:input_type -> boltzrpc.WalletInfo
+	60,  // 96: boltzrpc.Boltz.GetWallets:input_type -> boltzrpc.GetWalletsRequest
+	61,  // 97: boltzrpc.Boltz.GetWallet:input_type -> boltzrpc.GetWalletRequest
+	62,  // 98: boltzrpc.Boltz.GetWalletCredentials:input_type -> boltzrpc.GetWalletCredentialsRequest
+	63,  // 99: boltzrpc.Boltz.RemoveWallet:input_type -> boltzrpc.RemoveWalletRequest
+	82,  // 100: boltzrpc.Boltz.Stop:input_type -> google.protobuf.Empty
+	69,  // 101: boltzrpc.Boltz.Unlock:input_type -> boltzrpc.UnlockRequest
+	70,  // 102: boltzrpc.Boltz.VerifyWalletPassword:input_type -> boltzrpc.VerifyWalletPasswordRequest
+	72,  // 103: boltzrpc.Boltz.ChangeWalletPassword:input_type -> boltzrpc.ChangeWalletPasswordRequest
+	73,  // 104: boltzrpc.Boltz.BackupDatabase:input_type -> boltzrpc.BackupDatabaseRequest
+	75,  // 105: boltzrpc.Boltz.RestoreDatabase:input_type -> boltzrpc.RestoreDatabaseRequest
+	16,  // 106: boltzrpc.Boltz.GetInfo:output_type -> boltzrpc.GetInfoResponse
+	27,  // 107: boltzrpc.Boltz.GetServiceInfo:output_type -> boltzrpc.GetServiceInfoResponse
+	18,  // 108: boltzrpc.Boltz.GetSubmarinePair:output_type -> boltzrpc.SubmarinePair
+	19,  // 109: boltzrpc.Boltz.GetReversePair:output_type -> boltzrpc.ReversePair
+	20,  // 110: boltzrpc.Boltz.GetChainPair:output_type -> boltzrpc.ChainPair
+	21,  // 111: boltzrpc.Boltz.GetPairs:output_type -> boltzrpc.GetPairsResponse
+	23,  // 112: boltzrpc.Boltz.GetQuote:output_type -> boltzrpc.GetQuoteResponse
+	29,  // 113: boltzrpc.Boltz.ListSwaps:output_type -> boltzrpc.ListSwapsResponse
+	31,  // 114: boltzrpc.Boltz.ExportSwaps:output_type -> boltzrpc.ExportSwapsResponse
+	36,  // 115: boltzrpc.Boltz.RefundSwap:output_type -> boltzrpc.GetSwapInfoResponse
+	34,  // 116: boltzrpc.Boltz.BumpTransaction:output_type -> boltzrpc.BumpTransactionResponse
+	36,  // 117: boltzrpc.Boltz.GetSwapInfo:output_type -> boltzrpc.GetSwapInfoResponse
+	36,  // 118: boltzrpc.Boltz.GetSwapInfoStream:output_type -> boltzrpc.GetSwapInfoResponse
+	82,  // 119: boltzrpc.Boltz.UpdateSwapLabel:output_type -> google.protobuf.Empty
+	41,  // 120: boltzrpc.Boltz.Deposit:output_type -> boltzrpc.DepositResponse
+	43,  // 121: boltzrpc.Boltz.CreateSwap:output_type -> boltzrpc.CreateSwapResponse
+	43,  // 122: boltzrpc.Boltz.CreateChannel:output_type -> boltzrpc.CreateSwapResponse
+	46,  // 123: boltzrpc.Boltz.CreateReverseSwap:output_type -> boltzrpc.CreateReverseSwapResponse
+	12,  // 124: boltzrpc.Boltz.CreateChainSwap:output_type -> boltzrpc.ChainSwapInfo
+	52,  // 125: boltzrpc.Boltz.CreateWallet:output_type -> boltzrpc.WalletCredentials
+	64,  // 126: boltzrpc.Boltz.ImportWallet:output_type -> boltzrpc.Wallet
+	67,  // 127: boltzrpc.Boltz.SetSubaccount:output_type -> boltzrpc.Subaccount
+	58,  // 128: boltzrpc.Boltz.GetSubaccounts:output_type -> boltzrpc.GetSubaccountsResponse
+	65,  // 129: boltzrpc.Boltz.GetWallets:output_type -> boltzrpc.Wallets
+	64,  // 130: boltzrpc.Boltz.GetWallet:output_type -> boltzrpc.Wallet
+	52,  // 131: boltzrpc.Boltz.GetWalletCredentials:output_type -> boltzrpc.WalletCredentials
+	68,  // 132: boltzrpc.Boltz.RemoveWallet:output_type -> boltzrpc.RemoveWalletResponse
+	82,  // 133: boltzrpc.Boltz.Stop:output_type -> google.protobuf.Empty
+	82,  // 134: boltzrpc.Boltz.Unlock:output_type -> google.protobuf.Empty
+	71,  // 135: boltzrpc.Boltz.VerifyWalletPassword:output_type -> boltzrpc.VerifyWalletPasswordResponse
+	82,  // 136: boltzrpc.Boltz.ChangeWalletPassword:output_type -> google.protobuf.Empty
+	74,  // 137: boltzrpc.Boltz.BackupDatabase:output_type -> boltzrpc.BackupDatabaseResponse
+	82,  // 138: boltzrpc.Boltz.RestoreDatabase:output_type -> google.protobuf.Empty
+	106, // [106:139] is the sub-list for method output_type
+	73,  // [73:106] is the sub-list for method input_type
+	73,  // [73:73] is the sub-list for extension type_name
+	73,  // [73:73] is the sub-list for extension extendee
+	0,   // [0:73] is the sub-list for field type_name
 }
 
 func init() { file_boltzrpc_proto_init() }
@@ -4745,8 +7405,104 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SwapInfo); i {
+		file_boltzrpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeePolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChannelCreationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombinedChannelSwapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReverseSwapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainSwapInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainSwapData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeights); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4757,8 +7513,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelCreationInfo); i {
+		file_boltzrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4769,8 +7525,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CombinedChannelSwapInfo); i {
+		file_boltzrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Limits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4781,8 +7537,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReverseSwapInfo); i {
+		file_boltzrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmarinePair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4793,8 +7549,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeights); i {
+		file_boltzrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReversePair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4805,8 +7561,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInfoRequest); i {
+		file_boltzrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainPair); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4817,8 +7573,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetInfoResponse); i {
+		file_boltzrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPairsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4829,8 +7585,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Limits); i {
+		file_boltzrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4841,8 +7597,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubmarinePair); i {
+		file_boltzrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4853,8 +7609,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReversePair); i {
+		file_boltzrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinerFees); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4865,8 +7621,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPairsResponse); i {
+		file_boltzrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Fees); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4877,8 +7633,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MinerFees); i {
+		file_boltzrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4889,8 +7645,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Fees); i {
+		file_boltzrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4901,8 +7657,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceInfoRequest); i {
+		file_boltzrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4913,8 +7669,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceInfoResponse); i {
+		file_boltzrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSwapsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4925,8 +7681,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSwapsRequest); i {
+		file_boltzrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSwapsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4937,8 +7693,8 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListSwapsResponse); i {
+		file_boltzrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportSwapsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4949,7 +7705,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RefundSwapRequest); i {
 			case 0:
 				return &v.state
@@ -4961,7 +7717,31 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BumpTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSwapInfoRequest); i {
 			case 0:
 				return &v.state
@@ -4973,7 +7753,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSwapInfoResponse); i {
 			case 0:
 				return &v.state
@@ -4985,7 +7765,43 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateSwapLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwapEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeoutWarning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DepositRequest); i {
 			case 0:
 				return &v.state
@@ -4997,7 +7813,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DepositResponse); i {
 			case 0:
 				return &v.state
@@ -5009,7 +7825,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateSwapRequest); i {
 			case 0:
 				return &v.state
@@ -5021,7 +7837,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateSwapResponse); i {
 			case 0:
 				return &v.state
@@ -5033,7 +7849,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateChannelRequest); i {
 			case 0:
 				return &v.state
@@ -5045,7 +7861,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateReverseSwapRequest); i {
 			case 0:
 				return &v.state
@@ -5057,7 +7873,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateReverseSwapResponse); i {
 			case 0:
 				return &v.state
@@ -5069,7 +7885,19 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateChainSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChannelId); i {
 			case 0:
 				return &v.state
@@ -5081,7 +7909,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LightningChannel); i {
 			case 0:
 				return &v.state
@@ -5093,7 +7921,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SwapStats); i {
 			case 0:
 				return &v.state
@@ -5105,7 +7933,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Budget); i {
 			case 0:
 				return &v.state
@@ -5117,7 +7945,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletCredentials); i {
 			case 0:
 				return &v.state
@@ -5129,7 +7957,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WalletInfo); i {
 			case 0:
 				return &v.state
@@ -5141,7 +7969,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportWalletRequest); i {
 			case 0:
 				return &v.state
@@ -5153,7 +7981,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateWalletRequest); i {
 			case 0:
 				return &v.state
@@ -5165,7 +7993,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetSubaccountRequest); i {
 			case 0:
 				return &v.state
@@ -5177,7 +8005,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSubaccountsRequest); i {
 			case 0:
 				return &v.state
@@ -5189,7 +8017,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetSubaccountsResponse); i {
 			case 0:
 				return &v.state
@@ -5201,7 +8029,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ImportWalletResponse); i {
 			case 0:
 				return &v.state
@@ -5213,7 +8041,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetWalletsRequest); i {
 			case 0:
 				return &v.state
@@ -5225,7 +8053,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetWalletRequest); i {
 			case 0:
 				return &v.state
@@ -5237,7 +8065,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetWalletCredentialsRequest); i {
 			case 0:
 				return &v.state
@@ -5249,7 +8077,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveWalletRequest); i {
 			case 0:
 				return &v.state
@@ -5261,7 +8089,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Wallet); i {
 			case 0:
 				return &v.state
@@ -5273,7 +8101,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Wallets); i {
 			case 0:
 				return &v.state
@@ -5285,7 +8113,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Balance); i {
 			case 0:
 				return &v.state
@@ -5297,7 +8125,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Subaccount); i {
 			case 0:
 				return &v.state
@@ -5309,7 +8137,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RemoveWalletResponse); i {
 			case 0:
 				return &v.state
@@ -5321,7 +8149,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnlockRequest); i {
 			case 0:
 				return &v.state
@@ -5333,7 +8161,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VerifyWalletPasswordRequest); i {
 			case 0:
 				return &v.state
@@ -5345,7 +8173,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VerifyWalletPasswordResponse); i {
 			case 0:
 				return &v.state
@@ -5357,7 +8185,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChangeWalletPasswordRequest); i {
 			case 0:
 				return &v.state
@@ -5369,7 +8197,43 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupDatabaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupDatabaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreDatabaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubmarinePair_Fees); i {
 			case 0:
 				return &v.state
@@ -5381,7 +8245,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReversePair_Fees); i {
 			case 0:
 				return &v.state
@@ -5393,7 +8257,7 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
-		file_boltzrpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_boltzrpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReversePair_Fees_MinerFees); i {
 			case 0:
 				return &v.state
@@ -5405,28 +8269,82 @@ func file_boltzrpc_proto_init() {
 				return nil
 			}
 		}
+		file_boltzrpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainPair_Fees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainPair_Fees_MinerFees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_boltzrpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainPair_Fees_MinerFees_UserFees); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_boltzrpc_proto_msgTypes[1].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	file_boltzrpc_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[16].OneofWrappers = []interface{}{
+		(*GetQuoteRequest_SendAmount)(nil),
+		(*GetQuoteRequest_ReceiveAmount)(nil),
+	}
+	file_boltzrpc_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[22].OneofWrappers = []interface{}{}
 	file_boltzrpc_proto_msgTypes[23].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[24].OneofWrappers = []interface{}{}
 	file_boltzrpc_proto_msgTypes[26].OneofWrappers = []interface{}{}
 	file_boltzrpc_proto_msgTypes[27].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[31].OneofWrappers = []interface{}{}
 	file_boltzrpc_proto_msgTypes[32].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[34].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[35].OneofWrappers = []interface{}{}
 	file_boltzrpc_proto_msgTypes[36].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[39].OneofWrappers = []interface{}{}
 	file_boltzrpc_proto_msgTypes[40].OneofWrappers = []interface{}{}
-	file_boltzrpc_proto_msgTypes[42].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[41].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[46].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[48].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[49].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[50].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[52].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[54].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[56].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[67].OneofWrappers = []interface{}{}
+	file_boltzrpc_proto_msgTypes[69].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_boltzrpc_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   56,
+			NumEnums:      6,
+			NumMessages:   76,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
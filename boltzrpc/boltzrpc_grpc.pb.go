@@ -24,15 +24,21 @@ const (
 	Boltz_GetServiceInfo_FullMethodName       = "/boltzrpc.Boltz/GetServiceInfo"
 	Boltz_GetSubmarinePair_FullMethodName     = "/boltzrpc.Boltz/GetSubmarinePair"
 	Boltz_GetReversePair_FullMethodName       = "/boltzrpc.Boltz/GetReversePair"
+	Boltz_GetChainPair_FullMethodName         = "/boltzrpc.Boltz/GetChainPair"
 	Boltz_GetPairs_FullMethodName             = "/boltzrpc.Boltz/GetPairs"
+	Boltz_GetQuote_FullMethodName             = "/boltzrpc.Boltz/GetQuote"
 	Boltz_ListSwaps_FullMethodName            = "/boltzrpc.Boltz/ListSwaps"
+	Boltz_ExportSwaps_FullMethodName          = "/boltzrpc.Boltz/ExportSwaps"
 	Boltz_RefundSwap_FullMethodName           = "/boltzrpc.Boltz/RefundSwap"
+	Boltz_BumpTransaction_FullMethodName      = "/boltzrpc.Boltz/BumpTransaction"
 	Boltz_GetSwapInfo_FullMethodName          = "/boltzrpc.Boltz/GetSwapInfo"
 	Boltz_GetSwapInfoStream_FullMethodName    = "/boltzrpc.Boltz/GetSwapInfoStream"
+	Boltz_UpdateSwapLabel_FullMethodName      = "/boltzrpc.Boltz/UpdateSwapLabel"
 	Boltz_Deposit_FullMethodName              = "/boltzrpc.Boltz/Deposit"
 	Boltz_CreateSwap_FullMethodName           = "/boltzrpc.Boltz/CreateSwap"
 	Boltz_CreateChannel_FullMethodName        = "/boltzrpc.Boltz/CreateChannel"
 	Boltz_CreateReverseSwap_FullMethodName    = "/boltzrpc.Boltz/CreateReverseSwap"
+	Boltz_CreateChainSwap_FullMethodName      = "/boltzrpc.Boltz/CreateChainSwap"
 	Boltz_CreateWallet_FullMethodName         = "/boltzrpc.Boltz/CreateWallet"
 	Boltz_ImportWallet_FullMethodName         = "/boltzrpc.Boltz/ImportWallet"
 	Boltz_SetSubaccount_FullMethodName        = "/boltzrpc.Boltz/SetSubaccount"
@@ -45,6 +51,8 @@ const (
 	Boltz_Unlock_FullMethodName               = "/boltzrpc.Boltz/Unlock"
 	Boltz_VerifyWalletPassword_FullMethodName = "/boltzrpc.Boltz/VerifyWalletPassword"
 	Boltz_ChangeWalletPassword_FullMethodName = "/boltzrpc.Boltz/ChangeWalletPassword"
+	Boltz_BackupDatabase_FullMethodName       = "/boltzrpc.Boltz/BackupDatabase"
+	Boltz_RestoreDatabase_FullMethodName      = "/boltzrpc.Boltz/RestoreDatabase"
 )
 
 // BoltzClient is the client API for Boltz service.
@@ -62,18 +70,30 @@ type BoltzClient interface {
 	GetSubmarinePair(ctx context.Context, in *Pair, opts ...grpc.CallOption) (*SubmarinePair, error)
 	// Fetches information about a specific pair for a reverse swap.
 	GetReversePair(ctx context.Context, in *Pair, opts ...grpc.CallOption) (*ReversePair, error)
-	// Fetches all available pairs for submarine and reverse swaps.
+	// Fetches information about a specific pair for a chain swap.
+	GetChainPair(ctx context.Context, in *Pair, opts ...grpc.CallOption) (*ChainPair, error)
+	// Fetches all available pairs for submarine, reverse and chain swaps.
 	GetPairs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetPairsResponse, error)
+	// Calculates the amounts and fees of a swap with either a fixed amount to send or to receive,
+	// based on the current fees of the pair and our own fee estimations.
+	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error)
 	// Returns a list of all swaps, reverse swaps and channel creations in the database.
 	ListSwaps(ctx context.Context, in *ListSwapsRequest, opts ...grpc.CallOption) (*ListSwapsResponse, error)
+	// Exports the swaps in the database for accounting purposes.
+	ExportSwaps(ctx context.Context, in *ExportSwapsRequest, opts ...grpc.CallOption) (*ExportSwapsResponse, error)
 	// Refund a failed swap manually.
 	// This is only required when no refund address has been set or the daemon has no wallet for the currency.
 	RefundSwap(ctx context.Context, in *RefundSwapRequest, opts ...grpc.CallOption) (*GetSwapInfoResponse, error)
+	// Bumps the fee of a pending claim or refund transaction. The transaction is replaced if possible (RBF),
+	// otherwise its output is spent with a higher fee by an internal wallet (CPFP).
+	BumpTransaction(ctx context.Context, in *BumpTransactionRequest, opts ...grpc.CallOption) (*BumpTransactionResponse, error)
 	// Gets all available information about a swap from the database.
 	GetSwapInfo(ctx context.Context, in *GetSwapInfoRequest, opts ...grpc.CallOption) (*GetSwapInfoResponse, error)
 	// Returns the entire history of the swap if is still pending and streams updates in real time.
 	// If the swap id is empty or "*" updates for all swaps will be streamed.
 	GetSwapInfoStream(ctx context.Context, in *GetSwapInfoRequest, opts ...grpc.CallOption) (Boltz_GetSwapInfoStreamClient, error)
+	// Sets the label and external id of an existing swap of any type.
+	UpdateSwapLabel(ctx context.Context, in *UpdateSwapLabelRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Deprecated: Do not use.
 	//
 	// This is a wrapper for channel creation swaps. The daemon only returns the ID, timeout block height and lockup address.
@@ -91,6 +111,9 @@ type BoltzClient interface {
 	// Creates a new reverse swap from lightning to onchain. If `accept_zero_conf` is set to true in the request, the daemon
 	// will not wait until the lockup transaction from Boltz is confirmed in a block, but will claim it instantly.
 	CreateReverseSwap(ctx context.Context, in *CreateReverseSwapRequest, opts ...grpc.CallOption) (*CreateReverseSwapResponse, error)
+	// Creates a new chain swap from one onchain currency to another. If `from_wallet` is set, the daemon will pay
+	// the lockup address from the specified internal wallet, otherwise the returned `lockup_address` has to be paid manually.
+	CreateChainSwap(ctx context.Context, in *CreateChainSwapRequest, opts ...grpc.CallOption) (*ChainSwapInfo, error)
 	// Creates a new liquid wallet and returns the mnemonic.
 	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*WalletCredentials, error)
 	// Imports an existing wallet.
@@ -109,12 +132,17 @@ type BoltzClient interface {
 	RemoveWallet(ctx context.Context, in *RemoveWalletRequest, opts ...grpc.CallOption) (*RemoveWalletResponse, error)
 	// Gracefully stops the daemon.
 	Stop(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*empty.Empty, error)
-	// Unlocks the server. This will be required on startup if there are any encrypted wallets.
+	// Unlocks the server. This will be required on startup if there are any encrypted wallets or swap secrets.
 	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Check if the password is correct.
 	VerifyWalletPassword(ctx context.Context, in *VerifyWalletPasswordRequest, opts ...grpc.CallOption) (*VerifyWalletPasswordResponse, error)
-	// Changes the password for wallet encryption.
+	// Changes the password for wallet encryption. Swap secrets encrypted with the old password are re-encrypted with the new one.
 	ChangeWalletPassword(ctx context.Context, in *ChangeWalletPasswordRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// Writes a consistent snapshot of the database to a file on the machine of the daemon while it keeps running.
+	BackupDatabase(ctx context.Context, in *BackupDatabaseRequest, opts ...grpc.CallOption) (*BackupDatabaseResponse, error)
+	// Replaces the database with a backup on the machine of the daemon. The daemon shuts down afterwards
+	// and uses the restored database once it is started again. If the restore fails, it keeps running with the previous database.
+	RestoreDatabase(ctx context.Context, in *RestoreDatabaseRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type boltzClient struct {
@@ -162,6 +190,15 @@ func (c *boltzClient) GetReversePair(ctx context.Context, in *Pair, opts ...grpc
 	return out, nil
 }
 
+func (c *boltzClient) GetChainPair(ctx context.Context, in *Pair, opts ...grpc.CallOption) (*ChainPair, error) {
+	out := new(ChainPair)
+	err := c.cc.Invoke(ctx, Boltz_GetChainPair_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boltzClient) GetPairs(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetPairsResponse, error) {
 	out := new(GetPairsResponse)
 	err := c.cc.Invoke(ctx, Boltz_GetPairs_FullMethodName, in, out, opts...)
@@ -171,6 +208,15 @@ func (c *boltzClient) GetPairs(ctx context.Context, in *empty.Empty, opts ...grp
 	return out, nil
 }
 
+func (c *boltzClient) GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error) {
+	out := new(GetQuoteResponse)
+	err := c.cc.Invoke(ctx, Boltz_GetQuote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boltzClient) ListSwaps(ctx context.Context, in *ListSwapsRequest, opts ...grpc.CallOption) (*ListSwapsResponse, error) {
 	out := new(ListSwapsResponse)
 	err := c.cc.Invoke(ctx, Boltz_ListSwaps_FullMethodName, in, out, opts...)
@@ -180,6 +226,15 @@ func (c *boltzClient) ListSwaps(ctx context.Context, in *ListSwapsRequest, opts
 	return out, nil
 }
 
+func (c *boltzClient) ExportSwaps(ctx context.Context, in *ExportSwapsRequest, opts ...grpc.CallOption) (*ExportSwapsResponse, error) {
+	out := new(ExportSwapsResponse)
+	err := c.cc.Invoke(ctx, Boltz_ExportSwaps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boltzClient) RefundSwap(ctx context.Context, in *RefundSwapRequest, opts ...grpc.CallOption) (*GetSwapInfoResponse, error) {
 	out := new(GetSwapInfoResponse)
 	err := c.cc.Invoke(ctx, Boltz_RefundSwap_FullMethodName, in, out, opts...)
@@ -189,6 +244,15 @@ func (c *boltzClient) RefundSwap(ctx context.Context, in *RefundSwapRequest, opt
 	return out, nil
 }
 
+func (c *boltzClient) BumpTransaction(ctx context.Context, in *BumpTransactionRequest, opts ...grpc.CallOption) (*BumpTransactionResponse, error) {
+	out := new(BumpTransactionResponse)
+	err := c.cc.Invoke(ctx, Boltz_BumpTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boltzClient) GetSwapInfo(ctx context.Context, in *GetSwapInfoRequest, opts ...grpc.CallOption) (*GetSwapInfoResponse, error) {
 	out := new(GetSwapInfoResponse)
 	err := c.cc.Invoke(ctx, Boltz_GetSwapInfo_FullMethodName, in, out, opts...)
@@ -230,6 +294,15 @@ func (x *boltzGetSwapInfoStreamClient) Recv() (*GetSwapInfoResponse, error) {
 	return m, nil
 }
 
+func (c *boltzClient) UpdateSwapLabel(ctx context.Context, in *UpdateSwapLabelRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, Boltz_UpdateSwapLabel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *boltzClient) Deposit(ctx context.Context, in *DepositRequest, opts ...grpc.CallOption) (*DepositResponse, error) {
 	out := new(DepositResponse)
@@ -268,6 +341,15 @@ func (c *boltzClient) CreateReverseSwap(ctx context.Context, in *CreateReverseSw
 	return out, nil
 }
 
+func (c *boltzClient) CreateChainSwap(ctx context.Context, in *CreateChainSwapRequest, opts ...grpc.CallOption) (*ChainSwapInfo, error) {
+	out := new(ChainSwapInfo)
+	err := c.cc.Invoke(ctx, Boltz_CreateChainSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *boltzClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*WalletCredentials, error) {
 	out := new(WalletCredentials)
 	err := c.cc.Invoke(ctx, Boltz_CreateWallet_FullMethodName, in, out, opts...)
@@ -376,6 +458,24 @@ func (c *boltzClient) ChangeWalletPassword(ctx context.Context, in *ChangeWallet
 	return out, nil
 }
 
+func (c *boltzClient) BackupDatabase(ctx context.Context, in *BackupDatabaseRequest, opts ...grpc.CallOption) (*BackupDatabaseResponse, error) {
+	out := new(BackupDatabaseResponse)
+	err := c.cc.Invoke(ctx, Boltz_BackupDatabase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *boltzClient) RestoreDatabase(ctx context.Context, in *RestoreDatabaseRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, Boltz_RestoreDatabase_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BoltzServer is the server API for Boltz service.
 // All implementations must embed UnimplementedBoltzServer
 // for forward compatibility
@@ -391,18 +491,30 @@ type BoltzServer interface {
 	GetSubmarinePair(context.Context, *Pair) (*SubmarinePair, error)
 	// Fetches information about a specific pair for a reverse swap.
 	GetReversePair(context.Context, *Pair) (*ReversePair, error)
-	// Fetches all available pairs for submarine and reverse swaps.
+	// Fetches information about a specific pair for a chain swap.
+	GetChainPair(context.Context, *Pair) (*ChainPair, error)
+	// Fetches all available pairs for submarine, reverse and chain swaps.
 	GetPairs(context.Context, *empty.Empty) (*GetPairsResponse, error)
+	// Calculates the amounts and fees of a swap with either a fixed amount to send or to receive,
+	// based on the current fees of the pair and our own fee estimations.
+	GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error)
 	// Returns a list of all swaps, reverse swaps and channel creations in the database.
 	ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error)
+	// Exports the swaps in the database for accounting purposes.
+	ExportSwaps(context.Context, *ExportSwapsRequest) (*ExportSwapsResponse, error)
 	// Refund a failed swap manually.
 	// This is only required when no refund address has been set or the daemon has no wallet for the currency.
 	RefundSwap(context.Context, *RefundSwapRequest) (*GetSwapInfoResponse, error)
+	// Bumps the fee of a pending claim or refund transaction. The transaction is replaced if possible (RBF),
+	// otherwise its output is spent with a higher fee by an internal wallet (CPFP).
+	BumpTransaction(context.Context, *BumpTransactionRequest) (*BumpTransactionResponse, error)
 	// Gets all available information about a swap from the database.
 	GetSwapInfo(context.Context, *GetSwapInfoRequest) (*GetSwapInfoResponse, error)
 	// Returns the entire history of the swap if is still pending and streams updates in real time.
 	// If the swap id is empty or "*" updates for all swaps will be streamed.
 	GetSwapInfoStream(*GetSwapInfoRequest, Boltz_GetSwapInfoStreamServer) error
+	// Sets the label and external id of an existing swap of any type.
+	UpdateSwapLabel(context.Context, *UpdateSwapLabelRequest) (*empty.Empty, error)
 	// Deprecated: Do not use.
 	//
 	// This is a wrapper for channel creation swaps. The daemon only returns the ID, timeout block height and lockup address.
@@ -420,6 +532,9 @@ type BoltzServer interface {
 	// Creates a new reverse swap from lightning to onchain. If `accept_zero_conf` is set to true in the request, the daemon
 	// will not wait until the lockup transaction from Boltz is confirmed in a block, but will claim it instantly.
 	CreateReverseSwap(context.Context, *CreateReverseSwapRequest) (*CreateReverseSwapResponse, error)
+	// Creates a new chain swap from one onchain currency to another. If `from_wallet` is set, the daemon will pay
+	// the lockup address from the specified internal wallet, otherwise the returned `lockup_address` has to be paid manually.
+	CreateChainSwap(context.Context, *CreateChainSwapRequest) (*ChainSwapInfo, error)
 	// Creates a new liquid wallet and returns the mnemonic.
 	CreateWallet(context.Context, *CreateWalletRequest) (*WalletCredentials, error)
 	// Imports an existing wallet.
@@ -438,12 +553,17 @@ type BoltzServer interface {
 	RemoveWallet(context.Context, *RemoveWalletRequest) (*RemoveWalletResponse, error)
 	// Gracefully stops the daemon.
 	Stop(context.Context, *empty.Empty) (*empty.Empty, error)
-	// Unlocks the server. This will be required on startup if there are any encrypted wallets.
+	// Unlocks the server. This will be required on startup if there are any encrypted wallets or swap secrets.
 	Unlock(context.Context, *UnlockRequest) (*empty.Empty, error)
 	// Check if the password is correct.
 	VerifyWalletPassword(context.Context, *VerifyWalletPasswordRequest) (*VerifyWalletPasswordResponse, error)
-	// Changes the password for wallet encryption.
+	// Changes the password for wallet encryption. Swap secrets encrypted with the old password are re-encrypted with the new one.
 	ChangeWalletPassword(context.Context, *ChangeWalletPasswordRequest) (*empty.Empty, error)
+	// Writes a consistent snapshot of the database to a file on the machine of the daemon while it keeps running.
+	BackupDatabase(context.Context, *BackupDatabaseRequest) (*BackupDatabaseResponse, error)
+	// Replaces the database with a backup on the machine of the daemon. The daemon shuts down afterwards
+	// and uses the restored database once it is started again. If the restore fails, it keeps running with the previous database.
+	RestoreDatabase(context.Context, *RestoreDatabaseRequest) (*empty.Empty, error)
 	mustEmbedUnimplementedBoltzServer()
 }
 
@@ -463,21 +583,36 @@ func (UnimplementedBoltzServer) GetSubmarinePair(context.Context, *Pair) (*Subma
 func (UnimplementedBoltzServer) GetReversePair(context.Context, *Pair) (*ReversePair, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReversePair not implemented")
 }
+func (UnimplementedBoltzServer) GetChainPair(context.Context, *Pair) (*ChainPair, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainPair not implemented")
+}
 func (UnimplementedBoltzServer) GetPairs(context.Context, *empty.Empty) (*GetPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPairs not implemented")
 }
+func (UnimplementedBoltzServer) GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuote not implemented")
+}
 func (UnimplementedBoltzServer) ListSwaps(context.Context, *ListSwapsRequest) (*ListSwapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSwaps not implemented")
 }
+func (UnimplementedBoltzServer) ExportSwaps(context.Context, *ExportSwapsRequest) (*ExportSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSwaps not implemented")
+}
 func (UnimplementedBoltzServer) RefundSwap(context.Context, *RefundSwapRequest) (*GetSwapInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefundSwap not implemented")
 }
+func (UnimplementedBoltzServer) BumpTransaction(context.Context, *BumpTransactionRequest) (*BumpTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpTransaction not implemented")
+}
 func (UnimplementedBoltzServer) GetSwapInfo(context.Context, *GetSwapInfoRequest) (*GetSwapInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSwapInfo not implemented")
 }
 func (UnimplementedBoltzServer) GetSwapInfoStream(*GetSwapInfoRequest, Boltz_GetSwapInfoStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetSwapInfoStream not implemented")
 }
+func (UnimplementedBoltzServer) UpdateSwapLabel(context.Context, *UpdateSwapLabelRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSwapLabel not implemented")
+}
 func (UnimplementedBoltzServer) Deposit(context.Context, *DepositRequest) (*DepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
@@ -490,6 +625,9 @@ func (UnimplementedBoltzServer) CreateChannel(context.Context, *CreateChannelReq
 func (UnimplementedBoltzServer) CreateReverseSwap(context.Context, *CreateReverseSwapRequest) (*CreateReverseSwapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateReverseSwap not implemented")
 }
+func (UnimplementedBoltzServer) CreateChainSwap(context.Context, *CreateChainSwapRequest) (*ChainSwapInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChainSwap not implemented")
+}
 func (UnimplementedBoltzServer) CreateWallet(context.Context, *CreateWalletRequest) (*WalletCredentials, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
 }
@@ -526,6 +664,12 @@ func (UnimplementedBoltzServer) VerifyWalletPassword(context.Context, *VerifyWal
 func (UnimplementedBoltzServer) ChangeWalletPassword(context.Context, *ChangeWalletPasswordRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeWalletPassword not implemented")
 }
+func (UnimplementedBoltzServer) BackupDatabase(context.Context, *BackupDatabaseRequest) (*BackupDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupDatabase not implemented")
+}
+func (UnimplementedBoltzServer) RestoreDatabase(context.Context, *RestoreDatabaseRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDatabase not implemented")
+}
 func (UnimplementedBoltzServer) mustEmbedUnimplementedBoltzServer() {}
 
 // UnsafeBoltzServer may be embedded to opt out of forward compatibility for this service.
@@ -611,6 +755,24 @@ func _Boltz_GetReversePair_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_GetChainPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Pair)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).GetChainPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_GetChainPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).GetChainPair(ctx, req.(*Pair))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_GetPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
@@ -629,6 +791,24 @@ func _Boltz_GetPairs_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).GetQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_GetQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).GetQuote(ctx, req.(*GetQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_ListSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSwapsRequest)
 	if err := dec(in); err != nil {
@@ -647,6 +827,24 @@ func _Boltz_ListSwaps_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_ExportSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).ExportSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_ExportSwaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).ExportSwaps(ctx, req.(*ExportSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_RefundSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RefundSwapRequest)
 	if err := dec(in); err != nil {
@@ -665,6 +863,24 @@ func _Boltz_RefundSwap_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_BumpTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).BumpTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_BumpTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).BumpTransaction(ctx, req.(*BumpTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_GetSwapInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSwapInfoRequest)
 	if err := dec(in); err != nil {
@@ -704,6 +920,24 @@ func (x *boltzGetSwapInfoStreamServer) Send(m *GetSwapInfoResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Boltz_UpdateSwapLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSwapLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).UpdateSwapLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_UpdateSwapLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).UpdateSwapLabel(ctx, req.(*UpdateSwapLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DepositRequest)
 	if err := dec(in); err != nil {
@@ -776,6 +1010,24 @@ func _Boltz_CreateReverseSwap_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_CreateChainSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChainSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).CreateChainSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_CreateChainSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).CreateChainSwap(ctx, req.(*CreateChainSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Boltz_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateWalletRequest)
 	if err := dec(in); err != nil {
@@ -992,6 +1244,42 @@ func _Boltz_ChangeWalletPassword_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Boltz_BackupDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).BackupDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_BackupDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).BackupDatabase(ctx, req.(*BackupDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Boltz_RestoreDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BoltzServer).RestoreDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Boltz_RestoreDatabase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BoltzServer).RestoreDatabase(ctx, req.(*RestoreDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Boltz_ServiceDesc is the grpc.ServiceDesc for Boltz service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1015,22 +1303,42 @@ var Boltz_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReversePair",
 			Handler:    _Boltz_GetReversePair_Handler,
 		},
+		{
+			MethodName: "GetChainPair",
+			Handler:    _Boltz_GetChainPair_Handler,
+		},
 		{
 			MethodName: "GetPairs",
 			Handler:    _Boltz_GetPairs_Handler,
 		},
+		{
+			MethodName: "GetQuote",
+			Handler:    _Boltz_GetQuote_Handler,
+		},
 		{
 			MethodName: "ListSwaps",
 			Handler:    _Boltz_ListSwaps_Handler,
 		},
+		{
+			MethodName: "ExportSwaps",
+			Handler:    _Boltz_ExportSwaps_Handler,
+		},
 		{
 			MethodName: "RefundSwap",
 			Handler:    _Boltz_RefundSwap_Handler,
 		},
+		{
+			MethodName: "BumpTransaction",
+			Handler:    _Boltz_BumpTransaction_Handler,
+		},
 		{
 			MethodName: "GetSwapInfo",
 			Handler:    _Boltz_GetSwapInfo_Handler,
 		},
+		{
+			MethodName: "UpdateSwapLabel",
+			Handler:    _Boltz_UpdateSwapLabel_Handler,
+		},
 		{
 			MethodName: "Deposit",
 			Handler:    _Boltz_Deposit_Handler,
@@ -1047,6 +1355,10 @@ var Boltz_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateReverseSwap",
 			Handler:    _Boltz_CreateReverseSwap_Handler,
 		},
+		{
+			MethodName: "CreateChainSwap",
+			Handler:    _Boltz_CreateChainSwap_Handler,
+		},
 		{
 			MethodName: "CreateWallet",
 			Handler:    _Boltz_CreateWallet_Handler,
@@ -1095,6 +1407,14 @@ var Boltz_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeWalletPassword",
 			Handler:    _Boltz_ChangeWalletPassword_Handler,
 		},
+		{
+			MethodName: "BackupDatabase",
+			Handler:    _Boltz_BackupDatabase_Handler,
+		},
+		{
+			MethodName: "RestoreDatabase",
+			Handler:    _Boltz_RestoreDatabase_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
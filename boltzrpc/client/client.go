@@ -35,12 +35,33 @@ func (boltz *Boltz) GetReversePair(pair *boltzrpc.Pair) (*boltzrpc.ReversePair,
 	return boltz.Client.GetReversePair(boltz.Ctx, pair)
 }
 
+func (boltz *Boltz) GetChainPair(pair *boltzrpc.Pair) (*boltzrpc.ChainPair, error) {
+	return boltz.Client.GetChainPair(boltz.Ctx, pair)
+}
+
+func (boltz *Boltz) GetQuote(request *boltzrpc.GetQuoteRequest) (*boltzrpc.GetQuoteResponse, error) {
+	return boltz.Client.GetQuote(boltz.Ctx, request)
+}
+
 func (boltz *Boltz) ListSwaps(request *boltzrpc.ListSwapsRequest) (*boltzrpc.ListSwapsResponse, error) {
 	return boltz.Client.ListSwaps(boltz.Ctx, request)
 }
 
+func (boltz *Boltz) ExportSwaps(request *boltzrpc.ExportSwapsRequest) (*boltzrpc.ExportSwapsResponse, error) {
+	return boltz.Client.ExportSwaps(boltz.Ctx, request)
+}
+
 func (boltz *Boltz) RefundSwap(id string, address string) (*boltzrpc.GetSwapInfoResponse, error) {
-	return boltz.Client.RefundSwap(boltz.Ctx, &boltzrpc.RefundSwapRequest{Id: id, Address: address})
+	return boltz.RefundSwapWithFeePolicy(id, address, nil)
+}
+
+// RefundSwapWithFeePolicy refunds a swap like RefundSwap and replaces its fee policy, unless the policy is nil
+func (boltz *Boltz) RefundSwapWithFeePolicy(id string, address string, feePolicy *boltzrpc.FeePolicy) (*boltzrpc.GetSwapInfoResponse, error) {
+	return boltz.Client.RefundSwap(boltz.Ctx, &boltzrpc.RefundSwapRequest{Id: id, Address: address, FeePolicy: feePolicy})
+}
+
+func (boltz *Boltz) BumpTransaction(request *boltzrpc.BumpTransactionRequest) (*boltzrpc.BumpTransactionResponse, error) {
+	return boltz.Client.BumpTransaction(boltz.Ctx, request)
 }
 
 func (boltz *Boltz) GetSwapInfo(id string) (*boltzrpc.GetSwapInfoResponse, error) {
@@ -55,6 +76,11 @@ func (boltz *Boltz) GetSwapInfoStream(id string) (boltzrpc.Boltz_GetSwapInfoStre
 	})
 }
 
+func (boltz *Boltz) UpdateSwapLabel(request *boltzrpc.UpdateSwapLabelRequest) error {
+	_, err := boltz.Client.UpdateSwapLabel(boltz.Ctx, request)
+	return err
+}
+
 func (boltz *Boltz) CreateSwap(request *boltzrpc.CreateSwapRequest) (*boltzrpc.CreateSwapResponse, error) {
 	return boltz.Client.CreateSwap(boltz.Ctx, request)
 }
@@ -62,6 +88,10 @@ func (boltz *Boltz) CreateSwap(request *boltzrpc.CreateSwapRequest) (*boltzrpc.C
 func (boltz *Boltz) CreateReverseSwap(request *boltzrpc.CreateReverseSwapRequest) (*boltzrpc.CreateReverseSwapResponse, error) {
 	return boltz.Client.CreateReverseSwap(boltz.Ctx, request)
 }
+
+func (boltz *Boltz) CreateChainSwap(request *boltzrpc.CreateChainSwapRequest) (*boltzrpc.ChainSwapInfo, error) {
+	return boltz.Client.CreateChainSwap(boltz.Ctx, request)
+}
 func (boltz *Boltz) GetWallet(name string) (*boltzrpc.Wallet, error) {
 	return boltz.Client.GetWallet(boltz.Ctx, &boltzrpc.GetWalletRequest{Name: name})
 }
@@ -124,3 +154,12 @@ func (boltz *Boltz) ChangeWalletPassword(old string, new string) error {
 	_, err := boltz.Client.ChangeWalletPassword(boltz.Ctx, &boltzrpc.ChangeWalletPasswordRequest{Old: old, New: new})
 	return err
 }
+
+func (boltz *Boltz) BackupDatabase(request *boltzrpc.BackupDatabaseRequest) (*boltzrpc.BackupDatabaseResponse, error) {
+	return boltz.Client.BackupDatabase(boltz.Ctx, request)
+}
+
+func (boltz *Boltz) RestoreDatabase(request *boltzrpc.RestoreDatabaseRequest) error {
+	_, err := boltz.Client.RestoreDatabase(boltz.Ctx, request)
+	return err
+}
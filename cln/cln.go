@@ -166,7 +166,7 @@ func (c *Cln) SanityCheck() (string, error) {
 	return info.Version, nil
 }
 
-func (c *Cln) CreateInvoice(value int64, preimage []byte, expiry int64, memo string) (*lightning.AddInvoiceResponse, error) {
+func (c *Cln) CreateInvoice(value int64, preimage []byte, expiry int64, memo string, channelIds []lightning.ChanId) (*lightning.AddInvoiceResponse, error) {
 	request := &protos.InvoiceRequest{
 		// wtf is this
 		AmountMsat: &protos.AmountOrAny{
@@ -188,6 +188,10 @@ func (c *Cln) CreateInvoice(value int64, preimage []byte, expiry int64, memo str
 		expiryDate := uint64(time.Now().Unix()) + uint64(expiry)
 		request.Expiry = &expiryDate
 	}
+	// only the given channels will be used for route hints
+	for _, chanId := range channelIds {
+		request.Exposeprivatechannels = append(request.Exposeprivatechannels, chanId.ToCln())
+	}
 	invoice, err := c.Client.Invoice(context.Background(), request)
 
 	if err != nil {
@@ -90,10 +90,15 @@ func main() {
 		getSwapCommand,
 		swapInfoStreamCommand,
 		listSwapsCommand,
+		exportSwapsCommand,
+		quoteCommand,
 
 		createSwapCommand,
 		createReverseSwapCommand,
+		createChainSwapCommand,
 		refundSwapCommand,
+		bumpTransactionCommand,
+		labelSwapCommand,
 
 		autoSwapCommands,
 
@@ -102,6 +107,8 @@ func main() {
 		formatMacaroonCommand,
 		shellCompletionsCommand,
 		stopCommand,
+		backupCommand,
+		restoreCommand,
 		unlockCommand,
 		changePasswordCommand,
 		verifyPasswordCommand,
@@ -16,6 +16,7 @@ import (
 
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/BoltzExchange/boltz-client/boltz"
@@ -90,6 +91,72 @@ var currencyFlag = &cli.StringFlag{
 	Usage: "Currency to use",
 }
 
+var feePolicyFlags = []cli.Flag{
+	&cli.IntFlag{
+		Name:  "conf-target",
+		Usage: "Confirmation target in blocks for fee estimations of the onchain transactions of the swap",
+	},
+	&cli.Float64Flag{
+		Name:  "sat-per-vbyte",
+		Usage: "Fixed fee rate for the onchain transactions of the swap",
+	},
+	&cli.Uint64Flag{
+		Name:  "max-fee",
+		Usage: "Maximal fee in satoshis a single onchain transaction of the swap may pay",
+	},
+	&cli.Float64Flag{
+		Name:  "max-fee-percent",
+		Usage: "Maximal fee a single onchain transaction of the swap may pay relative to the amount of the swap",
+	},
+}
+
+func getFeePolicy(ctx *cli.Context) *boltzrpc.FeePolicy {
+	policy := &boltzrpc.FeePolicy{}
+	if ctx.IsSet("conf-target") {
+		confTarget := int32(ctx.Int("conf-target"))
+		policy.ConfTarget = &confTarget
+	}
+	if ctx.IsSet("sat-per-vbyte") {
+		satPerVbyte := ctx.Float64("sat-per-vbyte")
+		policy.SatPerVbyte = &satPerVbyte
+	}
+	if ctx.IsSet("max-fee") {
+		maxFee := ctx.Uint64("max-fee")
+		policy.MaxFee = &maxFee
+	}
+	if ctx.IsSet("max-fee-percent") {
+		maxFeePercent := float32(ctx.Float64("max-fee-percent"))
+		policy.MaxFeePercent = &maxFeePercent
+	}
+	if proto.Equal(policy, &boltzrpc.FeePolicy{}) {
+		return nil
+	}
+	return policy
+}
+
+var labelFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "label",
+		Usage: "Free form description of the swap",
+	},
+	&cli.StringFlag{
+		Name:  "external-id",
+		Usage: "Reference of the swap in an external system, like an order id",
+	},
+}
+
+func getLabel(ctx *cli.Context) (label *string, externalId *string) {
+	if ctx.IsSet("label") {
+		value := ctx.String("label")
+		label = &value
+	}
+	if ctx.IsSet("external-id") {
+		value := ctx.String("external-id")
+		externalId = &value
+	}
+	return label, externalId
+}
+
 var fromFilterFlag = &cli.StringFlag{
 	Name:  "from",
 	Usage: "Originating swap currency",
@@ -106,6 +173,42 @@ var stateFilterFlag = &cli.StringFlag{
 	Name:  "state",
 	Usage: "Filter swaps by state",
 }
+var sinceFilterFlag = &cli.TimestampFlag{
+	Name:   "since",
+	Layout: time.DateOnly,
+	Usage:  "Only show swaps created on or after the given date (YYYY-MM-DD)",
+}
+var untilFilterFlag = &cli.TimestampFlag{
+	Name:   "until",
+	Layout: time.DateOnly,
+	Usage:  "Only show swaps created before the given date (YYYY-MM-DD)",
+}
+var queryFilterFlag = &cli.StringFlag{
+	Name:  "query",
+	Usage: "Search swaps by id prefix, payment hash, invoice, lockup address or transaction id",
+}
+var labelFilterFlag = &cli.StringFlag{
+	Name:  "label",
+	Usage: "Only show swaps with the given label",
+}
+var externalIdFilterFlag = &cli.StringFlag{
+	Name:  "external-id",
+	Usage: "Only show swaps with the given external id",
+}
+var paginationFlags = []cli.Flag{
+	&cli.Uint64Flag{
+		Name:  "limit",
+		Usage: "Maximal number of swaps to show",
+	},
+	&cli.StringFlag{
+		Name:  "cursor",
+		Usage: "Cursor of the next page returned by a previous call",
+	},
+	&cli.BoolFlag{
+		Name:  "desc",
+		Usage: "Show the newest swaps first",
+	},
+}
 
 var listSwapsCommand = &cli.Command{
 	Name:     "listswaps",
@@ -115,17 +218,22 @@ var listSwapsCommand = &cli.Command{
 		isAuto := ctx.Bool("auto")
 		return listSwaps(ctx, &isAuto)
 	},
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		jsonFlag,
 		fromFilterFlag,
 		toFilterFlag,
 		pendingFilterFlag,
 		stateFilterFlag,
+		sinceFilterFlag,
+		untilFilterFlag,
+		queryFilterFlag,
+		labelFilterFlag,
+		externalIdFilterFlag,
 		&cli.BoolFlag{
 			Name:  "auto",
 			Usage: "Only show swaps by autoswapper",
 		},
-	},
+	}, paginationFlags...),
 }
 
 func listSwaps(ctx *cli.Context, isAuto *bool) error {
@@ -160,6 +268,33 @@ func listSwaps(ctx *cli.Context, isAuto *bool) error {
 			request.State = &state
 		}
 	}
+	if since := ctx.Timestamp("since"); since != nil {
+		timestamp := since.Unix()
+		request.Since = &timestamp
+	}
+	if until := ctx.Timestamp("until"); until != nil {
+		timestamp := until.Unix()
+		request.Until = &timestamp
+	}
+	if query := ctx.String("query"); query != "" {
+		request.Query = &query
+	}
+	if label := ctx.String("label"); label != "" {
+		request.Label = &label
+	}
+	if externalId := ctx.String("external-id"); externalId != "" {
+		request.ExternalId = &externalId
+	}
+	if ctx.IsSet("limit") {
+		limit := ctx.Uint64("limit")
+		request.Limit = &limit
+	}
+	if cursor := ctx.String("cursor"); cursor != "" {
+		request.Cursor = &cursor
+	}
+	if ctx.Bool("desc") {
+		request.Order = boltzrpc.SortOrder_DESCENDING
+	}
 	list, err := client.ListSwaps(request)
 
 	if err != nil {
@@ -172,7 +307,7 @@ func listSwaps(ctx *cli.Context, isAuto *bool) error {
 		headerFmt := color.New(color.FgGreen, color.Underline).SprintfFunc()
 		columnFmt := color.New(color.FgYellow).SprintfFunc()
 
-		if len(list.Swaps) == 0 && len(list.ReverseSwaps) == 0 {
+		if len(list.Swaps) == 0 && len(list.ReverseSwaps) == 0 && len(list.ChainSwaps) == 0 {
 			fmt.Println("No swaps found")
 			return nil
 		}
@@ -207,12 +342,90 @@ func listSwaps(ctx *cli.Context, isAuto *bool) error {
 				return err
 			}
 			tbl.Print()
+			fmt.Println()
+		}
+
+		if len(list.ChainSwaps) > 0 {
+
+			tbl := table.New("ID", "From", "To", "State", "Status", "Amount", "Service Fee", "Onchain Fee", "Created At")
+			tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
+
+			for _, swap := range list.ChainSwaps {
+				tbl.AddRow(swap.Id, swap.Pair.From, swap.Pair.To, swap.State, swap.Status, swap.FromData.Amount, optionalInt(swap.ServiceFee), optionalInt(swap.OnchainFee), parseDate(swap.CreatedAt))
+			}
+
+			if _, err := yellowBold.Println("Chain Swaps"); err != nil {
+				return err
+			}
+			tbl.Print()
+		}
+
+		if list.NextCursor != nil {
+			fmt.Println()
+			fmt.Println("More swaps are available, show them with --cursor " + list.GetNextCursor())
 		}
 	}
 
 	return nil
 }
 
+var exportSwapsCommand = &cli.Command{
+	Name:     "export",
+	Category: "Info",
+	Usage:    "Exports all swaps for accounting",
+	Description: "Exports the swaps in the database as CSV, JSON lines, beancount or ledger.\n" +
+		"The beancount and ledger formats contain balanced transactions of successful and refunded swaps in BTC.",
+	Action: exportSwaps,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "format",
+			Value: "csv",
+			Usage: "Format of the export. One of csv, json, beancount or ledger",
+		},
+		sinceFilterFlag,
+		untilFilterFlag,
+		&cli.StringFlag{
+			Name:  "output",
+			Usage: "File to write the export to instead of printing it",
+		},
+	},
+}
+
+var exportFormats = map[string]boltzrpc.ExportFormat{
+	"csv":       boltzrpc.ExportFormat_CSV,
+	"json":      boltzrpc.ExportFormat_JSON_LINES,
+	"beancount": boltzrpc.ExportFormat_BEANCOUNT,
+	"ledger":    boltzrpc.ExportFormat_LEDGER,
+}
+
+func exportSwaps(ctx *cli.Context) error {
+	client := getClient(ctx)
+	format, ok := exportFormats[strings.ToLower(ctx.String("format"))]
+	if !ok {
+		return fmt.Errorf("invalid format: %s", ctx.String("format"))
+	}
+	request := &boltzrpc.ExportSwapsRequest{Format: format}
+	if since := ctx.Timestamp("since"); since != nil {
+		timestamp := since.Unix()
+		request.Since = &timestamp
+	}
+	if until := ctx.Timestamp("until"); until != nil {
+		timestamp := until.Unix()
+		request.Until = &timestamp
+	}
+
+	response, err := client.ExportSwaps(request)
+	if err != nil {
+		return err
+	}
+
+	if output := ctx.String("output"); output != "" {
+		return os.WriteFile(utils.ExpandHomeDir(output), []byte(response.Content), 0600)
+	}
+	fmt.Print(response.Content)
+	return nil
+}
+
 var getSwapCommand = &cli.Command{
 	Name:      "swapinfo",
 	Category:  "Info",
@@ -320,6 +533,34 @@ func swapInfoStream(ctx *cli.Context, id string, json bool) error {
 					fmt.Printf("Paid %dmsat routing fee, %dsat onchain fee and %dsat service fee\n", *swap.RoutingFeeMsat, *swap.OnchainFee, *swap.ServiceFee)
 					return nil
 				}
+			} else if info.ChainSwap != nil {
+				yellowBold.Printf("Swap Status: %s\n", info.ChainSwap.Status)
+
+				swap := info.ChainSwap
+				switch swap.State {
+				case boltzrpc.SwapState_ERROR:
+					fmt.Printf("Error: %s", swap.Error)
+					return nil
+				case boltzrpc.SwapState_REFUNDED:
+					fmt.Println("Swap was refunded")
+					return nil
+				}
+
+				status := boltz.ParseEvent(swap.Status)
+				switch status {
+				case boltz.SwapCreated:
+					fmt.Printf("Swap ID: %s\n", swap.Id)
+				case boltz.TransactionMempool:
+					fmt.Printf("Lockup Transaction ID: %s\n", swap.FromData.GetLockupTransactionId())
+				case boltz.TransactionServerMempool:
+					fmt.Printf("Boltz Lockup Transaction ID: %s\n", swap.ToData.GetLockupTransactionId())
+				case boltz.TransactionClaimed:
+					fmt.Printf("Claim Transaction ID: %s\n", swap.ToData.GetTransactionId())
+					if swap.OnchainFee != nil && swap.ServiceFee != nil {
+						fmt.Printf("Paid %dsat onchain fee and %dsat service fee\n", *swap.OnchainFee, *swap.ServiceFee)
+					}
+					return nil
+				}
 			}
 			fmt.Println()
 		}
@@ -366,7 +607,10 @@ var autoSwapCommands = &cli.Command{
 				isAuto := true
 				return listSwaps(ctx, &isAuto)
 			},
-			Flags: []cli.Flag{jsonFlag, fromFilterFlag, toFilterFlag, stateFilterFlag, pendingFilterFlag},
+			Flags: append(
+				[]cli.Flag{jsonFlag, fromFilterFlag, toFilterFlag, stateFilterFlag, pendingFilterFlag, sinceFilterFlag, untilFilterFlag, queryFilterFlag, labelFilterFlag, externalIdFilterFlag},
+				paginationFlags...,
+			),
 		},
 		{
 			Name:   "setup",
@@ -753,6 +997,99 @@ func disableAutoSwap(ctx *cli.Context) error {
 	return err
 }
 
+var quoteCommand = &cli.Command{
+	Name:      "quote",
+	Category:  "Info",
+	Usage:     "Calculate the amounts and fees of a swap",
+	ArgsUsage: "type amount",
+	Description: "Calculates the amounts and fees of a swap of type `type` (submarine, reverse or chain) which sends exactly `amount` satoshis.\n" +
+		"\nExamples\n" +
+		"quote a submarine swap which sends 100000 satoshis onchain:\n" +
+		"> boltzcli quote submarine 100000\n" +
+		"quote a chain swap from btc to liquid which receives 100000 satoshis:\n" +
+		"> boltzcli quote --to LBTC --receive chain 100000",
+	Action: requireNArgs(2, quote),
+	Flags: []cli.Flag{
+		jsonFlag,
+		&cli.StringFlag{
+			Name:  "from",
+			Usage: "Currency to send",
+			Value: "btc",
+		},
+		&cli.StringFlag{
+			Name:  "to",
+			Usage: "Currency to receive",
+			Value: "btc",
+		},
+		&cli.BoolFlag{
+			Name:  "receive",
+			Usage: "Receive exactly the given amount instead of sending it",
+		},
+	},
+}
+
+func parseSwapType(swapType string) (boltzrpc.SwapType, error) {
+	parsed, ok := boltzrpc.SwapType_value[strings.ToUpper(swapType)]
+	if !ok {
+		return boltzrpc.SwapType_SUBMARINE, fmt.Errorf("invalid swap type: %s, allowed values: submarine, reverse, chain", swapType)
+	}
+	return boltzrpc.SwapType(parsed), nil
+}
+
+func quote(ctx *cli.Context) error {
+	client := getClient(ctx)
+
+	swapType, err := parseSwapType(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	from, err := parseCurrency(ctx.String("from"))
+	if err != nil {
+		return err
+	}
+	to, err := parseCurrency(ctx.String("to"))
+	if err != nil {
+		return err
+	}
+
+	request := &boltzrpc.GetQuoteRequest{
+		Type: swapType,
+		Pair: &boltzrpc.Pair{From: from, To: to},
+	}
+	amount := uint64(parseInt64(ctx.Args().Get(1), "amount"))
+	if ctx.Bool("receive") {
+		request.Amount = &boltzrpc.GetQuoteRequest_ReceiveAmount{ReceiveAmount: amount}
+	} else {
+		request.Amount = &boltzrpc.GetQuoteRequest_SendAmount{SendAmount: amount}
+	}
+
+	response, err := client.GetQuote(request)
+	if err != nil {
+		return err
+	}
+
+	if ctx.Bool("json") {
+		printJson(response)
+		return nil
+	}
+
+	fmt.Println("You send: " + utils.Satoshis(response.SendAmount))
+	fmt.Println("You receive: " + utils.Satoshis(response.ReceiveAmount))
+	if response.InvoiceAmount != nil {
+		fmt.Println("Invoice amount: " + utils.Satoshis(*response.InvoiceAmount))
+	}
+	fmt.Println("Onchain amount: " + utils.Satoshis(response.OnchainAmount))
+	fmt.Println("The fees for this swap are:")
+	fmt.Println("  - Service fee: " + utils.Satoshis(response.ServiceFee))
+	fmt.Println("  - Boltz miner fee: " + utils.Satoshis(response.BoltzMinerFee))
+	if swapType == boltzrpc.SwapType_SUBMARINE {
+		fmt.Println("  - Refund fee (only if the swap fails): " + utils.Satoshis(response.NetworkFee))
+	} else {
+		fmt.Println("  - Claim fee: " + utils.Satoshis(response.NetworkFee))
+	}
+	return nil
+}
+
 var createSwapCommand = &cli.Command{
 	Name:      "createswap",
 	Category:  "Swaps",
@@ -768,7 +1105,7 @@ var createSwapCommand = &cli.Command{
 		"Create a swap using an existing invoice:\n" +
 		"> boltzcli createswap --invoice lnbcrt1m1pja7adjpp59xdpx33l80wf8rsmqkwjyccdzccsedp9qgy9agf0k8m5g8ttrnzsdq8w3jhxaqcqp5xqzjcsp528qsd7mec4jml9zy302tmr0t995fe9uu80qwgg4zegerh3weyn8s9qyyssqpwecwyvndxh9ar0crgpe4crr93pr4g682u5sstzfk6e0g73s6urxm320j5yuamlszxnk5fzzrtx2hkxw8ehy6kntrx4cr4kcq6zc4uqqy7tcst",
 	Action: createSwap,
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		jsonFlag,
 		currencyFlag,
 		liquidFlag,
@@ -789,7 +1126,11 @@ var createSwapCommand = &cli.Command{
 			Name:  "invoice",
 			Usage: "Invoice which should be paid",
 		},
-	},
+		&cli.StringSliceFlag{
+			Name:  "chan-id",
+			Usage: "Channels which should receive the payment",
+		},
+	}, append(feePolicyFlags, labelFlags...)...),
 }
 
 func createSwap(ctx *cli.Context) error {
@@ -834,14 +1175,22 @@ func createSwap(ctx *cli.Context) error {
 	invoice := ctx.String("invoice")
 	wallet := ctx.String("wallet")
 	refundAddress := ctx.String("refund")
-	swap, err := client.CreateSwap(&boltzrpc.CreateSwapRequest{
+	request := &boltzrpc.CreateSwapRequest{
 		Amount:           amount,
 		Pair:             pair,
 		RefundAddress:    &refundAddress,
 		SendFromInternal: internalSend,
 		Wallet:           &wallet,
 		Invoice:          &invoice,
-	})
+		ChanIds:          ctx.StringSlice("chan-id"),
+		FeePolicy:        getFeePolicy(ctx),
+	}
+	request.Label, request.ExternalId = getLabel(ctx)
+	if !json {
+		// only create the swap with the fees that are shown
+		request.PairHash = &submarinePair.Hash
+	}
+	swap, err := client.CreateSwap(request)
 	if err != nil {
 		return err
 	}
@@ -875,13 +1224,12 @@ var refundSwapCommand = &cli.Command{
 	Usage:     "Refund a swap manually",
 	ArgsUsage: "id addresss",
 	Action:    requireNArgs(2, refundSwap),
+	Flags:     feePolicyFlags,
 }
 
 func refundSwap(ctx *cli.Context) error {
 	client := getClient(ctx)
-	id := ctx.Args().First()
-	address := ctx.Args().Get(1)
-	swap, err := client.RefundSwap(id, address)
+	swap, err := client.RefundSwapWithFeePolicy(ctx.Args().First(), ctx.Args().Get(1), getFeePolicy(ctx))
 	if err != nil {
 		return err
 	}
@@ -889,6 +1237,57 @@ func refundSwap(ctx *cli.Context) error {
 	return nil
 }
 
+var labelSwapCommand = &cli.Command{
+	Name:      "labelswap",
+	Category:  "Swaps",
+	Usage:     "Set the label and external id of a swap",
+	ArgsUsage: "id",
+	Description: "Sets the label and external id of an existing swap. Values which are not specified are left unchanged, an empty value removes them.\n" +
+		"\nExamples\n" +
+		"> boltzcli labelswap --label \"rent\" --external-id 4711 B7JzSI",
+	Action: requireNArgs(1, labelSwap),
+	Flags:  labelFlags,
+}
+
+func labelSwap(ctx *cli.Context) error {
+	client := getClient(ctx)
+	request := &boltzrpc.UpdateSwapLabelRequest{Id: ctx.Args().First()}
+	request.Label, request.ExternalId = getLabel(ctx)
+	if request.Label == nil && request.ExternalId == nil {
+		return errors.New("--label or --external-id has to be specified")
+	}
+	return client.UpdateSwapLabel(request)
+}
+
+var bumpTransactionCommand = &cli.Command{
+	Name:      "bumptransaction",
+	Category:  "Swaps",
+	Usage:     "Bump the fee of a pending claim or refund transaction",
+	ArgsUsage: "txid",
+	Action:    requireNArgs(1, bumpTransaction),
+	Flags: []cli.Flag{
+		&cli.Float64Flag{
+			Name:  "sat-per-vbyte",
+			Usage: "Fee rate of the bumped transaction. Defaults to the current fee estimation",
+		},
+	},
+}
+
+func bumpTransaction(ctx *cli.Context) error {
+	client := getClient(ctx)
+	request := &boltzrpc.BumpTransactionRequest{TxId: ctx.Args().First()}
+	if ctx.IsSet("sat-per-vbyte") {
+		satPerVbyte := ctx.Float64("sat-per-vbyte")
+		request.SatPerVbyte = &satPerVbyte
+	}
+	response, err := client.BumpTransaction(request)
+	if err != nil {
+		return err
+	}
+	fmt.Println("Bumped Transaction: " + response.TxId)
+	return nil
+}
+
 var createReverseSwapCommand = &cli.Command{
 	Name:      "createreverseswap",
 	Category:  "Swaps",
@@ -902,9 +1301,11 @@ var createReverseSwapCommand = &cli.Command{
 		"create a reverse swap for 100000 satoshis that will be sent to the specified btc address:\n" +
 		"> boltzcli createreverseswap 100000 bcrt1qkp70ncua3dqp6syqu24jw5mnpf3gdxqrm3gn2a\n" +
 		"create a reverse swap for 100000 satoshis that will be sent to the clients liquid wallet:\n" +
-		"> boltzcli createreverseswap --currency LBTC 100000",
+		"> boltzcli createreverseswap --currency LBTC 100000\n" +
+		"create a reverse swap for which exactly 100000 satoshis arrive in the clients btc wallet:\n" +
+		"> boltzcli createreverseswap --onchain 100000",
 	Action: requireNArgs(1, createReverseSwap),
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		jsonFlag,
 		currencyFlag,
 		liquidFlag,
@@ -917,10 +1318,142 @@ var createReverseSwapCommand = &cli.Command{
 			Name:  "external-pay",
 			Usage: "Do not automatically pay the swap from the connected lightning node",
 		},
+		&cli.BoolFlag{
+			Name:  "onchain",
+			Usage: "Receive exactly the given amount onchain after all fees instead of paying an invoice of that amount",
+		},
 		&cli.StringSliceFlag{
 			Name: "chan-id",
 		},
-	},
+	}, append(feePolicyFlags, labelFlags...)...),
+}
+
+var createChainSwapCommand = &cli.Command{
+	Name:      "createchainswap",
+	Category:  "Swaps",
+	Usage:     "Create a new chain swap",
+	ArgsUsage: "amount",
+	Description: "Creates a new chain swap (onchain -> onchain) for `amount` satoshis between the --from and --to currencies.\n" +
+		"If no destination address is specified, it will be generated by the clients wallet.\n" +
+		"\nExamples\n" +
+		"create a chain swap for 100000 satoshis from btc to the clients liquid wallet, paid from the btc wallet named \"main\":\n" +
+		"> boltzcli createchainswap --from BTC --to LBTC --from-wallet main 100000\n" +
+		"create a chain swap for 100000 satoshis from liquid to the specified btc address:\n" +
+		"> boltzcli createchainswap --from LBTC --to BTC --to-address bcrt1qkp70ncua3dqp6syqu24jw5mnpf3gdxqrm3gn2a 100000",
+	Action: requireNArgs(1, createChainSwap),
+	Flags: append([]cli.Flag{
+		jsonFlag,
+		&cli.StringFlag{
+			Name:  "from",
+			Usage: "Currency to send",
+			Value: "btc",
+		},
+		&cli.StringFlag{
+			Name:  "to",
+			Usage: "Currency to receive",
+			Value: "lbtc",
+		},
+		&cli.StringFlag{
+			Name:  "from-wallet",
+			Usage: "Internal wallet to pay the lockup from. The lockup address has to be paid manually if not set",
+		},
+		&cli.StringFlag{
+			Name:  "to-wallet",
+			Usage: "Internal wallet to receive the coins in",
+		},
+		&cli.StringFlag{
+			Name:  "to-address",
+			Usage: "Address to receive the coins on",
+		},
+		&cli.StringFlag{
+			Name:  "refund",
+			Usage: "Address to refund to in case the swap fails",
+		},
+		&cli.BoolFlag{
+			Name:  "zero-conf",
+			Usage: "Claim the coins as soon as the lockup transaction of boltz is in the mempool",
+		},
+	}, labelFlags...),
+}
+
+func createChainSwap(ctx *cli.Context) error {
+	client := getClient(ctx)
+
+	from, err := parseCurrency(ctx.String("from"))
+	if err != nil {
+		return err
+	}
+	to, err := parseCurrency(ctx.String("to"))
+	if err != nil {
+		return err
+	}
+
+	pair := &boltzrpc.Pair{
+		From: from,
+		To:   to,
+	}
+
+	amount := parseInt64(ctx.Args().First(), "amount")
+	json := ctx.Bool("json")
+
+	if !json {
+		chainPair, err := client.GetChainPair(pair)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("You will receive the coins on the other chain")
+		fmt.Println("The fees for this service are:")
+		fmt.Println("  - Service fee: " + formatPercentageFee(chainPair.Fees.Percentage) + "%")
+		fmt.Println("  - Boltz lockup fee: " + utils.Satoshis(int(chainPair.Fees.MinerFees.Server)))
+		fmt.Println("  - Claim fee: " + utils.Satoshis(int(chainPair.Fees.MinerFees.User.Claim)))
+		fmt.Println()
+
+		if !prompt("Do you want to continue?") {
+			return nil
+		}
+	}
+
+	request := &boltzrpc.CreateChainSwapRequest{
+		Amount: uint64(amount),
+		Pair:   pair,
+	}
+	if fromWallet := ctx.String("from-wallet"); fromWallet != "" {
+		request.FromWallet = &fromWallet
+	}
+	if toWallet := ctx.String("to-wallet"); toWallet != "" {
+		request.ToWallet = &toWallet
+	}
+	if toAddress := ctx.String("to-address"); toAddress != "" {
+		request.ToAddress = &toAddress
+	}
+	if refundAddress := ctx.String("refund"); refundAddress != "" {
+		request.RefundAddress = &refundAddress
+	}
+	if zeroConf := ctx.Bool("zero-conf"); zeroConf {
+		request.AcceptZeroConf = &zeroConf
+	}
+	request.Label, request.ExternalId = getLabel(ctx)
+
+	swap, err := client.CreateChainSwap(request)
+	if err != nil {
+		return err
+	}
+
+	if json {
+		printJson(swap)
+		return nil
+	}
+
+	if request.FromWallet == nil {
+		fmt.Printf(
+			"Please deposit %s into %s before block height %d\n",
+			utils.Satoshis(int(swap.FromData.Amount)), swap.FromData.LockupAddress, swap.FromData.TimeoutBlockHeight,
+		)
+		fmt.Println()
+	}
+
+	return swapInfoStream(ctx, swap.Id, false)
 }
 
 func parseCurrency(currency string) (boltzrpc.Currency, error) {
@@ -929,8 +1462,10 @@ func parseCurrency(currency string) (boltzrpc.Currency, error) {
 		return boltzrpc.Currency_LBTC, nil
 	} else if upper == "BTC" {
 		return boltzrpc.Currency_BTC, nil
+	} else if upper == "RBTC" {
+		return boltzrpc.Currency_RBTC, nil
 	}
-	return boltzrpc.Currency_BTC, fmt.Errorf("invalid currency: %s, allowed values: BTC, LBTC", currency)
+	return boltzrpc.Currency_BTC, fmt.Errorf("invalid currency: %s, allowed values: BTC, LBTC, RBTC", currency)
 }
 
 func getCurrency(ctx *cli.Context) (boltzrpc.Currency, error) {
@@ -958,11 +1493,14 @@ func createReverseSwap(ctx *cli.Context) error {
 	amount := parseInt64(ctx.Args().First(), "amount")
 	json := ctx.Bool("json")
 
+	var pairHash *string
 	if !json {
 		reversePair, err := client.GetReversePair(pair)
 		if err != nil {
 			return err
 		}
+		// only create the swap with the fees that are shown
+		pairHash = &reversePair.Hash
 
 		fmt.Println("You will receive the withdrawal to the specified onchain address")
 		fmt.Println("The fees for this service are:")
@@ -980,16 +1518,24 @@ func createReverseSwap(ctx *cli.Context) error {
 	returnImmediately := true
 	request := &boltzrpc.CreateReverseSwapRequest{
 		Address:           address,
-		Amount:            amount,
 		AcceptZeroConf:    !ctx.Bool("no-zero-conf"),
 		Pair:              pair,
 		Wallet:            &wallet,
 		ChanIds:           ctx.StringSlice("chan-id"),
 		ReturnImmediately: &returnImmediately,
+		FeePolicy:         getFeePolicy(ctx),
+		PairHash:          pairHash,
 	}
+	request.Label, request.ExternalId = getLabel(ctx)
 	if externalPay := ctx.Bool("external-pay"); externalPay {
 		request.ExternalPay = &externalPay
 	}
+	if ctx.Bool("onchain") {
+		onchainAmount := uint64(amount)
+		request.OnchainAmount = &onchainAmount
+	} else {
+		request.Amount = amount
+	}
 
 	response, err := client.CreateReverseSwap(request)
 	if err != nil {
@@ -1463,3 +2009,79 @@ var stopCommand = &cli.Command{
 		return client.Stop()
 	},
 }
+
+func askBackupPassword(message string) (string, error) {
+	prompt := survey.Password{Message: message}
+	var password string
+	if err := survey.AskOne(&prompt, &password, survey.WithValidator(survey.Required)); err != nil {
+		return "", err
+	}
+	return password, nil
+}
+
+var backupCommand = &cli.Command{
+	Name:      "backup",
+	Usage:     "Backs up the database of the daemon",
+	ArgsUsage: "[path]",
+	Description: "Writes a snapshot of the database to a file on the machine of the daemon.\n" +
+		"The file is created in the backup directory of the daemon if no path is given.",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "encrypt",
+			Usage: "Encrypt the backup with a password",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		client := getClient(ctx)
+		request := &boltzrpc.BackupDatabaseRequest{}
+		if path := ctx.Args().First(); path != "" {
+			request.Path = &path
+		}
+		if ctx.Bool("encrypt") {
+			password, err := askBackupPassword("Enter a password for the backup:")
+			if err != nil {
+				return err
+			}
+			request.Password = &password
+		}
+		response, err := client.BackupDatabase(request)
+		if err != nil {
+			return err
+		}
+		fmt.Println("Database backed up to " + response.Path)
+		return nil
+	},
+}
+
+var restoreCommand = &cli.Command{
+	Name:      "restore",
+	Usage:     "Restores the database of the daemon from a backup",
+	ArgsUsage: "path",
+	Description: "Replaces the database with a backup on the machine of the daemon.\n" +
+		"The daemon shuts down afterwards and uses the restored database once it is started again.",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "encrypted",
+			Usage: "Whether the backup is encrypted",
+		},
+	},
+	Action: requireNArgs(1, func(ctx *cli.Context) error {
+		client := getClient(ctx)
+		request := &boltzrpc.RestoreDatabaseRequest{Path: ctx.Args().First()}
+		if ctx.Bool("encrypted") {
+			password, err := askBackupPassword("Enter the password of the backup:")
+			if err != nil {
+				return err
+			}
+			request.Password = &password
+		}
+		if !prompt("The current database will be replaced and the daemon shut down. Do you want to continue?") {
+			return nil
+		}
+		if err := client.RestoreDatabase(request); err != nil {
+			return err
+		}
+		fmt.Println("Database restored, start the daemon again to use it")
+		return nil
+	}),
+}
@@ -1,19 +1,27 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/BoltzExchange/boltz-client/bitcoind"
 	"github.com/BoltzExchange/boltz-client/boltz"
 	"github.com/BoltzExchange/boltz-client/config"
 	"github.com/BoltzExchange/boltz-client/electrum"
 	"github.com/BoltzExchange/boltz-client/lightning"
 	"github.com/BoltzExchange/boltz-client/logger"
 	"github.com/BoltzExchange/boltz-client/mempool"
+	"github.com/BoltzExchange/boltz-client/nursery"
 	"github.com/BoltzExchange/boltz-client/onchain"
 	"github.com/BoltzExchange/boltz-client/onchain/wallet"
+	"github.com/BoltzExchange/boltz-client/proxy"
+	"github.com/BoltzExchange/boltz-client/rootstock"
 	"github.com/BoltzExchange/boltz-client/utils"
 )
 
@@ -59,6 +67,17 @@ func Init(cfg *config.Config) {
 		logger.Fatal("Could not connect to database: " + err.Error())
 	}
 
+	go cfg.Database.RunBackups()
+
+	socksProxy, err := proxy.New(cfg.Proxy)
+	if err != nil {
+		logger.Fatal("Could not parse proxy: " + err.Error())
+	}
+	if socksProxy != nil {
+		logger.Info("Routing outbound connections through SOCKS5 proxy: " + socksProxy.Address())
+	}
+	cfg.Boltz.SetProxy(socksProxy)
+
 	if !cfg.Standalone {
 		setLightningNode(cfg)
 
@@ -100,14 +119,26 @@ func Init(cfg *config.Config) {
 
 	checkBoltzVersion(cfg.Boltz)
 
-	onchain, err := initOnchain(cfg, network)
+	onchain, err := initOnchain(cfg, network, socksProxy)
 	if err != nil {
 		logger.Fatalf("could not init onchain: %v", err)
 	}
 
 	autoSwapConfPath := path.Join(cfg.DataDir, "autoswap.toml")
 
-	err = cfg.RPC.Init(network, cfg.Lightning, cfg.Boltz, cfg.Database, onchain, autoSwapConfPath)
+	timeoutWarnings, err := parseTimeoutWarnings(cfg.TimeoutWarnings)
+	if err != nil {
+		logger.Fatalf("Could not parse timeout warnings: %v", err)
+	}
+
+	nurseryConfig := nursery.Config{
+		ClaimBatchWindow: time.Duration(cfg.ClaimBatchWindow) * time.Second,
+		TimeoutWarnings:  timeoutWarnings,
+
+		HealthCheckInterval: boltzHealthInterval,
+	}
+
+	err = cfg.RPC.Init(network, cfg.Lightning, cfg.Boltz, cfg.Database, onchain, autoSwapConfPath, nurseryConfig)
 
 	if err != nil {
 		logger.Fatalf("Could not initialize Server: %v", err)
@@ -161,14 +192,17 @@ func setBoltzEndpoint(boltzCfg *boltz.Boltz, network *boltz.Network) {
 	logger.Info("Using default Boltz endpoint for network " + network.Name + ": " + boltzCfg.URL)
 }
 
-func initOnchain(cfg *config.Config, network *boltz.Network) (*onchain.Onchain, error) {
+func initOnchain(cfg *config.Config, network *boltz.Network, socksProxy *proxy.Proxy) (*onchain.Onchain, error) {
+	btcTxProviders := map[string]onchain.TxProvider{
+		"boltz": onchain.NewBoltzTxProvider(cfg.Boltz, boltz.CurrencyBtc),
+	}
+	liquidTxProviders := map[string]onchain.TxProvider{
+		"boltz": onchain.NewBoltzTxProvider(cfg.Boltz, boltz.CurrencyLiquid),
+	}
+
 	onchain := &onchain.Onchain{
-		Btc: &onchain.Currency{
-			Tx: onchain.NewBoltzTxProvider(cfg.Boltz, boltz.CurrencyBtc),
-		},
-		Liquid: &onchain.Currency{
-			Tx: onchain.NewBoltzTxProvider(cfg.Boltz, boltz.CurrencyLiquid),
-		},
+		Btc:     &onchain.Currency{},
+		Liquid:  &onchain.Currency{},
 		Network: network,
 	}
 
@@ -183,6 +217,7 @@ func initOnchain(cfg *config.Config, network *boltz.Network) (*onchain.Onchain,
 			DataDir: cfg.DataDir,
 			Network: network,
 			Debug:   false,
+			Proxy:   socksProxy,
 		})
 		if err != nil {
 			return nil, fmt.Errorf("could not init wallet: %v", err)
@@ -191,21 +226,23 @@ func initOnchain(cfg *config.Config, network *boltz.Network) (*onchain.Onchain,
 
 	if cfg.ElectrumUrl != "" {
 		logger.Info("Using configured Electrum RPC: " + cfg.ElectrumUrl)
-		client, err := electrum.NewClient(cfg.ElectrumUrl, cfg.ElectrumSSL)
+		client, err := electrum.NewClient(cfg.ElectrumUrl, cfg.ElectrumSSL, socksProxy)
 		if err != nil {
 			return nil, fmt.Errorf("could not connect to electrum: %v", err)
 		}
 		onchain.Btc.Fees = client
 		onchain.Btc.Listener = client
+		btcTxProviders["electrum"] = client
 	}
 	if cfg.ElectrumLiquidUrl != "" {
 		logger.Info("Using configured Electrum Liquid RPC: " + cfg.ElectrumLiquidUrl)
-		client, err := electrum.NewClient(cfg.ElectrumLiquidUrl, cfg.ElectrumLiquiLiquidSSL)
+		client, err := electrum.NewClient(cfg.ElectrumLiquidUrl, cfg.ElectrumLiquiLiquidSSL, socksProxy)
 		if err != nil {
 			return nil, fmt.Errorf("could not connect to electrum: %v", err)
 		}
 		onchain.Liquid.Fees = client
 		onchain.Liquid.Listener = client
+		liquidTxProviders["electrum"] = client
 	}
 	if network == boltz.MainNet {
 		cfg.MempoolApi = "https://mempool.space/api"
@@ -217,17 +254,126 @@ func initOnchain(cfg *config.Config, network *boltz.Network) (*onchain.Onchain,
 
 	if cfg.MempoolApi != "" {
 		logger.Info("mempool.space API: " + cfg.MempoolApi)
-		mempoolBtc := mempool.InitClient(cfg.MempoolApi)
+		mempoolBtc := mempool.InitClient(cfg.MempoolApi, socksProxy)
 		onchain.Btc.Fees = mempoolBtc
 		onchain.Btc.Listener = mempoolBtc
+		btcTxProviders["mempool"] = mempoolBtc
 	}
 
 	if cfg.MempoolLiquidApi != "" {
 		logger.Info("liquid.network API: " + cfg.MempoolLiquidApi)
-		mempoolLiquid := mempool.InitClient(cfg.MempoolLiquidApi)
+		mempoolLiquid := mempool.InitClient(cfg.MempoolLiquidApi, socksProxy)
 		onchain.Liquid.Fees = mempoolLiquid
 		onchain.Liquid.Listener = mempoolLiquid
+		liquidTxProviders["mempool"] = mempoolLiquid
+	}
+
+	// configured after the public apis so that the own node takes precedence
+	if cfg.BitcoindUrl != "" {
+		logger.Info("Using configured bitcoind RPC: " + cfg.BitcoindUrl)
+		client := bitcoind.NewClient(cfg.BitcoindUrl, cfg.BitcoindUser, cfg.BitcoindPassword)
+		onchain.Btc.Fees = client
+		onchain.Btc.Listener = client
+		btcTxProviders["node"] = client
+	}
+	if cfg.ElementsdUrl != "" {
+		logger.Info("Using configured elementsd RPC: " + cfg.ElementsdUrl)
+		client := bitcoind.NewClient(cfg.ElementsdUrl, cfg.ElementsdUser, cfg.ElementsdPassword)
+		onchain.Liquid.Fees = client
+		onchain.Liquid.Listener = client
+		liquidTxProviders["node"] = client
+	}
+
+	if cfg.RootstockUrl != "" {
+		logger.Info("Using configured rootstock RPC: " + cfg.RootstockUrl)
+		rootstockWallet, rootstockCurrency, err := initRootstock(cfg, socksProxy)
+		if err != nil {
+			return nil, fmt.Errorf("could not init rootstock: %v", err)
+		}
+		onchain.Rootstock = rootstockCurrency
+		onchain.AddWallet(rootstockWallet)
+	}
+
+	var err error
+	onchain.Btc.Tx, err = sortTxProviders(boltz.CurrencyBtc, cfg.TxProviders, btcTxProviders)
+	if err != nil {
+		return nil, err
+	}
+	onchain.Liquid.Tx, err = sortTxProviders(boltz.CurrencyLiquid, cfg.TxProviders, liquidTxProviders)
+	if err != nil {
+		return nil, err
 	}
 
 	return onchain, nil
 }
+
+func initRootstock(cfg *config.Config, socksProxy *proxy.Proxy) (*rootstock.Wallet, *onchain.Currency, error) {
+	contracts, err := cfg.Boltz.GetContracts(boltz.CurrencyRootstock)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get contracts from boltz: %w", err)
+	}
+	etherSwap, err := rootstock.ParseAddress(contracts.SwapContracts.EtherSwap)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid EtherSwap contract: %w", err)
+	}
+	client := rootstock.NewClient(cfg.RootstockUrl, socksProxy.HTTPClient("rootstock"))
+	// the key of the wallet is loaded from the database on unlock
+	wallet, err := rootstock.NewWallet(client, etherSwap, contracts.Network.ChainId)
+	if err != nil {
+		return nil, nil, err
+	}
+	logger.Infof("Using EtherSwap contract %s", etherSwap)
+	return wallet, &onchain.Currency{Listener: client, Fees: client, Tx: client}, nil
+}
+
+func parseTimeoutWarnings(thresholds string) ([]uint32, error) {
+	var result []uint32
+	for _, threshold := range strings.Split(thresholds, ",") {
+		threshold = strings.TrimSpace(threshold)
+		if threshold == "" {
+			continue
+		}
+		blocks, err := strconv.ParseUint(threshold, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block count %s: %w", threshold, err)
+		}
+		result = append(result, uint32(blocks))
+	}
+	return result, nil
+}
+
+const boltzHealthInterval = time.Minute
+
+var txProviderNames = []string{"node", "boltz", "electrum", "mempool"}
+
+func sortTxProviders(currency boltz.Currency, priority string, available map[string]onchain.TxProvider) (onchain.FallbackTxProvider, error) {
+	var providers onchain.FallbackTxProvider
+	var names []string
+	add := func(name string) {
+		if provider, ok := available[name]; ok && !slices.Contains(names, name) {
+			providers = append(providers, onchain.NamedTxProvider{TxProvider: provider, Name: name})
+			names = append(names, name)
+		}
+	}
+	for _, name := range strings.Split(priority, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if !slices.Contains(txProviderNames, name) {
+			return nil, fmt.Errorf("unknown tx provider %s, allowed values: %s", name, strings.Join(txProviderNames, ", "))
+		}
+		add(name)
+	}
+	if len(providers) == 0 {
+		// none of the preferred providers is configured for this currency, so the other ones have to be used
+		for _, name := range txProviderNames {
+			add(name)
+		}
+		if len(providers) == 0 {
+			return nil, errors.New("no tx provider configured")
+		}
+		logger.Warnf("None of the tx providers %s is configured for %s, falling back to %s", priority, currency, strings.Join(names, ", "))
+	}
+	return providers, nil
+}
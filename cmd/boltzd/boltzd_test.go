@@ -415,7 +415,7 @@ func TestSwap(t *testing.T) {
 
 		t.Run("Valid", func(t *testing.T) {
 			node := cfg.LND
-			invoice, err := node.CreateInvoice(100000, nil, 0, "test")
+			invoice, err := node.CreateInvoice(100000, nil, 0, "test", nil)
 			require.NoError(t, err)
 			swap, err := client.CreateSwap(&boltzrpc.CreateSwapRequest{
 				Invoice:          &invoice.PaymentRequest,
@@ -450,7 +450,7 @@ func TestSwap(t *testing.T) {
 		_, err = client.CreateSwap(request)
 		require.Error(t, err)
 
-		invoice, err := node.CreateInvoice(100000, nil, 0, "test")
+		invoice, err := node.CreateInvoice(100000, nil, 0, "test", nil)
 		require.NoError(t, err)
 		request.Invoice = &invoice.PaymentRequest
 		swap, err := client.CreateSwap(request)
@@ -928,7 +928,7 @@ func TestAutoSwap(t *testing.T) {
 					return
 				}
 
-				response, err := to.CreateInvoice(int64(amount), nil, 100000, "Testt")
+				response, err := to.CreateInvoice(int64(amount), nil, 100000, "Testt", nil)
 				require.NoError(t, err)
 				_, err = from.PayInvoice(response.PaymentRequest, 10000, 30, []lightning.ChanId{channel.Id})
 				require.NoError(t, err)
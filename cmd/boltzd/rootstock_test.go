@@ -0,0 +1,170 @@
+//go:build !unit
+
+package main
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/boltzrpc/client"
+	"github.com/BoltzExchange/boltz-client/config"
+	"github.com/BoltzExchange/boltz-client/rootstock"
+	"github.com/BoltzExchange/boltz-client/test"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+const anvilUrl = "http://localhost:8545"
+
+// fourth development account of anvil, which is funded on startup and not used by boltz or the rootstock tests
+const anvilKey = "7c852118294e51e653712a81e05800f419141751be58f605c371e15141b007a6"
+
+const receiptTimeout = 30 * time.Second
+
+var pairRbtc = &boltzrpc.Pair{
+	From: boltzrpc.Currency_RBTC,
+	To:   boltzrpc.Currency_BTC,
+}
+
+// mineRootstock makes anvil mine the given amount of blocks
+func mineRootstock(t *testing.T, blocks uint32) {
+	body, err := json.Marshal(map[string]any{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  "anvil_mine",
+		"params":  []string{fmt.Sprintf("0x%x", blocks)},
+	})
+	require.NoError(t, err)
+	res, err := http.Post(anvilUrl, "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+// fundRootstock sends amount satoshis from a development account of anvil to address
+func fundRootstock(t *testing.T, cfg *config.Config, address rootstock.Address, amount uint64) {
+	contracts, err := cfg.Boltz.GetContracts(boltz.CurrencyRootstock)
+	require.NoError(t, err)
+	etherSwap, err := rootstock.ParseAddress(contracts.SwapContracts.EtherSwap)
+	require.NoError(t, err)
+	funder, err := rootstock.NewWallet(rootstock.NewClient(anvilUrl, nil), etherSwap, contracts.Network.ChainId)
+	require.NoError(t, err)
+
+	decoded, err := hex.DecodeString(anvilKey)
+	require.NoError(t, err)
+	key, _ := btcec.PrivKeyFromBytes(decoded)
+	funder.Unlock(key)
+
+	txId, err := funder.SendToAddress(address.Hex(), amount, 0)
+	require.NoError(t, err)
+	_, err = funder.WaitForReceipt(txId, receiptTimeout)
+	require.NoError(t, err)
+}
+
+// setupRootstock starts the daemon with a funded rootstock wallet
+func setupRootstock(t *testing.T) (client.Boltz, func()) {
+	cfg := loadConfig(t)
+	cfg.RootstockUrl = anvilUrl
+	boltzClient, _, stop := setup(t, cfg, "")
+
+	// the key of the rootstock wallet is created when the daemon is unlocked
+	key, err := cfg.Database.QueryRootstockKey()
+	require.NoError(t, err)
+	require.NotNil(t, key)
+	fundRootstock(t, cfg, rootstock.AddressFromKey(key.PubKey()), 10_000_000)
+
+	return boltzClient, stop
+}
+
+// holdInvoice creates an invoice on the lightning node of the daemon which is only settled or canceled manually
+func holdInvoice(t *testing.T, amount uint64) (string, string) {
+	preimage := make([]byte, 32)
+	_, err := rand.Read(preimage)
+	require.NoError(t, err)
+	hash := sha256.Sum256(preimage)
+	paymentHash := hex.EncodeToString(hash[:])
+
+	var invoice struct {
+		PaymentRequest string `json:"payment_request"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(test.LnCli(fmt.Sprintf("addholdinvoice %s --amt %d", paymentHash, amount))), &invoice))
+	return invoice.PaymentRequest, paymentHash
+}
+
+func TestRootstock(t *testing.T) {
+	client, stop := setupRootstock(t)
+	defer stop()
+
+	t.Run("Swap", func(t *testing.T) {
+		swap, err := client.CreateSwap(&boltzrpc.CreateSwapRequest{
+			Amount:           100000,
+			Pair:             pairRbtc,
+			SendFromInternal: true,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, swap.TxId)
+		require.NotZero(t, swap.TimeoutBlockHeight)
+
+		next := swapStream(t, client, swap.Id)
+		info := next(boltzrpc.SwapState_SUCCESSFUL).Swap
+		require.Equal(t, swap.TxId, info.LockupTransactionId)
+		require.Equal(t, rootstock.WalletName, info.GetWallet())
+		require.NotZero(t, info.GetOnchainFee())
+	})
+
+	t.Run("Refund", func(t *testing.T) {
+		invoice, paymentHash := holdInvoice(t, 100000)
+		swap, err := client.CreateSwap(&boltzrpc.CreateSwapRequest{
+			Invoice:          &invoice,
+			Pair:             pairRbtc,
+			SendFromInternal: true,
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, swap.TxId)
+
+		next := swapStream(t, client, swap.Id)
+		// boltz fails to pay the invoice once it is canceled
+		test.LnCli("cancelinvoice " + paymentHash)
+		next(boltzrpc.SwapState_ERROR)
+
+		// there are no cooperative refunds of EVM lockups, so the timeout has to be reached
+		node := rootstock.NewClient(anvilUrl, nil)
+		height, err := node.GetBlockHeight()
+		require.NoError(t, err)
+		require.Greater(t, swap.TimeoutBlockHeight, height)
+		mineRootstock(t, swap.TimeoutBlockHeight-height)
+
+		info := next(boltzrpc.SwapState_REFUNDED).Swap
+		require.NotEmpty(t, info.RefundTransactionId)
+		require.False(t, info.GetRefundCooperative())
+
+		receipt, err := node.WaitForReceipt(info.RefundTransactionId, receiptTimeout)
+		require.NoError(t, err)
+		require.NotNil(t, receipt)
+	})
+
+	t.Run("ReverseSwap", func(t *testing.T) {
+		returnImmediately := true
+		swap, err := client.CreateReverseSwap(&boltzrpc.CreateReverseSwapRequest{
+			Amount:            100000,
+			Pair:              &boltzrpc.Pair{From: boltzrpc.Currency_BTC, To: boltzrpc.Currency_RBTC},
+			AcceptZeroConf:    true,
+			ReturnImmediately: &returnImmediately,
+		})
+		require.NoError(t, err)
+
+		next := swapStream(t, client, swap.Id)
+		info := next(boltzrpc.SwapState_SUCCESSFUL).ReverseSwap
+		require.NotEmpty(t, info.ClaimTransactionId)
+		require.NotZero(t, info.GetOnchainFee())
+	})
+}
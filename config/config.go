@@ -6,6 +6,7 @@ import (
 	"path"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	"github.com/jessevdk/go-flags"
@@ -56,6 +57,24 @@ type Config struct {
 	ElectrumLiquidUrl      string `long:"electrum-liquid" description:"electrum rpc to use for fee estimations; set to empty string to disable"`
 	ElectrumLiquiLiquidSSL bool   `long:"electrum-liquid-ssl" description:"whether the electrum server uses ssl"`
 
+	Proxy string `long:"proxy" description:"Address (host:port) of a SOCKS5 proxy, like the one of Tor, through which connections to Boltz, mempool.space, electrum servers, the rootstock node and the servers of the wallets are made; every service uses a separate circuit. bitcoind, elementsd and the lightning node are still connected to directly"`
+
+	BitcoindUrl      string `long:"bitcoind" description:"JSON-RPC url of a bitcoind node to use for blocks, fee estimations and transactions; set to empty string to disable"`
+	BitcoindUser     string `long:"bitcoind-user" description:"JSON-RPC user of the bitcoind node"`
+	BitcoindPassword string `long:"bitcoind-password" description:"JSON-RPC password of the bitcoind node" json:"-"`
+
+	ElementsdUrl      string `long:"elementsd" description:"JSON-RPC url of an elementsd node to use for blocks, fee estimations and transactions; set to empty string to disable"`
+	ElementsdUser     string `long:"elementsd-user" description:"JSON-RPC user of the elementsd node"`
+	ElementsdPassword string `long:"elementsd-password" description:"JSON-RPC password of the elementsd node" json:"-"`
+
+	RootstockUrl string `long:"rootstock" description:"JSON-RPC url of a rootstock node to enable swaps of RBTC; the key of the wallet is kept in the database and loaded on unlock; set to empty string to disable"`
+
+	ClaimBatchWindow uint64 `long:"claim-batch-window" description:"Seconds to wait for more confirmed Reverse Swaps with the same currency and claim address before claiming them in a single transaction; 0 claims every Reverse Swap on its own"`
+
+	TimeoutWarnings string `long:"timeout-warnings" description:"Comma separated list of block counts left until the timeout of a pending swap at which a warning is logged and sent to swap info streams; set to empty string to disable"`
+
+	TxProviders string `long:"tx-providers" description:"Comma separated list of providers (node, boltz, mempool, electrum) used to fetch and broadcast transactions in order of priority. node refers to the configured bitcoind or elementsd. Unconfigured providers are skipped; if none of them is configured for a currency, the other available providers are used"`
+
 	Help *helpOptions `group:"Help Options"`
 }
 
@@ -107,8 +126,14 @@ func LoadConfig(dataDir string) (*Config, error) {
 		},
 
 		Database: &database.Database{
-			Path: "",
+			Path:           "",
+			BackupInterval: 24 * time.Hour,
+			BackupKeep:     7,
 		},
+
+		TimeoutWarnings: "36,6",
+
+		TxProviders: "node",
 	}
 
 	parser := flags.NewParser(&cfg, flags.IgnoreUnknown)
@@ -187,6 +212,7 @@ func LoadConfig(dataDir string) (*Config, error) {
 
 	cfg.LogFile = utils.ExpandDefaultPath(cfg.DataDir, cfg.LogFile, "boltz.log")
 	cfg.Database.Path = utils.ExpandDefaultPath(cfg.DataDir, cfg.Database.Path, "boltz.db")
+	cfg.Database.BackupDir = utils.ExpandHomeDir(cfg.Database.BackupDir)
 
 	cfg.RPC.TlsKeyPath = utils.ExpandDefaultPath(cfg.DataDir, cfg.RPC.TlsKeyPath, "tls.key")
 	cfg.RPC.TlsCertPath = utils.ExpandDefaultPath(cfg.DataDir, cfg.RPC.TlsCertPath, "tls.cert")
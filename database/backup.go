@@ -0,0 +1,360 @@
+package database
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/logger"
+	"github.com/BoltzExchange/boltz-client/onchain/wallet"
+)
+
+// encrypted backups start with this line, followed by the hex encoded salt in the second line and the ciphertext
+const encryptedBackupHeader = "boltz-client encrypted backup\n"
+
+const backupFilePrefix = "boltz-"
+
+// the files of a restore are suffixed with this date format, so that a second restore doesn't replace the database kept by the first one
+const restoreSuffixFormat = "20060102T150405.000000000Z"
+
+var sqliteHeader = []byte("SQLite format 3\x00")
+
+var errPostgresBackup = errors.New("backups are only supported for SQLite databases; use pg_dump for PostgreSQL")
+
+// Backup writes a consistent snapshot of the database to the given path while the database stays in use.
+// The snapshot is encrypted if a password is set.
+func (database *Database) Backup(path string, password string) error {
+	if database.isPostgres() {
+		return errPostgresBackup
+	}
+	// existing files are never overwritten, since the path might point to anything
+	if _, err := os.Lstat(path); err == nil {
+		return fmt.Errorf("%s already exists", path)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	if password == "" {
+		return database.snapshot(path)
+	}
+
+	// the plain snapshot is written to a new directory, so that no existing file can be replaced by it
+	snapshotDir, err := os.MkdirTemp(filepath.Dir(path), ".boltz-backup-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(snapshotDir)
+	snapshotPath := filepath.Join(snapshotDir, "snapshot.db")
+	if err := database.snapshot(snapshotPath); err != nil {
+		return err
+	}
+
+	snapshot, err := os.ReadFile(snapshotPath)
+	if err != nil {
+		return err
+	}
+	salt, err := wallet.GenerateSalt()
+	if err != nil {
+		return err
+	}
+	encrypted, err := wallet.EncryptBytes(snapshot, password, salt)
+	if err != nil {
+		return err
+	}
+	content := append([]byte(encryptedBackupHeader+salt+"\n"), encrypted...)
+	return writeNewFile(path, content)
+}
+
+// writeNewFile writes content to a file which must not exist yet
+func writeNewFile(path string, content []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// snapshot writes a copy of the database to path; VACUUM INTO fails if the file exists already
+func (database *Database) snapshot(path string) error {
+	if _, err := database.Exec("VACUUM INTO ?", path); err != nil {
+		return fmt.Errorf("could not create snapshot: %w", err)
+	}
+	return nil
+}
+
+// readBackup returns the plain SQLite file of a backup
+func readBackup(path string, password string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(content, sqliteHeader) {
+		return content, nil
+	}
+	encrypted, found := bytes.CutPrefix(content, []byte(encryptedBackupHeader))
+	if !found {
+		return nil, errors.New("file is not a database backup")
+	}
+	if password == "" {
+		return nil, errors.New("backup is encrypted, but no password was provided")
+	}
+	salt, ciphertext, found := bytes.Cut(encrypted, []byte("\n"))
+	if !found {
+		return nil, errors.New("backup is missing its salt")
+	}
+	decrypted, err := wallet.DecryptBytes(ciphertext, password, string(salt))
+	if err != nil {
+		return nil, errors.New("could not decrypt backup: wrong password")
+	}
+	return decrypted, nil
+}
+
+// validateBackup makes sure that the database at the path is intact and can be migrated to the latest schema version
+func validateBackup(path string) error {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	backup := &Database{Path: path, db: db}
+	version, err := backup.queryVersion()
+	if err != nil {
+		return fmt.Errorf("could not query schema version of backup: %w", err)
+	}
+	if version < 1 || version > latestSchemaVersion {
+		return fmt.Errorf("backup has unsupported schema version %d", version)
+	}
+
+	var result string
+	if err := db.QueryRow("PRAGMA quick_check").Scan(&result); err != nil {
+		return err
+	}
+	if result != "ok" {
+		return fmt.Errorf("backup is corrupted: %s", result)
+	}
+	return nil
+}
+
+// CheckBackup returns an error if the backup can't be restored
+func (database *Database) CheckBackup(path string, password string) error {
+	if database.isPostgres() {
+		return errPostgresBackup
+	}
+	content, err := readBackup(path, password)
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp("", "boltz-backup-check-*.db")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	_, err = file.Write(content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return validateBackup(file.Name())
+}
+
+// Restore replaces the database with a backup. The previous database is kept next to it with a ".before-restore-<date>" suffix.
+// If the restore fails, the previous database is used again unless it can't be opened anymore; see Connected.
+func (database *Database) Restore(path string, password string) error {
+	return database.restore(path, password, time.Now())
+}
+
+func (database *Database) restore(path string, password string, now time.Time) error {
+	if database.isPostgres() {
+		return errPostgresBackup
+	}
+	content, err := readBackup(path, password)
+	if err != nil {
+		return err
+	}
+
+	suffix := now.UTC().Format(restoreSuffixFormat)
+	restorePath := database.Path + ".restore-" + suffix
+	if err := writeNewFile(restorePath, content); err != nil {
+		return err
+	}
+	defer os.Remove(restorePath)
+
+	if err := validateBackup(restorePath); err != nil {
+		return err
+	}
+
+	// the secrets of the previous database stay unlocked in case it has to be used again
+	secrets := database.secrets.Load()
+	previousPath := database.Path + ".before-restore-" + suffix
+	if err := database.replaceFile(restorePath, previousPath); err != nil {
+		return database.reopen(secrets, err)
+	}
+
+	// migrates the backup in case it was created with an older version
+	if err := database.Connect(); err != nil {
+		err = fmt.Errorf("could not open restored database: %w", err)
+		database.lock.Lock()
+		closeErr := database.close()
+		database.lock.Unlock()
+		if closeErr == nil {
+			closeErr = os.Rename(previousPath, database.Path)
+		}
+		if closeErr != nil {
+			return errors.Join(err, closeErr)
+		}
+		return database.reopen(secrets, err)
+	}
+
+	logger.Infof("Restored database from backup %s; the previous database was moved to %s", path, previousPath)
+	return nil
+}
+
+// replaceFile closes the database and moves the file at path in its place. The previous file is moved to previousPath.
+func (database *Database) replaceFile(path string, previousPath string) error {
+	database.lock.Lock()
+	defer database.lock.Unlock()
+
+	if _, err := os.Lstat(previousPath); err == nil {
+		return fmt.Errorf("%s already exists", previousPath)
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := database.close(); err != nil {
+		return err
+	}
+	if err := os.Rename(database.Path, previousPath); err != nil {
+		return err
+	}
+	if err := os.Rename(path, database.Path); err != nil {
+		return errors.Join(err, os.Rename(previousPath, database.Path))
+	}
+	return nil
+}
+
+// reopen connects to the previous database again after a restore failed
+func (database *Database) reopen(secrets *secretsState, cause error) error {
+	if err := database.Connect(); err != nil {
+		database.lock.Lock()
+		_ = database.close()
+		database.lock.Unlock()
+		return fmt.Errorf("%w; could not reopen previous database: %w", cause, err)
+	}
+	database.secrets.Store(secrets)
+	return cause
+}
+
+// closedDB stands in for the database while it is closed during a restore, so that queries fail instead of panicking
+var closedDB = func() *sql.DB {
+	db, _ := sql.Open(DriverSqlite, "")
+	_ = db.Close()
+	return db
+}()
+
+func (database *Database) conn() *sql.DB {
+	if database.db == nil {
+		return closedDB
+	}
+	return database.db
+}
+
+func (database *Database) close() error {
+	if database.db == nil {
+		return nil
+	}
+	err := database.db.Close()
+	database.db = nil
+	return err
+}
+
+// Connected returns whether the database is open, which it might not be anymore after a failed restore
+func (database *Database) Connected() bool {
+	database.lock.RLock()
+	defer database.lock.RUnlock()
+	return database.db != nil
+}
+
+func backupFileName(now time.Time, encrypted bool) string {
+	name := backupFilePrefix + now.UTC().Format("20060102T150405Z") + ".db"
+	if encrypted {
+		name += ".enc"
+	}
+	return name
+}
+
+// NewBackupPath returns the path of a new backup in the configured backup directory
+func (database *Database) NewBackupPath(encrypted bool) (string, error) {
+	if database.BackupDir == "" {
+		return "", errors.New("no backup directory configured")
+	}
+	return filepath.Join(database.BackupDir, backupFileName(time.Now(), encrypted)), nil
+}
+
+// pruneBackups deletes the oldest backups in the backup directory until only the configured amount is left
+func (database *Database) pruneBackups() error {
+	if database.BackupKeep <= 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(database.BackupDir)
+	if err != nil {
+		return err
+	}
+	var backups []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() && strings.HasPrefix(name, backupFilePrefix) && (strings.HasSuffix(name, ".db") || strings.HasSuffix(name, ".db.enc")) {
+			backups = append(backups, name)
+		}
+	}
+	// the names contain the date, so sorting them sorts the backups by age
+	sort.Strings(backups)
+	for len(backups) > database.BackupKeep {
+		if err := os.Remove(filepath.Join(database.BackupDir, backups[0])); err != nil {
+			return err
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
+
+// RunBackups creates a backup in the backup directory every backup interval
+func (database *Database) RunBackups() {
+	if database.BackupDir == "" || database.BackupInterval <= 0 {
+		return
+	}
+	if database.isPostgres() {
+		logger.Warn("Not backing up database: " + errPostgresBackup.Error())
+		return
+	}
+	logger.Infof("Backing up database to %s every %s", database.BackupDir, database.BackupInterval)
+
+	ticker := time.NewTicker(database.BackupInterval)
+	defer ticker.Stop()
+	for ; true; <-ticker.C {
+		path, err := database.NewBackupPath(database.BackupPassword != "")
+		if err == nil {
+			err = database.Backup(path, database.BackupPassword)
+		}
+		if err == nil {
+			logger.Debug("Backed up database to " + path)
+			err = database.pruneBackups()
+		}
+		if err != nil {
+			logger.Errorf("Could not back up database: %v", err)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package database
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackup(t *testing.T) {
+	dir := t.TempDir()
+	db := Database{Path: filepath.Join(dir, "boltz.db")}
+	require.NoError(t, db.Connect())
+
+	privateKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	swap := Swap{Id: "backedUp", Pair: boltz.PairBtc, PrivateKey: privateKey}
+	require.NoError(t, db.CreateSwap(swap))
+
+	tests := []struct {
+		desc     string
+		password string
+	}{
+		{"Plain", ""},
+		{"Encrypted", "password"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			path := filepath.Join(dir, "backups", tc.desc+".db")
+			require.NoError(t, db.Backup(path, tc.password))
+			require.NoError(t, db.CheckBackup(path, tc.password))
+
+			if tc.password != "" {
+				require.Error(t, db.CheckBackup(path, ""))
+				require.Error(t, db.CheckBackup(path, "wrong"))
+				require.Error(t, db.Restore(path, "wrong"))
+			}
+
+			_, err := db.Exec("DELETE FROM swaps WHERE id = ?", swap.Id)
+			require.NoError(t, err)
+			require.NoError(t, db.Restore(path, tc.password))
+
+			restored, err := db.QuerySwap(swap.Id)
+			require.NoError(t, err)
+			require.Equal(t, swap.Id, restored.Id)
+			previous, err := filepath.Glob(db.Path + ".before-restore-*")
+			require.NoError(t, err)
+			require.NotEmpty(t, previous)
+		})
+	}
+
+	t.Run("Existing", func(t *testing.T) {
+		path := filepath.Join(dir, "existing")
+		require.NoError(t, os.WriteFile(path, []byte("important"), 0600))
+
+		require.ErrorContains(t, db.Backup(path, ""), "already exists")
+		require.ErrorContains(t, db.Backup(path, "password"), "already exists")
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, "important", string(content))
+	})
+
+	t.Run("RestoreFailed", func(t *testing.T) {
+		path := filepath.Join(dir, "backups", "failed.db")
+		require.NoError(t, db.Backup(path, ""))
+
+		now := time.Now()
+		previousPath := db.Path + ".before-restore-" + now.UTC().Format(restoreSuffixFormat)
+		require.NoError(t, os.WriteFile(previousPath, []byte("important"), 0600))
+
+		require.ErrorContains(t, db.restore(path, "", now), "already exists")
+		require.True(t, db.Connected())
+		_, err := db.QuerySwap(swap.Id)
+		require.NoError(t, err)
+
+		content, err := os.ReadFile(previousPath)
+		require.NoError(t, err)
+		require.Equal(t, "important", string(content))
+
+		// the restored file is not written over an existing one either
+		now = now.Add(time.Second)
+		restorePath := db.Path + ".restore-" + now.UTC().Format(restoreSuffixFormat)
+		require.NoError(t, os.WriteFile(restorePath, []byte("important"), 0600))
+		require.Error(t, db.restore(path, "", now))
+		content, err = os.ReadFile(restorePath)
+		require.NoError(t, err)
+		require.Equal(t, "important", string(content))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.db")
+		require.NoError(t, os.WriteFile(path, []byte("not a backup"), 0600))
+		require.Error(t, db.CheckBackup(path, ""))
+		require.Error(t, db.Restore(path, ""))
+	})
+}
+
+func TestPruneBackups(t *testing.T) {
+	dir := t.TempDir()
+	db := Database{BackupDir: dir, BackupKeep: 2}
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var names []string
+	for i := 0; i < 4; i++ {
+		name := backupFileName(start.Add(time.Duration(i)*time.Hour), i%2 == 0)
+		names = append(names, name)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0600))
+	}
+	unrelated := filepath.Join(dir, "unrelated.db")
+	require.NoError(t, os.WriteFile(unrelated, nil, 0600))
+
+	require.NoError(t, db.pruneBackups())
+
+	for i, name := range names {
+		if i < 2 {
+			require.NoFileExists(t, filepath.Join(dir, name))
+		} else {
+			require.FileExists(t, filepath.Join(dir, name))
+		}
+	}
+	require.FileExists(t, unrelated)
+}
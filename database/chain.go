@@ -0,0 +1,533 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/utils"
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+type ChainSwap struct {
+	Id                string
+	Pair              boltz.Pair
+	State             boltzrpc.SwapState
+	Error             string
+	Status            boltz.SwapUpdateEvent
+	AcceptZeroConf    bool
+	Preimage          []byte
+	PaymentHash       []byte
+	IsAuto            bool
+	ServiceFee        *uint64
+	ServiceFeePercent utils.Percentage
+	OnchainFee        *uint64
+	CreatedAt         time.Time
+	FromData          *ChainSwapData
+	ToData            *ChainSwapData
+	// endpoint of the Boltz API the swap was created with; empty for swaps created before it was stored
+	BoltzUrl string
+	// free form description and reference in an external system set by the user; empty if not set
+	Label      string
+	ExternalId string
+}
+
+// ChainSwapData contains the details of one of the two lockups of a chain swap.
+// The lockup of FromData is made by us and refunded by us if the swap fails, the one of ToData is made by boltz and claimed by us.
+type ChainSwapData struct {
+	Id                  string
+	Currency            boltz.Currency
+	PrivateKey          *btcec.PrivateKey
+	TheirPublicKey      *btcec.PublicKey
+	BlindingKey         *btcec.PrivateKey
+	Tree                *boltz.SwapTree
+	Amount              uint64
+	TimeoutBlockHeight  uint32
+	LockupTransactionId string
+	// claim transaction of the lockup of boltz or refund transaction of our lockup
+	TransactionId string
+	// destination of the claim or refund transaction
+	Address       string
+	LockupAddress string
+	// wallet which was used to pay the lockup
+	Wallet string
+}
+
+type ChainSwapSerialized struct {
+	Id                string
+	From              string
+	To                string
+	State             string
+	Error             string
+	Status            string
+	AcceptZeroConf    bool
+	Preimage          string
+	IsAuto            bool
+	ServiceFee        *uint64
+	ServiceFeePercent utils.Percentage
+	OnchainFee        *uint64
+	FromData          *ChainSwapDataSerialized
+	ToData            *ChainSwapDataSerialized
+}
+
+type ChainSwapDataSerialized struct {
+	Id                  string
+	Currency            string
+	PrivateKey          string
+	TheirPublicKey      string
+	BlindingKey         string
+	SwapTree            string
+	Amount              uint64
+	TimeoutBlockHeight  uint32
+	LockupTransactionId string
+	TransactionId       string
+	Address             string
+	LockupAddress       string
+	Wallet              string
+}
+
+func (chainSwap *ChainSwap) Serialize() ChainSwapSerialized {
+	return ChainSwapSerialized{
+		Id:                chainSwap.Id,
+		From:              string(chainSwap.Pair.From),
+		To:                string(chainSwap.Pair.To),
+		State:             boltzrpc.SwapState_name[int32(chainSwap.State)],
+		Error:             chainSwap.Error,
+		Status:            chainSwap.Status.String(),
+		AcceptZeroConf:    chainSwap.AcceptZeroConf,
+		Preimage:          hex.EncodeToString(chainSwap.Preimage),
+		IsAuto:            chainSwap.IsAuto,
+		ServiceFee:        chainSwap.ServiceFee,
+		ServiceFeePercent: chainSwap.ServiceFeePercent,
+		OnchainFee:        chainSwap.OnchainFee,
+		FromData:          chainSwap.FromData.Serialize(),
+		ToData:            chainSwap.ToData.Serialize(),
+	}
+}
+
+func (data *ChainSwapData) Serialize() *ChainSwapDataSerialized {
+	if data == nil {
+		return nil
+	}
+	return &ChainSwapDataSerialized{
+		Id:                  data.Id,
+		Currency:            string(data.Currency),
+		PrivateKey:          formatPrivateKey(data.PrivateKey),
+		TheirPublicKey:      formatPublicKey(data.TheirPublicKey),
+		BlindingKey:         formatPrivateKey(data.BlindingKey),
+		SwapTree:            formatJson(data.Tree.Serialize()),
+		Amount:              data.Amount,
+		TimeoutBlockHeight:  data.TimeoutBlockHeight,
+		LockupTransactionId: data.LockupTransactionId,
+		TransactionId:       data.TransactionId,
+		Address:             data.Address,
+		LockupAddress:       data.LockupAddress,
+		Wallet:              data.Wallet,
+	}
+}
+
+// Redacted returns a copy without the preimage and the private and blinding keys of both sides, which may be logged
+func (chainSwap ChainSwapSerialized) Redacted() ChainSwapSerialized {
+	chainSwap.Preimage = ""
+	chainSwap.FromData = chainSwap.FromData.redacted()
+	chainSwap.ToData = chainSwap.ToData.redacted()
+	return chainSwap
+}
+
+func (data *ChainSwapDataSerialized) redacted() *ChainSwapDataSerialized {
+	if data == nil {
+		return nil
+	}
+	redacted := *data
+	redacted.PrivateKey = ""
+	redacted.BlindingKey = ""
+	return &redacted
+}
+
+func (data *ChainSwapData) InitTree() error {
+	return data.Tree.Init(
+		data.Currency == boltz.CurrencyLiquid,
+		data.PrivateKey,
+		data.TheirPublicKey,
+	)
+}
+
+func (data *ChainSwapData) BlindingPubKey() *btcec.PublicKey {
+	if data.BlindingKey == nil {
+		return nil
+	}
+	return data.BlindingKey.PubKey()
+}
+
+func (database *Database) parseChainSwap(rows *sql.Rows) (*ChainSwap, error) {
+	var chainSwap ChainSwap
+
+	var status string
+	var preimage, paymentHash string
+	var createdAt, serviceFee, onchainFee sql.NullInt64
+	var boltzUrl sql.NullString
+
+	err := scanRow(
+		rows,
+		map[string]interface{}{
+			"id":                &chainSwap.Id,
+			"fromCurrency":      &chainSwap.Pair.From,
+			"toCurrency":        &chainSwap.Pair.To,
+			"state":             &chainSwap.State,
+			"error":             &chainSwap.Error,
+			"status":            &status,
+			"acceptZeroConf":    &chainSwap.AcceptZeroConf,
+			"preimage":          database.secretScanner(&preimage),
+			"isAuto":            &chainSwap.IsAuto,
+			"serviceFee":        &serviceFee,
+			"serviceFeePercent": &chainSwap.ServiceFeePercent,
+			"onchainFee":        &onchainFee,
+			"createdAt":         &createdAt,
+			"boltzUrl":          &boltzUrl,
+			"paymentHash":       &paymentHash,
+			"label":             &chainSwap.Label,
+			"externalId":        &chainSwap.ExternalId,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	chainSwap.ServiceFee = parseNullInt(serviceFee)
+	chainSwap.OnchainFee = parseNullInt(onchainFee)
+	chainSwap.Status = boltz.ParseEvent(status)
+	chainSwap.CreatedAt = parseTime(createdAt.Int64)
+	chainSwap.BoltzUrl = boltzUrl.String
+
+	chainSwap.Preimage, err = hex.DecodeString(preimage)
+	if err != nil {
+		return nil, err
+	}
+
+	chainSwap.PaymentHash, err = hex.DecodeString(paymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chainSwap, nil
+}
+
+func (database *Database) parseChainSwapData(rows *sql.Rows) (*ChainSwapData, error) {
+	var data ChainSwapData
+
+	var privateKey PrivateKeyScanner
+	theirPublicKey := PublicKeyScanner{Nullable: true}
+	blindingKey := PrivateKeyScanner{Nullable: true}
+	swapTree := JsonScanner[*boltz.SerializedTree]{Nullable: true}
+	var wallet sql.NullString
+
+	err := scanRow(
+		rows,
+		map[string]interface{}{
+			"id":                  &data.Id,
+			"currency":            &data.Currency,
+			"privateKey":          database.secretScanner(&privateKey),
+			"theirPublicKey":      &theirPublicKey,
+			"blindingKey":         database.secretScanner(&blindingKey),
+			"swapTree":            &swapTree,
+			"amount":              &data.Amount,
+			"timeoutBlockheight":  &data.TimeoutBlockHeight,
+			"lockupTransactionId": &data.LockupTransactionId,
+			"transactionId":       &data.TransactionId,
+			"address":             &data.Address,
+			"lockupAddress":       &data.LockupAddress,
+			"wallet":              &wallet,
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	data.PrivateKey = privateKey.Value
+	data.TheirPublicKey = theirPublicKey.Value
+	data.BlindingKey = blindingKey.Value
+	data.Wallet = wallet.String
+
+	if swapTree.Value != nil {
+		data.Tree = swapTree.Value.Deserialize()
+		if err := data.InitTree(); err != nil {
+			return nil, fmt.Errorf("could not initialize swap tree: %w", err)
+		}
+	}
+
+	return &data, nil
+}
+
+func (database *Database) queryChainSwapData(id string, currency boltz.Currency) (*ChainSwapData, error) {
+	rows, err := database.Query("SELECT * FROM chainSwapsData WHERE id = ? AND currency = ?", id, currency)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	if rows.Next() {
+		return database.parseChainSwapData(rows)
+	}
+	return nil, fmt.Errorf("could not find %s data of Chain Swap %s", currency, id)
+}
+
+func (database *Database) fillChainSwapData(chainSwap *ChainSwap) (err error) {
+	chainSwap.FromData, err = database.queryChainSwapData(chainSwap.Id, chainSwap.Pair.From)
+	if err != nil {
+		return err
+	}
+	chainSwap.ToData, err = database.queryChainSwapData(chainSwap.Id, chainSwap.Pair.To)
+	return err
+}
+
+func (database *Database) QueryChainSwap(id string) (*ChainSwap, error) {
+	swaps, err := database.queryChainSwaps("SELECT * FROM chainSwaps WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(swaps) == 0 {
+		return nil, errors.New("could not find Chain Swap " + id)
+	}
+	return &swaps[0], nil
+}
+
+func (database *Database) queryChainSwaps(query string, values ...any) (swaps []ChainSwap, err error) {
+	database.secretsLock.RLock()
+	defer database.secretsLock.RUnlock()
+	database.lock.RLock()
+	defer database.lock.RUnlock()
+	rows, err := database.Query(query, values...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for rows.Next() {
+		swap, err := database.parseChainSwap(rows)
+
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		swaps = append(swaps, *swap)
+	}
+	rows.Close()
+
+	// the data of both sides can only be queried after the rows are closed
+	for i := range swaps {
+		if err := database.fillChainSwapData(&swaps[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return swaps, err
+}
+
+const chainSwapSearchCondition = `paymentHash = ? OR id IN (
+	SELECT id FROM chainSwapsData WHERE lockupAddress = ? OR lockupTransactionId = ? OR transactionId = ?
+)`
+
+func (database *Database) QueryChainSwaps(args SwapQuery) ([]ChainSwap, error) {
+	query, values := args.toQuery("chainSwaps", chainSwapSearchCondition)
+	return database.queryChainSwaps(query, values...)
+}
+
+func (database *Database) QueryPendingChainSwaps() ([]ChainSwap, error) {
+	state := boltzrpc.SwapState_PENDING
+	return database.QueryChainSwaps(SwapQuery{State: &state})
+}
+
+func (database *Database) QueryFailedChainSwaps(since time.Time) ([]ChainSwap, error) {
+	state := boltzrpc.SwapState_ERROR
+	return database.QueryChainSwaps(SwapQuery{State: &state, Since: since})
+}
+
+func (database *Database) QueryRefundableChainSwaps(currentBlockHeight uint32, currency boltz.Currency) ([]ChainSwap, error) {
+	return database.queryChainSwaps(
+		`SELECT chainSwaps.* FROM chainSwaps JOIN chainSwapsData ON chainSwaps.id = chainSwapsData.id AND chainSwaps.fromCurrency = chainSwapsData.currency
+		WHERE (state = ? OR state = ? OR state = ?) AND timeoutBlockheight <= ? AND fromCurrency = ?
+		AND lockupTransactionId != '' AND transactionId = ''`,
+		boltzrpc.SwapState_PENDING, boltzrpc.SwapState_SERVER_ERROR, boltzrpc.SwapState_ERROR, currentBlockHeight, currency,
+	)
+}
+
+const insertChainSwapStatement = `
+INSERT INTO chainSwaps (id, fromCurrency, toCurrency, state, error, status, acceptZeroConf, preimage, isAuto, serviceFee,
+                        serviceFeePercent, onchainFee, createdAt, boltzUrl, paymentHash, label, externalId)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+const insertChainSwapDataStatement = `
+INSERT INTO chainSwapsData (id, currency, privateKey, theirPublicKey, blindingKey, swapTree, amount, timeoutBlockheight,
+                            lockupTransactionId, transactionId, address, lockupAddress, wallet)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+func (database *Database) createChainSwapData(data *ChainSwapData) error {
+	privateKey, blindingKey := formatPrivateKey(data.PrivateKey), formatPrivateKey(data.BlindingKey)
+	if err := database.encryptSecrets(&privateKey, &blindingKey); err != nil {
+		return err
+	}
+
+	_, err := database.Exec(
+		insertChainSwapDataStatement,
+		data.Id,
+		data.Currency,
+		privateKey,
+		formatPublicKey(data.TheirPublicKey),
+		blindingKey,
+		formatJson(data.Tree.Serialize()),
+		data.Amount,
+		data.TimeoutBlockHeight,
+		data.LockupTransactionId,
+		data.TransactionId,
+		data.Address,
+		data.LockupAddress,
+		data.Wallet,
+	)
+	return err
+}
+
+func (database *Database) CreateChainSwap(chainSwap ChainSwap) error {
+	database.secretsLock.RLock()
+	defer database.secretsLock.RUnlock()
+
+	preimage := hex.EncodeToString(chainSwap.Preimage)
+	if err := database.encryptSecrets(&preimage); err != nil {
+		return err
+	}
+
+	tx, err := database.BeginTx()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(
+		insertChainSwapStatement,
+		chainSwap.Id,
+		chainSwap.Pair.From,
+		chainSwap.Pair.To,
+		chainSwap.State,
+		chainSwap.Error,
+		chainSwap.Status.String(),
+		chainSwap.AcceptZeroConf,
+		preimage,
+		chainSwap.IsAuto,
+		chainSwap.ServiceFee,
+		chainSwap.ServiceFeePercent,
+		chainSwap.OnchainFee,
+		FormatTime(chainSwap.CreatedAt),
+		chainSwap.BoltzUrl,
+		hex.EncodeToString(chainSwap.PaymentHash),
+		chainSwap.Label,
+		chainSwap.ExternalId,
+	)
+	if err != nil {
+		return tx.Rollback(err)
+	}
+
+	if err := tx.createChainSwapData(chainSwap.FromData); err != nil {
+		return tx.Rollback(err)
+	}
+
+	if err := tx.createChainSwapData(chainSwap.ToData); err != nil {
+		return tx.Rollback(err)
+	}
+
+	if err := tx.createStateEvent(chainSwap.Id, chainSwap.Pair.From, chainSwap.State, chainSwap.Error); err != nil {
+		return tx.Rollback(err)
+	}
+
+	return tx.Commit()
+}
+
+func (database *Database) UpdateChainSwapState(chainSwap *ChainSwap, state boltzrpc.SwapState, error string) error {
+	chainSwap.State = state
+	chainSwap.Error = error
+
+	if _, err := database.Exec("UPDATE chainSwaps SET state = ?, error = ? WHERE id = ?", state, error, chainSwap.Id); err != nil {
+		return err
+	}
+	return database.createStateEvent(chainSwap.Id, chainSwap.Pair.From, state, error)
+}
+
+func (database *Database) UpdateChainSwapStatus(chainSwap *ChainSwap, status boltz.SwapUpdateEvent) error {
+	chainSwap.Status = status
+
+	if _, err := database.Exec("UPDATE chainSwaps SET status = ? WHERE id = ?", status.String(), chainSwap.Id); err != nil {
+		return err
+	}
+	return database.createStatusEvent(chainSwap.Id, chainSwap.Pair.From, status)
+}
+
+func (database *Database) SetChainSwapLockupTransactionId(data *ChainSwapData, lockupTransactionId string) error {
+	data.LockupTransactionId = lockupTransactionId
+
+	if _, err := database.Exec(
+		"UPDATE chainSwapsData SET lockupTransactionId = ? WHERE id = ? AND currency = ?",
+		lockupTransactionId, data.Id, data.Currency,
+	); err != nil {
+		return err
+	}
+	return database.createTransactionEvent(data.Id, boltzrpc.SwapEventType_LOCKUP_TRANSACTION, data.Currency, lockupTransactionId, nil)
+}
+
+func (database *Database) SetChainSwapAmount(data *ChainSwapData, amount uint64) error {
+	data.Amount = amount
+
+	_, err := database.Exec("UPDATE chainSwapsData SET amount = ? WHERE id = ? AND currency = ?", amount, data.Id, data.Currency)
+	return err
+}
+
+func (database *Database) SetChainSwapAddress(data *ChainSwapData, address string) error {
+	data.Address = address
+
+	_, err := database.Exec("UPDATE chainSwapsData SET address = ? WHERE id = ? AND currency = ?", address, data.Id, data.Currency)
+	return err
+}
+
+func (database *Database) SetChainSwapTransactionId(chainSwap *ChainSwap, data *ChainSwapData, transactionId string, fee uint64) error {
+	data.TransactionId = transactionId
+	chainSwap.OnchainFee = addToOptional(chainSwap.OnchainFee, fee)
+
+	if _, err := database.Exec(
+		"UPDATE chainSwapsData SET transactionId = ? WHERE id = ? AND currency = ?",
+		transactionId, data.Id, data.Currency,
+	); err != nil {
+		return err
+	}
+
+	if _, err := database.Exec("UPDATE chainSwaps SET onchainFee = ? WHERE id = ?", chainSwap.OnchainFee, chainSwap.Id); err != nil {
+		return err
+	}
+
+	// the transaction of the sending side is a refund, the one of the receiving side a claim
+	eventType := boltzrpc.SwapEventType_CLAIM_TRANSACTION
+	if data.Currency == chainSwap.Pair.From {
+		eventType = boltzrpc.SwapEventType_REFUND_TRANSACTION
+	}
+	return database.createTransactionEvent(chainSwap.Id, eventType, data.Currency, transactionId, &fee)
+}
+
+func (database *Database) SetChainSwapOnchainFee(chainSwap *ChainSwap, onchainFee uint64) error {
+	chainSwap.OnchainFee = addToOptional(chainSwap.OnchainFee, onchainFee)
+
+	_, err := database.Exec("UPDATE chainSwaps SET onchainFee = ? WHERE id = ?", chainSwap.OnchainFee, chainSwap.Id)
+	return err
+}
+
+func (database *Database) SetChainSwapServiceFee(chainSwap *ChainSwap, serviceFee uint64, onchainFee uint64) error {
+	chainSwap.ServiceFee = &serviceFee
+	chainSwap.OnchainFee = addToOptional(chainSwap.OnchainFee, onchainFee)
+
+	_, err := database.Exec("UPDATE chainSwaps SET serviceFee = ?, onchainFee = ? WHERE id = ?", serviceFee, chainSwap.OnchainFee, chainSwap.Id)
+	return err
+}
@@ -4,9 +4,11 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/BoltzExchange/boltz-client/boltz"
@@ -57,7 +59,14 @@ CREATE TABLE swaps
     serviceFeePercent   REAL,
     onchainFee          INT,
     createdAt           INT,
-    wallet              VARCHAR
+    wallet              VARCHAR,
+    cooperativeError    VARCHAR DEFAULT '',
+    refundCooperative   BOOLEAN,
+    feePolicy           JSON,
+    boltzUrl            VARCHAR DEFAULT '',
+    paymentHash         VARCHAR DEFAULT '',
+    label               VARCHAR DEFAULT '',
+    externalId          VARCHAR DEFAULT ''
 );
 CREATE TABLE reverseSwaps
 (
@@ -87,7 +96,51 @@ CREATE TABLE reverseSwaps
     serviceFeePercent   REAL    DEFAULT 0,
     onchainFee          INT,
     createdAt           INT,
-    externalPay         BOOLEAN
+    externalPay         BOOLEAN,
+    feePolicy           JSON,
+    boltzUrl            VARCHAR DEFAULT '',
+    targetAmount        INT     DEFAULT 0,
+    paymentHash         VARCHAR DEFAULT '',
+    label               VARCHAR DEFAULT '',
+    externalId          VARCHAR DEFAULT ''
+);
+CREATE TABLE chainSwaps
+(
+    id                VARCHAR PRIMARY KEY,
+    fromCurrency      VARCHAR,
+    toCurrency        VARCHAR,
+    state             INT,
+    error             VARCHAR,
+    status            VARCHAR,
+    acceptZeroConf    BOOLEAN,
+    preimage          VARCHAR,
+    isAuto            BOOLEAN DEFAULT 0,
+    serviceFee        INT,
+    serviceFeePercent REAL DEFAULT 0,
+    onchainFee        INT,
+    createdAt         INT,
+    boltzUrl          VARCHAR DEFAULT '',
+    paymentHash       VARCHAR DEFAULT '',
+    label             VARCHAR DEFAULT '',
+    externalId        VARCHAR DEFAULT ''
+);
+CREATE TABLE chainSwapsData
+(
+    id                  VARCHAR,
+    currency            VARCHAR,
+    privateKey          VARCHAR,
+    theirPublicKey      VARCHAR,
+    blindingKey         VARCHAR,
+    swapTree            JSON,
+    amount              INT,
+    timeoutBlockheight  INTEGER,
+    lockupTransactionId VARCHAR DEFAULT '',
+    transactionId       VARCHAR DEFAULT '',
+    address             VARCHAR DEFAULT '',
+    lockupAddress       VARCHAR,
+    wallet              VARCHAR,
+    PRIMARY KEY (id, currency),
+    FOREIGN KEY (id) REFERENCES chainSwaps (id)
 );
 CREATE TABLE autobudget
 (
@@ -104,14 +157,69 @@ CREATE TABLE wallets
     subaccount     INT,
     salt           VARCHAR
 );
+CREATE TABLE pendingTransactions
+(
+    id          VARCHAR,
+    swapId      VARCHAR,
+    swapType    VARCHAR,
+    isRefund    BOOLEAN,
+    currency    VARCHAR,
+    fee         INT,
+    satPerVbyte REAL,
+    blockHeight INT,
+    cooperative BOOLEAN DEFAULT FALSE,
+    replacedBy  VARCHAR DEFAULT '',
+    PRIMARY KEY (id, swapId)
+);
+CREATE TABLE swapEvents
+(
+    id            INTEGER PRIMARY KEY,
+    swapId        VARCHAR,
+    type          INT,
+    createdAt     INT,
+    currency      VARCHAR DEFAULT '',
+    blockHeight   INT     DEFAULT 0,
+    status        VARCHAR DEFAULT '',
+    state         INT     DEFAULT 0,
+    error         VARCHAR DEFAULT '',
+    transactionId VARCHAR DEFAULT '',
+    fee           INT,
+    satPerVbyte   REAL    DEFAULT 0
+);
+CREATE INDEX swapEventsSwapId ON swapEvents (swapId);
+CREATE TABLE secretsEncryption
+(
+    salt     VARCHAR,
+    verifier VARCHAR
+);
+CREATE TABLE rootstockWallet
+(
+    publicKey  VARCHAR PRIMARY KEY,
+    privateKey VARCHAR
+);
 `
 
 type Database struct {
-	Path string `long:"database.path" description:"Path to the database file"`
+	Path   string `long:"database.path" description:"Path to the database file"`
+	Driver string `long:"database.driver" description:"Database driver to use; either sqlite3 or postgres"`
+	Url    string `long:"database.url" description:"Connection string of the PostgreSQL database; only used with the postgres driver" json:"-"`
+
+	BackupDir      string        `long:"database.backup.dir" description:"Directory to which the database is backed up periodically; backups are disabled if not set"`
+	BackupInterval time.Duration `long:"database.backup.interval" description:"Interval between periodic backups of the database"`
+	BackupKeep     int           `long:"database.backup.keep" description:"Number of periodic backups to keep; 0 keeps all of them"`
+	BackupPassword string        `long:"database.backup.password" description:"Password to encrypt periodic backups with" json:"-"`
+
+	EncryptSecrets bool `long:"database.encryptsecrets" description:"Encrypt the private keys and preimages of swaps with the wallet password"`
 
 	db *sql.DB
 	tx *sql.Tx
 
+	blockHeightResolver BlockHeightResolver
+
+	secrets atomic.Pointer[secretsState]
+	// held for reading while secret columns are read or written so that a password change can not interleave
+	secretsLock sync.RWMutex
+
 	lock sync.RWMutex
 }
 
@@ -128,8 +236,11 @@ func (j *JsonScanner[T]) Scan(src any) error {
 	if (src == nil || src == "") && j.Nullable {
 		return nil
 	}
-	if str, ok := src.(string); ok {
-		return json.Unmarshal([]byte(str), &j.Value)
+	switch src := src.(type) {
+	case string:
+		return json.Unmarshal([]byte(src), &j.Value)
+	case []byte:
+		return json.Unmarshal(src, &j.Value)
 	}
 	return fmt.Errorf("unsupported type: %T", src)
 }
@@ -167,13 +278,15 @@ func (s *PublicKeyScanner) Scan(src any) (err error) {
 }
 
 func (database *Database) BeginTx() (*Transaction, error) {
-	tx, err := database.db.Begin()
+	tx, err := database.conn().Begin()
 	if err != nil {
 		return nil, err
 	}
-	return &Transaction{
-		Database{tx: tx},
-	}, nil
+	transaction := &Transaction{
+		Database{Driver: database.Driver, tx: tx, blockHeightResolver: database.blockHeightResolver},
+	}
+	transaction.secrets.Store(database.getSecrets())
+	return transaction, nil
 }
 
 func (transaction *Transaction) Commit() error {
@@ -193,9 +306,31 @@ type SwapQuery struct {
 	State  *boltzrpc.SwapState
 	IsAuto *bool
 	Since  time.Time
+	Until  time.Time
+	// matches swaps whose id starts with it or whose payment hash, invoice, lockup address or any transaction id equals it
+	Search string
+	// only matches swaps with exactly this label or external id if not empty
+	Label      string
+	ExternalId string
+	// only returns swaps which come after the cursor in the requested order
+	After      *SwapCursor
+	Descending bool
+	// maximal number of swaps returned; 0 means no limit
+	Limit uint64
+}
+
+// SwapCursor is the position of a swap when sorted by creation date and id
+type SwapCursor struct {
+	CreatedAt time.Time
+	Id        string
 }
 
 func (query *SwapQuery) ToWhereClause() (where string, values []any) {
+	return query.whereClause("")
+}
+
+// whereClause also matches the search term against the given condition, whose placeholders are all set to the search term
+func (query *SwapQuery) whereClause(searchCondition string) (where string, values []any) {
 	var conditions []string
 	if query.From != nil {
 		conditions = append(conditions, "fromCurrency = ?")
@@ -217,16 +352,79 @@ func (query *SwapQuery) ToWhereClause() (where string, values []any) {
 		conditions = append(conditions, "createdAt >= ?")
 		values = append(values, query.Since.Unix())
 	}
+	if !query.Until.IsZero() {
+		conditions = append(conditions, "createdAt <= ?")
+		values = append(values, query.Until.Unix())
+	}
+	if query.Search != "" {
+		condition := `id LIKE ? ESCAPE '\'`
+		values = append(values, likeEscaper.Replace(query.Search)+"%")
+		if searchCondition != "" {
+			condition += " OR " + searchCondition
+			for i := 0; i < strings.Count(searchCondition, "?"); i++ {
+				values = append(values, query.Search)
+			}
+		}
+		conditions = append(conditions, "("+condition+")")
+	}
+	if query.Label != "" {
+		conditions = append(conditions, "label = ?")
+		values = append(values, query.Label)
+	}
+	if query.ExternalId != "" {
+		conditions = append(conditions, "externalId = ?")
+		values = append(values, query.ExternalId)
+	}
+	if query.After != nil {
+		comparison := ">"
+		if query.Descending {
+			comparison = "<"
+		}
+		conditions = append(conditions, fmt.Sprintf("(createdAt %[1]s ? OR (createdAt = ? AND id %[1]s ?))", comparison))
+		createdAt := query.After.CreatedAt.Unix()
+		values = append(values, createdAt, createdAt, query.After.Id)
+	}
 	if len(conditions) > 0 {
 		where = " WHERE " + strings.Join(conditions, " AND ")
 	}
 	return
 }
 
+// toQuery returns a full query for the swaps of a table including its order and limit
+func (query *SwapQuery) toQuery(table string, searchCondition string) (string, []any) {
+	where, values := query.whereClause(searchCondition)
+	order := "ASC"
+	if query.Descending {
+		order = "DESC"
+	}
+	result := fmt.Sprintf("SELECT * FROM %s%s ORDER BY createdAt %s, id %s", table, where, order, order)
+	if query.Limit != 0 {
+		result += " LIMIT ?"
+		values = append(values, query.Limit)
+	}
+	return result, values
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 func (database *Database) Connect() error {
 	if database.db == nil {
-		logger.Info("Opening database: " + database.Path)
-		db, err := sql.Open("sqlite3", database.Path)
+		var db *sql.DB
+		var err error
+
+		switch database.Driver {
+		case "", DriverSqlite:
+			logger.Info("Opening database: " + database.Path)
+			db, err = sql.Open(DriverSqlite, database.Path)
+		case DriverPostgres:
+			logger.Info("Connecting to PostgreSQL database")
+			db, err = sql.Open(DriverPostgres, database.Url)
+			if err == nil {
+				err = db.Ping()
+			}
+		default:
+			err = fmt.Errorf("unknown database driver: %s", database.Driver)
+		}
 
 		if err != nil {
 			return err
@@ -234,7 +432,10 @@ func (database *Database) Connect() error {
 
 		database.db = db
 
-		return database.migrate()
+		if err := database.migrate(); err != nil {
+			return err
+		}
+		return database.loadSecrets()
 	}
 	return nil
 }
@@ -242,45 +443,79 @@ func (database *Database) Connect() error {
 func (database *Database) Exec(query string, args ...any) (sql.Result, error) {
 	database.lock.Lock()
 	defer database.lock.Unlock()
+	query = database.rebind(query)
 	logger.Silly("Executing query: " + query)
 	if database.tx != nil {
 		return database.tx.Exec(query, args...)
 	}
-	return database.db.Exec(query, args...)
+	return database.conn().Exec(query, args...)
 }
 
 func (database *Database) Query(query string, args ...any) (*sql.Rows, error) {
+	query = database.rebind(query)
 	logger.Silly("Executing query: " + query)
 	if database.tx != nil {
 		return database.tx.Query(query, args...)
 	}
-	return database.db.Query(query, args...)
+	return database.conn().Query(query, args...)
 }
 
 func (database *Database) QueryRow(query string, args ...any) *sql.Row {
+	query = database.rebind(query)
 	logger.Silly("Executing query: " + query)
 	if database.tx != nil {
 		return database.tx.QueryRow(query, args...)
 	}
-	return database.db.QueryRow(query, args...)
+	return database.conn().QueryRow(query, args...)
 }
 
-func (database *Database) QueryAnySwap(id string) (*Swap, *ReverseSwap, error) {
+func (database *Database) QueryAnySwap(id string) (*Swap, *ReverseSwap, *ChainSwap, error) {
 	swap, err := database.QuerySwap(id)
 	if err == nil {
-		return swap, nil, nil
+		return swap, nil, nil, nil
 	}
 
 	reverseSwap, err := database.QueryReverseSwap(id)
 	if err == nil {
-		return nil, reverseSwap, nil
+		return nil, reverseSwap, nil, nil
 	}
 
-	return nil, nil, fmt.Errorf("could not find Swap or Reverse Swap with ID %s", id)
+	chainSwap, err := database.QueryChainSwap(id)
+	if err == nil {
+		return nil, nil, chainSwap, nil
+	}
+
+	return nil, nil, nil, fmt.Errorf("%w: %s", ErrSwapNotFound, id)
+}
+
+// ErrSwapNotFound is returned when there is no swap of any type with an id
+var ErrSwapNotFound = errors.New("could not find Swap, Reverse Swap or Chain Swap with ID")
+
+// UpdateSwapLabel sets the label and external id of a swap of any type; values which are nil are left unchanged
+func (database *Database) UpdateSwapLabel(id string, label *string, externalId *string) error {
+	for _, table := range []string{"swaps", "reverseSwaps", "chainSwaps"} {
+		result, err := database.Exec(
+			"UPDATE "+table+" SET label = COALESCE(?, label), externalId = COALESCE(?, externalId) WHERE id = ?",
+			label, externalId, id,
+		)
+		if err != nil {
+			return err
+		}
+		if affected, err := result.RowsAffected(); err != nil {
+			return err
+		} else if affected > 0 {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrSwapNotFound, id)
 }
 
 func (database *Database) createTables() error {
-	_, err := database.Exec(createTables)
+	schema := createTables
+	if database.isPostgres() {
+		schema = createPostgresTables
+	}
+	_, err := database.Exec(schema)
 	return err
 }
 
@@ -0,0 +1,143 @@
+package database
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+)
+
+// SwapEvent is an entry in the history of a swap
+type SwapEvent struct {
+	SwapId    string
+	Type      boltzrpc.SwapEventType
+	CreatedAt time.Time
+	// chain the block height and transaction belong to
+	Currency boltz.Currency
+	// zero if the block height was not known
+	BlockHeight   uint32
+	Status        string
+	State         boltzrpc.SwapState
+	Error         string
+	TransactionId string
+	Fee           *uint64
+	SatPerVbyte   float64
+}
+
+// BlockHeightResolver returns the last known block height of a currency or 0 if there is none.
+// It is called while swaps are written, so it must not wait for the chain backend.
+type BlockHeightResolver func(currency boltz.Currency) uint32
+
+// SetBlockHeightResolver makes swap events record the block height of their chain at the time they happened
+func (database *Database) SetBlockHeightResolver(resolver BlockHeightResolver) {
+	database.blockHeightResolver = resolver
+}
+
+func parseSwapEvent(rows *sql.Rows) (*SwapEvent, error) {
+	var event SwapEvent
+	var id, createdAt int64
+	var fee sql.NullInt64
+
+	err := scanRow(
+		rows,
+		map[string]interface{}{
+			"id":            &id,
+			"swapId":        &event.SwapId,
+			"type":          &event.Type,
+			"createdAt":     &createdAt,
+			"currency":      &event.Currency,
+			"blockHeight":   &event.BlockHeight,
+			"status":        &event.Status,
+			"state":         &event.State,
+			"error":         &event.Error,
+			"transactionId": &event.TransactionId,
+			"fee":           &fee,
+			"satPerVbyte":   &event.SatPerVbyte,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	event.CreatedAt = parseTime(createdAt)
+	event.Fee = parseNullInt(fee)
+	return &event, nil
+}
+
+func (database *Database) CreateSwapEvent(event SwapEvent) error {
+	if event.BlockHeight == 0 && event.Currency != "" && database.blockHeightResolver != nil {
+		event.BlockHeight = database.blockHeightResolver(event.Currency)
+	}
+
+	_, err := database.Exec(
+		"INSERT INTO swapEvents (swapId, type, createdAt, currency, blockHeight, status, state, error, transactionId, fee, satPerVbyte) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		event.SwapId,
+		event.Type,
+		FormatTime(event.CreatedAt),
+		event.Currency,
+		event.BlockHeight,
+		event.Status,
+		event.State,
+		event.Error,
+		event.TransactionId,
+		event.Fee,
+		event.SatPerVbyte,
+	)
+	return err
+}
+
+func (database *Database) createStatusEvent(swapId string, currency boltz.Currency, status boltz.SwapUpdateEvent) error {
+	return database.CreateSwapEvent(SwapEvent{
+		SwapId:   swapId,
+		Type:     boltzrpc.SwapEventType_STATUS,
+		Currency: currency,
+		Status:   status.String(),
+	})
+}
+
+func (database *Database) createStateEvent(swapId string, currency boltz.Currency, state boltzrpc.SwapState, error string) error {
+	return database.CreateSwapEvent(SwapEvent{
+		SwapId:   swapId,
+		Type:     boltzrpc.SwapEventType_STATE,
+		Currency: currency,
+		State:    state,
+		Error:    error,
+	})
+}
+
+func (database *Database) createTransactionEvent(
+	swapId string,
+	eventType boltzrpc.SwapEventType,
+	currency boltz.Currency,
+	transactionId string,
+	fee *uint64,
+) error {
+	return database.CreateSwapEvent(SwapEvent{
+		SwapId:        swapId,
+		Type:          eventType,
+		Currency:      currency,
+		TransactionId: transactionId,
+		Fee:           fee,
+	})
+}
+
+// QuerySwapEvents returns the history of a swap, oldest event first
+func (database *Database) QuerySwapEvents(swapId string) (events []SwapEvent, err error) {
+	database.lock.RLock()
+	defer database.lock.RUnlock()
+	rows, err := database.Query("SELECT * FROM swapEvents WHERE swapId = ? ORDER BY id", swapId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		event, err := parseSwapEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+	return events, rows.Err()
+}
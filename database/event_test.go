@@ -0,0 +1,93 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwapEvents(t *testing.T) {
+	db := Database{Path: ":memory:"}
+	require.NoError(t, db.Connect())
+
+	heights := map[boltz.Currency]uint32{boltz.CurrencyLiquid: 100}
+	db.SetBlockHeightResolver(func(currency boltz.Currency) uint32 {
+		return heights[currency]
+	})
+
+	privateKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	swap := &Swap{Id: "swap", Pair: boltz.Pair{From: boltz.CurrencyLiquid, To: boltz.CurrencyBtc}, PrivateKey: privateKey}
+	require.NoError(t, db.CreateSwap(*swap))
+	require.NoError(t, db.UpdateSwapStatus(swap, boltz.TransactionMempool))
+	require.NoError(t, db.SetSwapLockupTransactionId(swap, "lockup"))
+	heights[boltz.CurrencyLiquid] = 101
+	require.NoError(t, db.UpdateSwapState(swap, boltzrpc.SwapState_ERROR, "failed"))
+	require.NoError(t, db.SetSwapRefundTransactionId(swap, "refund", 10, true))
+	// replacing the refund transaction does not change the state again
+	require.NoError(t, db.SetSwapRefundTransactionId(swap, "replacement", 5, true))
+
+	events, err := db.QuerySwapEvents(swap.Id)
+	require.NoError(t, err)
+
+	var types []boltzrpc.SwapEventType
+	for _, event := range events {
+		types = append(types, event.Type)
+		require.Equal(t, boltz.CurrencyLiquid, event.Currency)
+	}
+	require.Equal(t, []boltzrpc.SwapEventType{
+		boltzrpc.SwapEventType_STATE,
+		boltzrpc.SwapEventType_STATUS,
+		boltzrpc.SwapEventType_LOCKUP_TRANSACTION,
+		boltzrpc.SwapEventType_STATE,
+		boltzrpc.SwapEventType_REFUND_TRANSACTION,
+		boltzrpc.SwapEventType_STATE,
+		boltzrpc.SwapEventType_REFUND_TRANSACTION,
+	}, types)
+
+	require.Equal(t, boltzrpc.SwapState_PENDING, events[0].State)
+	require.Equal(t, uint32(100), events[0].BlockHeight)
+	require.Equal(t, boltz.TransactionMempool.String(), events[1].Status)
+	require.Equal(t, "lockup", events[2].TransactionId)
+	require.Nil(t, events[2].Fee)
+	require.Equal(t, "failed", events[3].Error)
+	require.Equal(t, uint32(101), events[3].BlockHeight)
+	require.Equal(t, uint64(10), *events[4].Fee)
+	require.Equal(t, boltzrpc.SwapState_REFUNDED, events[5].State)
+
+	t.Run("UnknownBlockHeight", func(t *testing.T) {
+		reverseSwap := &ReverseSwap{Id: "reverse", Pair: boltz.PairBtc, PrivateKey: privateKey}
+		require.NoError(t, db.CreateReverseSwap(*reverseSwap))
+		require.NoError(t, db.SetReverseSwapClaimTransactionId(reverseSwap, "claim", 20))
+
+		events, err := db.QuerySwapEvents(reverseSwap.Id)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Zero(t, events[1].BlockHeight)
+		require.Equal(t, boltzrpc.SwapEventType_CLAIM_TRANSACTION, events[1].Type)
+	})
+
+	t.Run("ChainSwap", func(t *testing.T) {
+		chainSwap := &ChainSwap{
+			Id:       "chain",
+			Pair:     boltz.Pair{From: boltz.CurrencyBtc, To: boltz.CurrencyLiquid},
+			FromData: &ChainSwapData{Id: "chain", Currency: boltz.CurrencyBtc, PrivateKey: privateKey},
+			ToData:   &ChainSwapData{Id: "chain", Currency: boltz.CurrencyLiquid, PrivateKey: privateKey},
+		}
+		require.NoError(t, db.CreateChainSwap(*chainSwap))
+		require.NoError(t, db.SetChainSwapTransactionId(chainSwap, chainSwap.ToData, "claim", 1))
+		require.NoError(t, db.SetChainSwapTransactionId(chainSwap, chainSwap.FromData, "refund", 2))
+
+		events, err := db.QuerySwapEvents(chainSwap.Id)
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		require.Equal(t, boltzrpc.SwapEventType_CLAIM_TRANSACTION, events[1].Type)
+		require.Equal(t, uint32(101), events[1].BlockHeight)
+		require.Equal(t, boltzrpc.SwapEventType_REFUND_TRANSACTION, events[2].Type)
+		require.Equal(t, boltz.CurrencyBtc, events[2].Currency)
+	})
+}
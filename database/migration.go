@@ -1,6 +1,9 @@
 package database
 
 import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -9,6 +12,8 @@ import (
 	"github.com/BoltzExchange/boltz-client/boltz"
 	"github.com/BoltzExchange/boltz-client/boltzrpc"
 	"github.com/BoltzExchange/boltz-client/logger"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/zpay32"
 )
 
 type swapStatus struct {
@@ -16,13 +21,14 @@ type swapStatus struct {
 	status string
 }
 
-const latestSchemaVersion = 6
+const latestSchemaVersion = 18
 
 func (database *Database) migrate() error {
 	version, err := database.queryVersion()
 
 	if err != nil {
-		// Insert the latest schema version when no row is found
+		// Insert the latest schema version when no row is found.
+		// PostgreSQL databases always start at the latest version, so only migrations after it have to support both drivers
 		logger.Infof("No database schema version found, inserting latest schema version %d", latestSchemaVersion)
 		if err := database.createTables(); err != nil {
 			return err
@@ -389,6 +395,243 @@ func (database *Database) performMigration(tx *Transaction, oldVersion int) erro
 			return err
 		}
 
+	case 6:
+		logMigration(oldVersion)
+
+		migration := `
+CREATE TABLE chainSwaps
+(
+    id                VARCHAR PRIMARY KEY,
+    fromCurrency      VARCHAR,
+    toCurrency        VARCHAR,
+    state             INT,
+    error             VARCHAR,
+    status            VARCHAR,
+    acceptZeroConf    BOOLEAN,
+    preimage          VARCHAR,
+    isAuto            BOOLEAN DEFAULT 0,
+    serviceFee        INT,
+    serviceFeePercent REAL DEFAULT 0,
+    onchainFee        INT,
+    createdAt         INT
+);
+CREATE TABLE chainSwapsData
+(
+    id                  VARCHAR,
+    currency            VARCHAR,
+    privateKey          VARCHAR,
+    theirPublicKey      VARCHAR,
+    blindingKey         VARCHAR,
+    swapTree            JSON,
+    amount              INT,
+    timeoutBlockheight  INTEGER,
+    lockupTransactionId VARCHAR DEFAULT '',
+    transactionId       VARCHAR DEFAULT '',
+    address             VARCHAR DEFAULT '',
+    lockupAddress       VARCHAR,
+    wallet              VARCHAR,
+    PRIMARY KEY (id, currency),
+    FOREIGN KEY (id) REFERENCES chainSwaps (id)
+);
+`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+
+	case 7:
+		logMigration(oldVersion)
+
+		if _, err := tx.Exec("ALTER TABLE swaps ADD COLUMN cooperativeError VARCHAR DEFAULT ''"); err != nil {
+			return err
+		}
+		if _, err := tx.Exec("ALTER TABLE swaps ADD COLUMN refundCooperative BOOLEAN"); err != nil {
+			return err
+		}
+
+	case 8:
+		logMigration(oldVersion)
+
+		migration := `
+CREATE TABLE pendingTransactions
+(
+    id          VARCHAR,
+    swapId      VARCHAR,
+    swapType    VARCHAR,
+    isRefund    BOOLEAN,
+    currency    VARCHAR,
+    fee         INT,
+    satPerVbyte REAL,
+    blockHeight INT,
+    PRIMARY KEY (id, swapId)
+);
+`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+
+	case 9:
+		logMigration(oldVersion)
+
+		migration := `
+ALTER TABLE swaps ADD COLUMN feePolicy JSON;
+ALTER TABLE reverseSwaps ADD COLUMN feePolicy JSON;
+`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+
+	case 10:
+		logMigration(oldVersion)
+
+		migration := `
+ALTER TABLE swaps ADD COLUMN boltzUrl VARCHAR DEFAULT '';
+ALTER TABLE reverseSwaps ADD COLUMN boltzUrl VARCHAR DEFAULT '';
+ALTER TABLE chainSwaps ADD COLUMN boltzUrl VARCHAR DEFAULT '';
+`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+
+	case 11:
+		logMigration(oldVersion)
+
+		if _, err := tx.Exec("ALTER TABLE reverseSwaps ADD COLUMN targetAmount INT DEFAULT 0"); err != nil {
+			return err
+		}
+
+	case 12:
+		logMigration(oldVersion)
+
+		migration := `
+ALTER TABLE swaps ADD COLUMN paymentHash VARCHAR DEFAULT '';
+ALTER TABLE reverseSwaps ADD COLUMN paymentHash VARCHAR DEFAULT '';
+ALTER TABLE chainSwaps ADD COLUMN paymentHash VARCHAR DEFAULT '';
+`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+
+		updatePaymentHashes := func(table string, invoiceColumn string) error {
+			rows, err := tx.Query(fmt.Sprintf("SELECT id, preimage, %s FROM %s", invoiceColumn, table))
+			if err != nil {
+				return err
+			}
+			hashes := make(map[string]string)
+			for rows.Next() {
+				var id string
+				var preimage, invoice sql.NullString
+				if err := rows.Scan(&id, &preimage, &invoice); err != nil {
+					rows.Close()
+					return err
+				}
+				if paymentHash := findPaymentHash(preimage.String, invoice.String); paymentHash != "" {
+					hashes[id] = paymentHash
+				}
+			}
+			rows.Close()
+			for id, paymentHash := range hashes {
+				if _, err := tx.Exec(fmt.Sprintf("UPDATE %s SET paymentHash = ? WHERE id = ?", table), paymentHash, id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if err := updatePaymentHashes("swaps", "invoice"); err != nil {
+			return err
+		}
+		if err := updatePaymentHashes("reverseSwaps", "invoice"); err != nil {
+			return err
+		}
+		// chain swaps have no invoice, so an empty string is selected in its place
+		if err := updatePaymentHashes("chainSwaps", "''"); err != nil {
+			return err
+		}
+
+	case 13:
+		logMigration(oldVersion)
+
+		idColumn := "INTEGER PRIMARY KEY"
+		if tx.isPostgres() {
+			idColumn = "BIGSERIAL PRIMARY KEY"
+		}
+		migration := `
+CREATE TABLE swapEvents
+(
+    id            ` + idColumn + `,
+    swapId        VARCHAR,
+    type          INT,
+    createdAt     BIGINT,
+    currency      VARCHAR          DEFAULT '',
+    blockHeight   BIGINT           DEFAULT 0,
+    status        VARCHAR          DEFAULT '',
+    state         INT              DEFAULT 0,
+    error         VARCHAR          DEFAULT '',
+    transactionId VARCHAR          DEFAULT '',
+    fee           BIGINT,
+    satPerVbyte   DOUBLE PRECISION DEFAULT 0
+);
+CREATE INDEX swapEventsSwapId ON swapEvents (swapId);
+`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+
+	case 14:
+		logMigration(oldVersion)
+
+		migration := `
+ALTER TABLE swaps ADD COLUMN label VARCHAR DEFAULT '';
+ALTER TABLE swaps ADD COLUMN externalId VARCHAR DEFAULT '';
+ALTER TABLE reverseSwaps ADD COLUMN label VARCHAR DEFAULT '';
+ALTER TABLE reverseSwaps ADD COLUMN externalId VARCHAR DEFAULT '';
+ALTER TABLE chainSwaps ADD COLUMN label VARCHAR DEFAULT '';
+ALTER TABLE chainSwaps ADD COLUMN externalId VARCHAR DEFAULT '';
+`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+
+	case 15:
+		logMigration(oldVersion)
+
+		// the secrets of existing swaps are encrypted once the wallet password is known on unlock
+		migration := `
+CREATE TABLE secretsEncryption
+(
+    salt     VARCHAR,
+    verifier VARCHAR
+);
+`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+
+	case 16:
+		logMigration(oldVersion)
+
+		// replaced transactions are kept, since they might confirm instead of their replacement
+		migration := `
+ALTER TABLE pendingTransactions ADD COLUMN cooperative BOOLEAN DEFAULT FALSE;
+ALTER TABLE pendingTransactions ADD COLUMN replacedBy VARCHAR DEFAULT '';
+`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+
+	case 17:
+		logMigration(oldVersion)
+
+		migration := `
+CREATE TABLE rootstockWallet
+(
+    publicKey  VARCHAR PRIMARY KEY,
+    privateKey VARCHAR
+);
+`
+		if _, err := tx.Exec(migration); err != nil {
+			return err
+		}
+
 	case latestSchemaVersion:
 		logger.Info("database already at latest schema version: " + strconv.Itoa(latestSchemaVersion))
 		return nil
@@ -428,3 +671,24 @@ func (database *Database) queryVersion() (int, error) {
 func logMigration(oldVersion int) {
 	logger.Infof("Updating database from version %d to %d", oldVersion, oldVersion+1)
 }
+
+// findPaymentHash derives the payment hash of a swap from its preimage or, if that is unknown, from its invoice.
+// The network of the invoice is not known when migrating, so all of them are tried.
+func findPaymentHash(preimage string, invoice string) string {
+	if decoded, err := hex.DecodeString(preimage); err == nil && len(decoded) != 0 {
+		hash := sha256.Sum256(decoded)
+		return hex.EncodeToString(hash[:])
+	}
+	if invoice != "" {
+		networks := []*chaincfg.Params{
+			&chaincfg.MainNetParams, &chaincfg.TestNet3Params, &chaincfg.RegressionNetParams, &chaincfg.SigNetParams,
+		}
+		for _, network := range networks {
+			decoded, err := zpay32.Decode(invoice, network)
+			if err == nil && decoded.PaymentHash != nil {
+				return hex.EncodeToString(decoded.PaymentHash[:])
+			}
+		}
+	}
+	return ""
+}
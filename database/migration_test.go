@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"testing"
 
+	"github.com/BoltzExchange/boltz-client/boltz"
 	"github.com/stretchr/testify/require"
 )
 
@@ -41,8 +42,14 @@ func TestMigration(t *testing.T) {
 				require.NoError(t, err)
 				_, err = database.QueryReverseSwaps(SwapQuery{})
 				require.NoError(t, err)
+				_, err = database.QueryChainSwaps(SwapQuery{})
+				require.NoError(t, err)
 				_, err = database.QueryWalletCredentials()
 				require.NoError(t, err)
+				_, err = database.QueryPendingTransactions(boltz.CurrencyBtc)
+				require.NoError(t, err)
+				_, err = database.QuerySwapEvents("")
+				require.NoError(t, err)
 			} else {
 				require.Error(t, migrationError)
 				require.Equal(t, originalVersion, version)
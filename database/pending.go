@@ -0,0 +1,118 @@
+package database
+
+import (
+	"database/sql"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+)
+
+// PendingTransaction is a claim or refund transaction which has not confirmed yet.
+// A transaction spending multiple swaps has one entry per swap.
+// Replaced transactions are kept until one of the conflicting transactions confirms, because the replacement
+// might not make it into a block before them.
+type PendingTransaction struct {
+	Id       string
+	SwapId   string
+	SwapType boltz.SwapType
+	IsRefund bool
+	Currency boltz.Currency
+	// total fee of the transaction
+	Fee         uint64
+	SatPerVbyte float64
+	// block height at which the transaction was broadcast or last bumped
+	BlockHeight uint32
+	// whether the output of the swap was spent via the key path
+	Cooperative bool
+	// id of the transaction which replaced this one; empty if it was not replaced
+	ReplacedBy string
+}
+
+func parsePendingTransaction(rows *sql.Rows) (*PendingTransaction, error) {
+	var pending PendingTransaction
+
+	err := scanRow(
+		rows,
+		map[string]interface{}{
+			"id":          &pending.Id,
+			"swapId":      &pending.SwapId,
+			"swapType":    &pending.SwapType,
+			"isRefund":    &pending.IsRefund,
+			"currency":    &pending.Currency,
+			"fee":         &pending.Fee,
+			"satPerVbyte": &pending.SatPerVbyte,
+			"blockHeight": &pending.BlockHeight,
+			"cooperative": &pending.Cooperative,
+			"replacedBy":  &pending.ReplacedBy,
+		},
+	)
+
+	return &pending, err
+}
+
+func (database *Database) CreatePendingTransaction(pending PendingTransaction) error {
+	_, err := database.Exec(
+		"INSERT INTO pendingTransactions (id, swapId, swapType, isRefund, currency, fee, satPerVbyte, blockHeight, cooperative, replacedBy) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		pending.Id,
+		pending.SwapId,
+		pending.SwapType,
+		pending.IsRefund,
+		pending.Currency,
+		pending.Fee,
+		pending.SatPerVbyte,
+		pending.BlockHeight,
+		pending.Cooperative,
+		pending.ReplacedBy,
+	)
+	return err
+}
+
+func (database *Database) queryPendingTransactions(query string, args ...any) (pending []PendingTransaction, err error) {
+	database.lock.RLock()
+	defer database.lock.RUnlock()
+	rows, err := database.Query(query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		transaction, err := parsePendingTransaction(rows)
+
+		if err != nil {
+			return nil, err
+		}
+
+		pending = append(pending, *transaction)
+	}
+
+	return pending, err
+}
+
+// QueryPendingTransactions returns the pending transactions of a currency including the ones which were replaced
+func (database *Database) QueryPendingTransactions(currency boltz.Currency) ([]PendingTransaction, error) {
+	return database.queryPendingTransactions("SELECT * FROM pendingTransactions WHERE currency = ?", currency)
+}
+
+// QueryPendingTransaction returns the entries of a transaction, unless it was replaced
+func (database *Database) QueryPendingTransaction(id string) ([]PendingTransaction, error) {
+	return database.queryPendingTransactions("SELECT * FROM pendingTransactions WHERE id = ? AND replacedBy = ''", id)
+}
+
+func (database *Database) UpdatePendingTransaction(id string, satPerVbyte float64, blockHeight uint32) error {
+	_, err := database.Exec("UPDATE pendingTransactions SET satPerVbyte = ?, blockHeight = ? WHERE id = ?", satPerVbyte, blockHeight, id)
+	return err
+}
+
+// ReplacePendingTransaction marks a transaction and the ones it replaced already as replaced by another one
+func (database *Database) ReplacePendingTransaction(id string, replacedBy string) error {
+	_, err := database.Exec("UPDATE pendingTransactions SET replacedBy = ? WHERE id = ? OR replacedBy = ?", replacedBy, id, id)
+	return err
+}
+
+// DeletePendingTransaction deletes a transaction and the ones it replaced
+func (database *Database) DeletePendingTransaction(id string) error {
+	_, err := database.Exec("DELETE FROM pendingTransactions WHERE id = ? OR replacedBy = ?", id, id)
+	return err
+}
@@ -0,0 +1,218 @@
+package database
+
+import (
+	"strconv"
+	"strings"
+
+	_ "github.com/lib/pq"
+)
+
+const (
+	DriverSqlite   = "sqlite3"
+	DriverPostgres = "postgres"
+)
+
+// createPostgresTables is the latest schema of createTables with the types postgres expects;
+// JSON is stored as plain text to keep the values byte for byte identical to the ones written to SQLite
+const createPostgresTables = `
+CREATE TABLE version
+(
+    version INT
+);
+CREATE TABLE macaroons
+(
+    id      VARCHAR PRIMARY KEY,
+    rootKey VARCHAR
+);
+CREATE TABLE swaps
+(
+    id                  VARCHAR PRIMARY KEY,
+    fromCurrency        VARCHAR,
+    toCurrency          VARCHAR,
+    chanIds             TEXT,
+    state               INT,
+    error               VARCHAR,
+    status              VARCHAR,
+    privateKey          VARCHAR,
+    swapTree            TEXT,
+    claimPubKey         VARCHAR,
+    preimage            VARCHAR,
+    redeemScript        VARCHAR,
+    invoice             VARCHAR,
+    address             VARCHAR,
+    expectedAmount      BIGINT,
+    timeoutBlockheight  BIGINT,
+    lockupTransactionId VARCHAR,
+    refundTransactionId VARCHAR,
+    refundAddress       VARCHAR DEFAULT '',
+    blindingKey         VARCHAR,
+    isAuto              BOOLEAN DEFAULT FALSE,
+    serviceFee          BIGINT,
+    serviceFeePercent   DOUBLE PRECISION,
+    onchainFee          BIGINT,
+    createdAt           BIGINT,
+    wallet              VARCHAR,
+    cooperativeError    VARCHAR DEFAULT '',
+    refundCooperative   BOOLEAN,
+    feePolicy           TEXT,
+    boltzUrl            VARCHAR DEFAULT '',
+    paymentHash         VARCHAR DEFAULT '',
+    label               VARCHAR DEFAULT '',
+    externalId          VARCHAR DEFAULT ''
+);
+CREATE TABLE reverseSwaps
+(
+    id                  VARCHAR PRIMARY KEY,
+    fromCurrency        VARCHAR,
+    toCurrency          VARCHAR,
+    chanIds             TEXT,
+    state               INT,
+    error               VARCHAR,
+    status              VARCHAR,
+    acceptZeroConf      BOOLEAN,
+    privateKey          VARCHAR,
+    swapTree            TEXT,
+    refundPubKey        VARCHAR,
+    preimage            VARCHAR,
+    redeemScript        VARCHAR,
+    invoice             VARCHAR,
+    claimAddress        VARCHAR,
+    expectedAmount      BIGINT,
+    timeoutBlockheight  BIGINT,
+    lockupTransactionId VARCHAR,
+    claimTransactionId  VARCHAR,
+    blindingKey         VARCHAR,
+    isAuto              BOOLEAN          DEFAULT FALSE,
+    routingFeeMsat      BIGINT,
+    serviceFee          BIGINT,
+    serviceFeePercent   DOUBLE PRECISION DEFAULT 0,
+    onchainFee          BIGINT,
+    createdAt           BIGINT,
+    externalPay         BOOLEAN,
+    feePolicy           TEXT,
+    boltzUrl            VARCHAR          DEFAULT '',
+    targetAmount        BIGINT           DEFAULT 0,
+    paymentHash         VARCHAR          DEFAULT '',
+    label               VARCHAR          DEFAULT '',
+    externalId          VARCHAR          DEFAULT ''
+);
+CREATE TABLE chainSwaps
+(
+    id                VARCHAR PRIMARY KEY,
+    fromCurrency      VARCHAR,
+    toCurrency        VARCHAR,
+    state             INT,
+    error             VARCHAR,
+    status            VARCHAR,
+    acceptZeroConf    BOOLEAN,
+    preimage          VARCHAR,
+    isAuto            BOOLEAN          DEFAULT FALSE,
+    serviceFee        BIGINT,
+    serviceFeePercent DOUBLE PRECISION DEFAULT 0,
+    onchainFee        BIGINT,
+    createdAt         BIGINT,
+    boltzUrl          VARCHAR          DEFAULT '',
+    paymentHash       VARCHAR          DEFAULT '',
+    label             VARCHAR          DEFAULT '',
+    externalId        VARCHAR          DEFAULT ''
+);
+CREATE TABLE chainSwapsData
+(
+    id                  VARCHAR,
+    currency            VARCHAR,
+    privateKey          VARCHAR,
+    theirPublicKey      VARCHAR,
+    blindingKey         VARCHAR,
+    swapTree            TEXT,
+    amount              BIGINT,
+    timeoutBlockheight  BIGINT,
+    lockupTransactionId VARCHAR DEFAULT '',
+    transactionId       VARCHAR DEFAULT '',
+    address             VARCHAR DEFAULT '',
+    lockupAddress       VARCHAR,
+    wallet              VARCHAR,
+    PRIMARY KEY (id, currency),
+    FOREIGN KEY (id) REFERENCES chainSwaps (id)
+);
+CREATE TABLE autobudget
+(
+    startDate BIGINT PRIMARY KEY,
+    endDate   BIGINT
+);
+CREATE TABLE wallets
+(
+    name           VARCHAR PRIMARY KEY,
+    currency       VARCHAR,
+    xpub           VARCHAR,
+    coreDescriptor VARCHAR,
+    mnemonic       VARCHAR,
+    subaccount     BIGINT,
+    salt           VARCHAR
+);
+CREATE TABLE pendingTransactions
+(
+    id          VARCHAR,
+    swapId      VARCHAR,
+    swapType    VARCHAR,
+    isRefund    BOOLEAN,
+    currency    VARCHAR,
+    fee         BIGINT,
+    satPerVbyte DOUBLE PRECISION,
+    blockHeight BIGINT,
+    cooperative BOOLEAN DEFAULT FALSE,
+    replacedBy  VARCHAR DEFAULT '',
+    PRIMARY KEY (id, swapId)
+);
+CREATE TABLE swapEvents
+(
+    id            BIGSERIAL PRIMARY KEY,
+    swapId        VARCHAR,
+    type          INT,
+    createdAt     BIGINT,
+    currency      VARCHAR          DEFAULT '',
+    blockHeight   BIGINT           DEFAULT 0,
+    status        VARCHAR          DEFAULT '',
+    state         INT              DEFAULT 0,
+    error         VARCHAR          DEFAULT '',
+    transactionId VARCHAR          DEFAULT '',
+    fee           BIGINT,
+    satPerVbyte   DOUBLE PRECISION DEFAULT 0
+);
+CREATE INDEX swapEventsSwapId ON swapEvents (swapId);
+CREATE TABLE secretsEncryption
+(
+    salt     VARCHAR,
+    verifier VARCHAR
+);
+CREATE TABLE rootstockWallet
+(
+    publicKey  VARCHAR PRIMARY KEY,
+    privateKey VARCHAR
+);
+`
+
+func (database *Database) isPostgres() bool {
+	return database.Driver == DriverPostgres
+}
+
+// rebind replaces the ? placeholders used throughout the package with the numbered ones postgres expects
+func (database *Database) rebind(query string) string {
+	if !database.isPostgres() || !strings.Contains(query, "?") {
+		return query
+	}
+	var result strings.Builder
+	quoted := false
+	placeholders := 0
+	for _, char := range query {
+		if char == '\'' {
+			quoted = !quoted
+		}
+		if char == '?' && !quoted {
+			placeholders++
+			result.WriteString("$" + strconv.Itoa(placeholders))
+			continue
+		}
+		result.WriteRune(char)
+	}
+	return result.String()
+}
@@ -0,0 +1,197 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/onchain/wallet"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebind(t *testing.T) {
+	sqlite := Database{}
+	postgres := Database{Driver: DriverPostgres}
+
+	query := `SELECT * FROM swaps WHERE id LIKE ? ESCAPE '\' AND invoice = '?' AND state = ?`
+	require.Equal(t, query, sqlite.rebind(query))
+	require.Equal(t, `SELECT * FROM swaps WHERE id LIKE $1 ESCAPE '\' AND invoice = '?' AND state = $2`, postgres.rebind(query))
+}
+
+// startPostgres runs a throwaway server with the local postgres binaries and returns its connection string
+func startPostgres(t *testing.T) string {
+	initdb, err := exec.LookPath("initdb")
+	if err != nil {
+		t.Skip("initdb not found, install PostgreSQL to run this test")
+	}
+	postgres, err := exec.LookPath("postgres")
+	if err != nil {
+		t.Skip("postgres not found, install PostgreSQL to run this test")
+	}
+	if os.Geteuid() == 0 {
+		t.Skip("postgres refuses to run as root")
+	}
+
+	dir := t.TempDir()
+	data := filepath.Join(dir, "data")
+	output, err := exec.Command(initdb, "-D", data, "-U", "boltz", "--auth=trust").CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	// only listen on a unix socket in the temporary directory so that no port can clash
+	server := exec.Command(postgres, "-D", data, "-k", dir, "-h", "")
+	require.NoError(t, server.Start())
+	t.Cleanup(func() {
+		_ = server.Process.Signal(os.Interrupt)
+		_ = server.Wait()
+	})
+
+	url := fmt.Sprintf("host=%s user=boltz dbname=postgres sslmode=disable", dir)
+	db, err := sql.Open(DriverPostgres, url)
+	require.NoError(t, err)
+	defer db.Close()
+	require.Eventually(t, func() bool {
+		return db.Ping() == nil
+	}, 10*time.Second, 100*time.Millisecond)
+
+	return url
+}
+
+func TestDrivers(t *testing.T) {
+	tests := []struct {
+		driver string
+		setup  func(t *testing.T) *Database
+	}{
+		{DriverSqlite, func(t *testing.T) *Database {
+			return &Database{Path: ":memory:"}
+		}},
+		{DriverPostgres, func(t *testing.T) *Database {
+			return &Database{Driver: DriverPostgres, Url: startPostgres(t)}
+		}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.driver, func(t *testing.T) {
+			db := tc.setup(t)
+			require.NoError(t, db.Connect())
+			// connecting again has to detect the existing schema
+			version, err := db.queryVersion()
+			require.NoError(t, err)
+			require.Equal(t, latestSchemaVersion, version)
+			require.NoError(t, db.migrate())
+
+			privateKey, err := btcec.NewPrivateKey()
+			require.NoError(t, err)
+			createdAt := time.Unix(1700000000, 0)
+			serviceFee := uint64(100)
+
+			t.Run("Swaps", func(t *testing.T) {
+				swap := Swap{
+					Id:             "swap",
+					Pair:           boltz.PairBtc,
+					State:          boltzrpc.SwapState_SUCCESSFUL,
+					PrivateKey:     privateKey,
+					Preimage:       []byte{1, 2, 3},
+					ExpectedAmount: 5_000_000_000,
+					CreatedAt:      createdAt,
+					ServiceFee:     &serviceFee,
+					IsAuto:         true,
+				}
+				require.NoError(t, db.CreateSwap(swap))
+				require.NoError(t, db.SetSwapLockupTransactionId(&swap, "lockup"))
+
+				swaps, err := db.QuerySwaps(SwapQuery{Search: "lockup", Limit: 1})
+				require.NoError(t, err)
+				require.Len(t, swaps, 1)
+				require.Equal(t, swap.ExpectedAmount, swaps[0].ExpectedAmount)
+				require.Equal(t, swap.Preimage, swaps[0].Preimage)
+				require.Equal(t, serviceFee, *swaps[0].ServiceFee)
+				require.True(t, swaps[0].IsAuto)
+				require.Equal(t, createdAt.Unix(), swaps[0].CreatedAt.Unix())
+
+				isAuto := true
+				stats, err := db.QueryStats(SwapQuery{IsAuto: &isAuto})
+				require.NoError(t, err)
+				require.Equal(t, uint64(1), stats.Count)
+				require.Equal(t, swap.ExpectedAmount, stats.TotalAmount)
+			})
+
+			t.Run("ReverseSwaps", func(t *testing.T) {
+				reverseSwap := ReverseSwap{
+					Id:         "reverse",
+					Pair:       boltz.PairBtc,
+					PrivateKey: privateKey,
+					Preimage:   []byte{4, 5, 6},
+					CreatedAt:  createdAt,
+				}
+				require.NoError(t, db.CreateReverseSwap(reverseSwap))
+				require.NoError(t, db.SetReverseSwapRoutingFee(&reverseSwap, 1500))
+
+				queried, err := db.QueryReverseSwap(reverseSwap.Id)
+				require.NoError(t, err)
+				require.Equal(t, uint64(1500), *queried.RoutingFeeMsat)
+			})
+
+			t.Run("ChainSwaps", func(t *testing.T) {
+				chainSwap := ChainSwap{
+					Id:        "chain",
+					Pair:      boltz.PairBtc,
+					CreatedAt: createdAt,
+					FromData:  &ChainSwapData{Id: "chain", Currency: boltz.CurrencyBtc, PrivateKey: privateKey, LockupAddress: "from"},
+					ToData:    &ChainSwapData{Id: "chain", Currency: boltz.CurrencyLiquid, PrivateKey: privateKey, LockupAddress: "to"},
+				}
+				require.NoError(t, db.CreateChainSwap(chainSwap))
+
+				chainSwaps, err := db.QueryChainSwaps(SwapQuery{Search: "to"})
+				require.NoError(t, err)
+				require.Len(t, chainSwaps, 1)
+				require.Equal(t, "from", chainSwaps[0].FromData.LockupAddress)
+			})
+
+			t.Run("Budget", func(t *testing.T) {
+				interval := BudgetInterval{StartDate: createdAt, EndDate: createdAt.Add(time.Hour)}
+				require.NoError(t, db.CreateBudget(interval))
+
+				current, err := db.QueryCurrentBudgetInterval()
+				require.NoError(t, err)
+				require.Equal(t, interval.EndDate.Unix(), current.EndDate.Unix())
+			})
+
+			t.Run("Macaroons", func(t *testing.T) {
+				macaroon := Macaroon{Id: []byte{1}, RootKey: []byte{2}}
+				require.NoError(t, db.CreateMacaroon(macaroon))
+
+				queried, err := db.QueryMacaroon(macaroon.Id)
+				require.NoError(t, err)
+				require.Equal(t, macaroon, *queried)
+			})
+
+			t.Run("Wallets", func(t *testing.T) {
+				credentials := &wallet.Credentials{Name: "wallet", Currency: boltz.CurrencyBtc, Xpub: "xpub"}
+				require.NoError(t, db.InsertWalletCredentials(credentials))
+				require.NoError(t, db.SetWalletSubaccount(credentials.Name, string(credentials.Currency), 1))
+
+				queried, err := db.GetWalletCredentials(credentials.Name)
+				require.NoError(t, err)
+				require.Equal(t, uint64(1), *queried.Subaccount)
+			})
+
+			t.Run("Transaction", func(t *testing.T) {
+				tx, err := db.BeginTx()
+				require.NoError(t, err)
+				require.NoError(t, tx.CreateSwap(Swap{Id: "rolledBack", Pair: boltz.PairBtc, PrivateKey: privateKey}))
+				require.Error(t, tx.Rollback(errors.New("rollback")))
+
+				_, err = db.QuerySwap("rolledBack")
+				require.Error(t, err)
+			})
+		})
+	}
+}
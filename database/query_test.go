@@ -0,0 +1,105 @@
+package database
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwapQuery(t *testing.T) {
+	db := Database{Path: ":memory:"}
+	require.NoError(t, db.Connect())
+
+	privateKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	start := time.Unix(1700000000, 0)
+	paymentHash, err := hex.DecodeString("a1164fdb247b47931ed41fa1bd53391205406aa723adf4fda10b9ed013001016")
+	require.NoError(t, err)
+
+	// two swaps share the same creation date so that the id has to break the tie
+	swaps := []Swap{
+		{Id: "swapA", CreatedAt: start, LockupTransactionId: "lockup", Label: "rent", ExternalId: "order"},
+		{Id: "swapC", CreatedAt: start.Add(time.Minute)},
+		{Id: "swapB", CreatedAt: start.Add(time.Minute)},
+		{Id: "other", CreatedAt: start.Add(2 * time.Minute), PaymentHash: paymentHash},
+	}
+	for _, swap := range swaps {
+		swap.PrivateKey = privateKey
+		swap.Pair = boltz.PairBtc
+		require.NoError(t, db.CreateSwap(swap))
+	}
+
+	chainSwap := ChainSwap{
+		Id:        "chain",
+		Pair:      boltz.PairBtc,
+		CreatedAt: start,
+		FromData:  &ChainSwapData{Id: "chain", Currency: boltz.CurrencyBtc, PrivateKey: privateKey, LockupAddress: "from"},
+		ToData:    &ChainSwapData{Id: "chain", Currency: boltz.CurrencyLiquid, PrivateKey: privateKey, LockupAddress: "to"},
+	}
+	require.NoError(t, db.CreateChainSwap(chainSwap))
+
+	ids := func(t *testing.T, query SwapQuery) (result []string) {
+		swaps, err := db.QuerySwaps(query)
+		require.NoError(t, err)
+		for _, swap := range swaps {
+			result = append(result, swap.Id)
+		}
+		return result
+	}
+
+	t.Run("Order", func(t *testing.T) {
+		require.Equal(t, []string{"swapA", "swapB", "swapC", "other"}, ids(t, SwapQuery{}))
+		require.Equal(t, []string{"other", "swapC", "swapB", "swapA"}, ids(t, SwapQuery{Descending: true}))
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		require.Equal(t, []string{"swapA", "swapB"}, ids(t, SwapQuery{Limit: 2}))
+		after := &SwapCursor{CreatedAt: start.Add(time.Minute), Id: "swapB"}
+		require.Equal(t, []string{"swapC", "other"}, ids(t, SwapQuery{Limit: 2, After: after}))
+		require.Equal(t, []string{"swapA"}, ids(t, SwapQuery{After: after, Descending: true}))
+	})
+
+	t.Run("Dates", func(t *testing.T) {
+		require.Equal(t, []string{"swapB", "swapC"}, ids(t, SwapQuery{Since: start.Add(time.Second), Until: start.Add(time.Minute)}))
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		require.Equal(t, []string{"swapA", "swapB", "swapC"}, ids(t, SwapQuery{Search: "swap"}))
+		require.Equal(t, []string{"swapA"}, ids(t, SwapQuery{Search: "lockup"}))
+		require.Equal(t, []string{"other"}, ids(t, SwapQuery{Search: hex.EncodeToString(paymentHash)}))
+		require.Empty(t, ids(t, SwapQuery{Search: "sw_p"}))
+
+		chainSwaps, err := db.QueryChainSwaps(SwapQuery{Search: "to"})
+		require.NoError(t, err)
+		require.Len(t, chainSwaps, 1)
+		require.Equal(t, chainSwap.Id, chainSwaps[0].Id)
+	})
+	t.Run("Label", func(t *testing.T) {
+		require.Equal(t, []string{"swapA"}, ids(t, SwapQuery{Label: "rent"}))
+		require.Equal(t, []string{"swapA"}, ids(t, SwapQuery{ExternalId: "order"}))
+
+		label := "groceries"
+		require.NoError(t, db.UpdateSwapLabel("swapB", &label, nil))
+		require.Equal(t, []string{"swapB"}, ids(t, SwapQuery{Label: label}))
+
+		// only the external id changes
+		externalId := "invoice"
+		require.NoError(t, db.UpdateSwapLabel("swapB", nil, &externalId))
+		swap, err := db.QuerySwap("swapB")
+		require.NoError(t, err)
+		require.Equal(t, label, swap.Label)
+		require.Equal(t, externalId, swap.ExternalId)
+
+		require.NoError(t, db.UpdateSwapLabel(chainSwap.Id, &label, nil))
+		chainSwaps, err := db.QueryChainSwaps(SwapQuery{Label: label})
+		require.NoError(t, err)
+		require.Len(t, chainSwaps, 1)
+
+		require.ErrorIs(t, db.UpdateSwapLabel("unknown", &label, nil), ErrSwapNotFound)
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/BoltzExchange/boltz-client/lightning"
+	"github.com/BoltzExchange/boltz-client/onchain"
 	"github.com/BoltzExchange/boltz-client/utils"
 
 	"github.com/BoltzExchange/boltz-client/boltz"
@@ -28,6 +29,7 @@ type ReverseSwap struct {
 	RefundPubKey        *btcec.PublicKey
 	SwapTree            *boltz.SwapTree
 	Preimage            []byte
+	PaymentHash         []byte
 	RedeemScript        []byte
 	Invoice             string
 	ClaimAddress        string
@@ -42,6 +44,14 @@ type ReverseSwap struct {
 	ServiceFeePercent   utils.Percentage
 	OnchainFee          *uint64
 	ExternalPay         bool
+	FeePolicy           *onchain.FeePolicy
+	// endpoint of the Boltz API the swap was created with; empty for swaps created before it was stored
+	BoltzUrl string
+	// amount which has to arrive at the claim address after all fees; 0 if the invoice amount was chosen instead
+	TargetAmount uint64
+	// free form description and reference in an external system set by the user; empty if not set
+	Label      string
+	ExternalId string
 }
 
 type ReverseSwapSerialized struct {
@@ -70,6 +80,8 @@ type ReverseSwapSerialized struct {
 	ServiceFeePercent   utils.Percentage
 	OnchainFee          *uint64
 	ExternalPay         bool
+	FeePolicy           *onchain.FeePolicy
+	TargetAmount        uint64
 }
 
 func (reverseSwap *ReverseSwap) Serialize() ReverseSwapSerialized {
@@ -98,9 +110,19 @@ func (reverseSwap *ReverseSwap) Serialize() ReverseSwapSerialized {
 		ServiceFeePercent:   reverseSwap.ServiceFeePercent,
 		OnchainFee:          reverseSwap.OnchainFee,
 		ExternalPay:         reverseSwap.ExternalPay,
+		FeePolicy:           reverseSwap.FeePolicy,
+		TargetAmount:        reverseSwap.TargetAmount,
 	}
 }
 
+// Redacted returns a copy without the private key, preimage and blinding key, which may be logged
+func (reverseSwap ReverseSwapSerialized) Redacted() ReverseSwapSerialized {
+	reverseSwap.PrivateKey = ""
+	reverseSwap.Preimage = ""
+	reverseSwap.BlindingKey = ""
+	return reverseSwap
+}
+
 func (reverseSwap *ReverseSwap) InitTree() error {
 	return reverseSwap.SwapTree.Init(
 		reverseSwap.Pair.To == boltz.CurrencyLiquid,
@@ -109,19 +131,21 @@ func (reverseSwap *ReverseSwap) InitTree() error {
 	)
 }
 
-func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
+func (database *Database) parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 	var reverseSwap ReverseSwap
 
 	var status string
 	var privateKey PrivateKeyScanner
-	var preimage string
+	var preimage, paymentHash string
 	var redeemScript string
 	blindingKey := PrivateKeyScanner{Nullable: true}
 	var createdAt, serviceFee, onchainFee, routingFeeMsat sql.NullInt64
 	var externalPay sql.NullBool
+	var boltzUrl sql.NullString
 	swapTree := JsonScanner[*boltz.SerializedTree]{Nullable: true}
 	refundPubKey := PublicKeyScanner{Nullable: true}
 	chanIds := JsonScanner[[]lightning.ChanId]{Nullable: true}
+	feePolicy := JsonScanner[*onchain.FeePolicy]{Nullable: true}
 
 	err := scanRow(
 		rows,
@@ -134,10 +158,10 @@ func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 			"error":               &reverseSwap.Error,
 			"status":              &status,
 			"acceptZeroConf":      &reverseSwap.AcceptZeroConf,
-			"privateKey":          &privateKey,
+			"privateKey":          database.secretScanner(&privateKey),
 			"refundPubKey":        &refundPubKey,
 			"swapTree":            &swapTree,
-			"preimage":            &preimage,
+			"preimage":            database.secretScanner(&preimage),
 			"redeemScript":        &redeemScript,
 			"invoice":             &reverseSwap.Invoice,
 			"claimAddress":        &reverseSwap.ClaimAddress,
@@ -145,7 +169,7 @@ func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 			"timeoutBlockheight":  &reverseSwap.TimeoutBlockHeight,
 			"lockupTransactionId": &reverseSwap.LockupTransactionId,
 			"claimTransactionId":  &reverseSwap.ClaimTransactionId,
-			"blindingKey":         &blindingKey,
+			"blindingKey":         database.secretScanner(&blindingKey),
 			"isAuto":              &reverseSwap.IsAuto,
 			"routingFeeMsat":      &routingFeeMsat,
 			"serviceFee":          &serviceFee,
@@ -153,6 +177,12 @@ func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 			"onchainFee":          &onchainFee,
 			"createdAt":           &createdAt,
 			"externalPay":         &externalPay,
+			"feePolicy":           &feePolicy,
+			"boltzUrl":            &boltzUrl,
+			"targetAmount":        &reverseSwap.TargetAmount,
+			"paymentHash":         &paymentHash,
+			"label":               &reverseSwap.Label,
+			"externalId":          &reverseSwap.ExternalId,
 		},
 	)
 
@@ -165,6 +195,8 @@ func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 	reverseSwap.RoutingFeeMsat = parseNullInt(routingFeeMsat)
 	reverseSwap.Status = boltz.ParseEvent(status)
 	reverseSwap.ChanIds = chanIds.Value
+	reverseSwap.FeePolicy = feePolicy.Value
+	reverseSwap.BoltzUrl = boltzUrl.String
 
 	reverseSwap.PrivateKey = privateKey.Value
 	reverseSwap.BlindingKey = blindingKey.Value
@@ -175,6 +207,11 @@ func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 		return nil, err
 	}
 
+	reverseSwap.PaymentHash, err = hex.DecodeString(paymentHash)
+	if err != nil {
+		return nil, err
+	}
+
 	reverseSwap.RedeemScript, err = hex.DecodeString(redeemScript)
 	if err != nil {
 		return nil, err
@@ -198,6 +235,8 @@ func parseReverseSwap(rows *sql.Rows) (*ReverseSwap, error) {
 }
 
 func (database *Database) QueryReverseSwap(id string) (reverseSwap *ReverseSwap, err error) {
+	database.secretsLock.RLock()
+	defer database.secretsLock.RUnlock()
 	database.lock.Lock()
 	defer database.lock.Unlock()
 	// TODO: avoid "SELECT *" to be compatible with migrations (or work with columns in parse functions?)
@@ -210,7 +249,7 @@ func (database *Database) QueryReverseSwap(id string) (reverseSwap *ReverseSwap,
 	defer rows.Close()
 
 	if rows.Next() {
-		reverseSwap, err = parseReverseSwap(rows)
+		reverseSwap, err = database.parseReverseSwap(rows)
 
 		if err != nil {
 			return reverseSwap, err
@@ -223,6 +262,8 @@ func (database *Database) QueryReverseSwap(id string) (reverseSwap *ReverseSwap,
 }
 
 func (database *Database) queryReverseSwaps(query string, values ...any) (swaps []ReverseSwap, err error) {
+	database.secretsLock.RLock()
+	defer database.secretsLock.RUnlock()
 	database.lock.RLock()
 	defer database.lock.RUnlock()
 	rows, err := database.Query(query, values...)
@@ -234,7 +275,7 @@ func (database *Database) queryReverseSwaps(query string, values ...any) (swaps
 	defer rows.Close()
 
 	for rows.Next() {
-		swap, err := parseReverseSwap(rows)
+		swap, err := database.parseReverseSwap(rows)
 
 		if err != nil {
 			return nil, err
@@ -246,9 +287,11 @@ func (database *Database) queryReverseSwaps(query string, values ...any) (swaps
 	return swaps, err
 }
 
+const reverseSwapSearchCondition = "paymentHash = ? OR invoice = ? OR lockupTransactionId = ? OR claimTransactionId = ?"
+
 func (database *Database) QueryReverseSwaps(args SwapQuery) ([]ReverseSwap, error) {
-	where, values := args.ToWhereClause()
-	return database.queryReverseSwaps("SELECT * FROM reverseSwaps"+where, values...)
+	query, values := args.toQuery("reverseSwaps", reverseSwapSearchCondition)
+	return database.queryReverseSwaps(query, values...)
 }
 
 func (database *Database) QueryPendingReverseSwaps() ([]ReverseSwap, error) {
@@ -265,11 +308,21 @@ const insertReverseSwapStatement = `
 INSERT INTO reverseSwaps (id, fromCurrency, toCurrency, chanIds, state, error, status, acceptZeroConf, privateKey, preimage, redeemScript,
                           invoice, claimAddress, expectedAmount, timeoutBlockheight, lockupTransactionId,
                           claimTransactionId, blindingKey, isAuto, createdAt, routingFeeMsat, serviceFee,
-                          serviceFeePercent, onchainFee, refundPubKey, swapTree, externalPay)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+                          serviceFeePercent, onchainFee, refundPubKey, swapTree, externalPay, feePolicy,
+                          boltzUrl, targetAmount, paymentHash, label, externalId)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 func (database *Database) CreateReverseSwap(reverseSwap ReverseSwap) error {
+	database.secretsLock.RLock()
+	defer database.secretsLock.RUnlock()
+
+	privateKey, blindingKey := formatPrivateKey(reverseSwap.PrivateKey), formatPrivateKey(reverseSwap.BlindingKey)
+	preimage := hex.EncodeToString(reverseSwap.Preimage)
+	if err := database.encryptSecrets(&privateKey, &preimage, &blindingKey); err != nil {
+		return err
+	}
+
 	_, err := database.Exec(
 		insertReverseSwapStatement,
 		reverseSwap.Id,
@@ -280,8 +333,8 @@ func (database *Database) CreateReverseSwap(reverseSwap ReverseSwap) error {
 		reverseSwap.Error,
 		reverseSwap.Status.String(),
 		reverseSwap.AcceptZeroConf,
-		formatPrivateKey(reverseSwap.PrivateKey),
-		hex.EncodeToString(reverseSwap.Preimage),
+		privateKey,
+		preimage,
 		hex.EncodeToString(reverseSwap.RedeemScript),
 		reverseSwap.Invoice,
 		reverseSwap.ClaimAddress,
@@ -289,7 +342,7 @@ func (database *Database) CreateReverseSwap(reverseSwap ReverseSwap) error {
 		reverseSwap.TimeoutBlockHeight,
 		reverseSwap.LockupTransactionId,
 		reverseSwap.ClaimTransactionId,
-		formatPrivateKey(reverseSwap.BlindingKey),
+		blindingKey,
 		reverseSwap.IsAuto,
 		FormatTime(reverseSwap.CreatedAt),
 		reverseSwap.RoutingFeeMsat,
@@ -299,38 +352,55 @@ func (database *Database) CreateReverseSwap(reverseSwap ReverseSwap) error {
 		formatPublicKey(reverseSwap.RefundPubKey),
 		formatJson(reverseSwap.SwapTree.Serialize()),
 		reverseSwap.ExternalPay,
+		formatJson(reverseSwap.FeePolicy),
+		reverseSwap.BoltzUrl,
+		reverseSwap.TargetAmount,
+		hex.EncodeToString(reverseSwap.PaymentHash),
+		reverseSwap.Label,
+		reverseSwap.ExternalId,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	return database.createStateEvent(reverseSwap.Id, reverseSwap.Pair.To, reverseSwap.State, reverseSwap.Error)
 }
 
 func (database *Database) UpdateReverseSwapState(reverseSwap *ReverseSwap, state boltzrpc.SwapState, error string) error {
 	reverseSwap.State = state
 	reverseSwap.Error = error
 
-	_, err := database.Exec("UPDATE reverseSwaps SET state = ?, error = ? WHERE id = ?", state, error, reverseSwap.Id)
-	return err
+	if _, err := database.Exec("UPDATE reverseSwaps SET state = ?, error = ? WHERE id = ?", state, error, reverseSwap.Id); err != nil {
+		return err
+	}
+	return database.createStateEvent(reverseSwap.Id, reverseSwap.Pair.To, state, error)
 }
 
 func (database *Database) UpdateReverseSwapStatus(reverseSwap *ReverseSwap, status boltz.SwapUpdateEvent) error {
 	reverseSwap.Status = status
 
-	_, err := database.Exec("UPDATE reverseSwaps SET status = ? WHERE id = ?", status.String(), reverseSwap.Id)
-	return err
+	if _, err := database.Exec("UPDATE reverseSwaps SET status = ? WHERE id = ?", status.String(), reverseSwap.Id); err != nil {
+		return err
+	}
+	return database.createStatusEvent(reverseSwap.Id, reverseSwap.Pair.To, status)
 }
 
 func (database *Database) SetReverseSwapLockupTransactionId(reverseSwap *ReverseSwap, lockupTransactionId string) error {
 	reverseSwap.LockupTransactionId = lockupTransactionId
 
-	_, err := database.Exec("UPDATE reverseSwaps SET lockupTransactionId = ? WHERE id = ?", lockupTransactionId, reverseSwap.Id)
-	return err
+	if _, err := database.Exec("UPDATE reverseSwaps SET lockupTransactionId = ? WHERE id = ?", lockupTransactionId, reverseSwap.Id); err != nil {
+		return err
+	}
+	return database.createTransactionEvent(reverseSwap.Id, boltzrpc.SwapEventType_LOCKUP_TRANSACTION, reverseSwap.Pair.To, lockupTransactionId, nil)
 }
 
 func (database *Database) SetReverseSwapClaimTransactionId(reverseSwap *ReverseSwap, claimTransactionId string, fee uint64) error {
 	reverseSwap.ClaimTransactionId = claimTransactionId
 	reverseSwap.OnchainFee = &fee
 
-	_, err := database.Exec("UPDATE reverseSwaps SET claimTransactionId = ?, onchainFee = ? WHERE id = ?", claimTransactionId, fee, reverseSwap.Id)
-	return err
+	if _, err := database.Exec("UPDATE reverseSwaps SET claimTransactionId = ?, onchainFee = ? WHERE id = ?", claimTransactionId, fee, reverseSwap.Id); err != nil {
+		return err
+	}
+	return database.createTransactionEvent(reverseSwap.Id, boltzrpc.SwapEventType_CLAIM_TRANSACTION, reverseSwap.Pair.To, claimTransactionId, &fee)
 }
 
 func (database *Database) SetReverseSwapRoutingFee(reverseSwap *ReverseSwap, feeMsat uint64) error {
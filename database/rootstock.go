@@ -0,0 +1,42 @@
+package database
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+// QueryRootstockKey returns the private key of the rootstock wallet or nil if none was created yet
+func (database *Database) QueryRootstockKey() (*btcec.PrivateKey, error) {
+	database.secretsLock.RLock()
+	defer database.secretsLock.RUnlock()
+	database.lock.RLock()
+	defer database.lock.RUnlock()
+
+	var privateKey string
+	err := database.QueryRow("SELECT privateKey FROM rootstockWallet").Scan(database.secretScanner(&privateKey))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return ParsePrivateKey(privateKey)
+}
+
+// CreateRootstockKey stores the private key of the rootstock wallet, which is encrypted like the swap secrets
+func (database *Database) CreateRootstockKey(key *btcec.PrivateKey) error {
+	database.secretsLock.RLock()
+	defer database.secretsLock.RUnlock()
+
+	privateKey := formatPrivateKey(key)
+	if err := database.encryptSecrets(&privateKey); err != nil {
+		return err
+	}
+	_, err := database.Exec(
+		"INSERT INTO rootstockWallet (publicKey, privateKey) VALUES (?, ?)",
+		formatPublicKey(key.PubKey()),
+		privateKey,
+	)
+	return err
+}
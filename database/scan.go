@@ -2,6 +2,7 @@ package database
 
 import (
 	"database/sql"
+	"strings"
 )
 
 // TODO: optimize (query columns only once per query (improves speed by like a microsecond per row so low priority))
@@ -15,7 +16,17 @@ func scanRow(row *sql.Rows, rowValues map[string]interface{}) error {
 	var values []interface{}
 
 	for _, column := range columns {
-		values = append(values, rowValues[column])
+		value, ok := rowValues[column]
+		if !ok {
+			// postgres folds the unquoted column names to lower case
+			for name, candidate := range rowValues {
+				if strings.EqualFold(name, column) {
+					value = candidate
+					break
+				}
+			}
+		}
+		values = append(values, value)
 	}
 
 	err = row.Scan(values...)
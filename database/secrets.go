@@ -0,0 +1,309 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/BoltzExchange/boltz-client/logger"
+	"github.com/BoltzExchange/boltz-client/onchain/wallet"
+)
+
+// The private keys, preimages and blinding keys of swaps and the key of the rootstock wallet can be encrypted with the wallet password.
+// Either all of them are encrypted with a key derived from the salt in the secretsEncryption table or none of them is.
+
+var ErrWrongPassword = errors.New("wrong password")
+
+var errSecretsLocked = errors.New("swap secrets are encrypted, unlock boltzd to access them")
+
+// known plaintext which is stored encrypted to verify the password even when there are no swaps yet
+const secretsVerifier = "boltz"
+
+// secretsState is the in memory state of the encryption of the secret columns
+type secretsState struct {
+	salt string
+	// nil if the secrets are not encrypted or the password is not known yet
+	cipher *wallet.Cipher
+}
+
+type secretColumns struct {
+	table   string
+	keys    []string
+	columns []string
+}
+
+var secretTables = []secretColumns{
+	{table: "swaps", keys: []string{"id"}, columns: []string{"privateKey", "preimage", "blindingKey"}},
+	{table: "reverseSwaps", keys: []string{"id"}, columns: []string{"privateKey", "preimage", "blindingKey"}},
+	{table: "chainSwaps", keys: []string{"id"}, columns: []string{"preimage"}},
+	{table: "chainSwapsData", keys: []string{"id", "currency"}, columns: []string{"privateKey", "blindingKey"}},
+	{table: "rootstockWallet", keys: []string{"publicKey"}, columns: []string{"privateKey"}},
+}
+
+// convertSecret decrypts a value with from and encrypts it with to; a nil cipher means plaintext
+func convertSecret(value string, from *wallet.Cipher, to *wallet.Cipher) (string, error) {
+	if value == "" {
+		return value, nil
+	}
+	if from != nil {
+		encrypted, err := hex.DecodeString(value)
+		if err != nil {
+			return "", err
+		}
+		decrypted, err := from.Decrypt(encrypted)
+		if err != nil {
+			return "", err
+		}
+		value = string(decrypted)
+	}
+	if to != nil {
+		encrypted, err := to.Encrypt([]byte(value))
+		if err != nil {
+			return "", err
+		}
+		value = hex.EncodeToString(encrypted)
+	}
+	return value, nil
+}
+
+func (database *Database) getSecrets() *secretsState {
+	if state := database.secrets.Load(); state != nil {
+		return state
+	}
+	return &secretsState{}
+}
+
+func (database *Database) loadSecrets() error {
+	var salt string
+	err := database.QueryRow("SELECT salt FROM secretsEncryption").Scan(&salt)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	database.secrets.Store(&secretsState{salt: salt})
+	return nil
+}
+
+func (database *Database) encryptSecrets(values ...*string) error {
+	state := database.getSecrets()
+	if state.salt != "" && state.cipher == nil {
+		return errSecretsLocked
+	}
+	for _, value := range values {
+		encrypted, err := convertSecret(*value, nil, state.cipher)
+		if err != nil {
+			return err
+		}
+		*value = encrypted
+	}
+	return nil
+}
+
+func (database *Database) decryptSecret(value string) (string, error) {
+	state := database.getSecrets()
+	if state.salt != "" && state.cipher == nil {
+		return "", errSecretsLocked
+	}
+	decrypted, err := convertSecret(value, state.cipher, nil)
+	if err != nil {
+		return "", fmt.Errorf("could not decrypt swap secret: %w", err)
+	}
+	return decrypted, nil
+}
+
+// secretScanner decrypts a secret column before it is scanned into its destination, which is either a string or a sql.Scanner
+type secretScanner struct {
+	database *Database
+	dest     any
+}
+
+func (database *Database) secretScanner(dest any) *secretScanner {
+	return &secretScanner{database: database, dest: dest}
+}
+
+func (s *secretScanner) Scan(src any) error {
+	if value, ok := src.(string); ok {
+		decrypted, err := s.database.decryptSecret(value)
+		if err != nil {
+			return err
+		}
+		src = decrypted
+	}
+	switch dest := s.dest.(type) {
+	case sql.Scanner:
+		return dest.Scan(src)
+	case *string:
+		if value, ok := src.(string); ok {
+			*dest = value
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported type: %T", src)
+}
+
+// secretsCipher returns the cipher the secrets are currently encrypted with; nil if they are not encrypted
+func (database *Database) secretsCipher(password string) (*wallet.Cipher, error) {
+	var salt, verifier string
+	err := database.QueryRow("SELECT salt, verifier FROM secretsEncryption").Scan(&salt, &verifier)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	cipher, err := wallet.NewCipher(password, salt)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := convertSecret(verifier, cipher, nil); err != nil {
+		return nil, ErrWrongPassword
+	}
+	return cipher, nil
+}
+
+// VerifySecretsPassword returns ErrWrongPassword if the swap secrets are encrypted with a different password
+func (database *Database) VerifySecretsPassword(password string) error {
+	_, err := database.secretsCipher(password)
+	return err
+}
+
+// UnlockSecrets makes the swap secrets accessible with the wallet password.
+// Existing swaps are encrypted or decrypted here when EncryptSecrets was changed since the last unlock.
+func (database *Database) UnlockSecrets(password string) error {
+	return database.setSecretsPassword(password, password, false, nil)
+}
+
+// ChangeSecretsPassword encrypts the swap secrets with the new wallet password.
+// within, if not nil, is run in the same transaction so that other data encrypted with the password can be changed atomically.
+func (database *Database) ChangeSecretsPassword(old string, new string, within func(tx *Transaction) error) error {
+	return database.setSecretsPassword(old, new, true, within)
+}
+
+func (database *Database) setSecretsPassword(old string, new string, changed bool, within func(tx *Transaction) error) error {
+	// secret columns may not be read or written until the new state is published
+	database.secretsLock.Lock()
+	defer database.secretsLock.Unlock()
+	database.lock.Lock()
+	defer database.lock.Unlock()
+
+	current, err := database.secretsCipher(old)
+	if err != nil {
+		return err
+	}
+
+	encrypt := database.EncryptSecrets && new != ""
+	if database.EncryptSecrets && new == "" {
+		logger.Warn("Swap secrets are only encrypted once a wallet password is set")
+	}
+
+	convert := (current == nil && encrypt) || (current != nil && (!encrypt || changed))
+	if !convert && !changed {
+		database.secrets.Store(&secretsState{salt: database.getSecrets().salt, cipher: current})
+		return nil
+	}
+
+	state := &secretsState{salt: database.getSecrets().salt, cipher: current}
+	var verifier string
+	if convert {
+		state = &secretsState{}
+		if encrypt {
+			if state.salt, err = wallet.GenerateSalt(); err != nil {
+				return err
+			}
+			if state.cipher, err = wallet.NewCipher(new, state.salt); err != nil {
+				return err
+			}
+			if verifier, err = convertSecret(secretsVerifier, nil, state.cipher); err != nil {
+				return err
+			}
+		}
+	}
+
+	tx, err := database.BeginTx()
+	if err != nil {
+		return err
+	}
+	tx.secrets.Store(state)
+	if convert {
+		for _, secret := range secretTables {
+			if err := tx.convertSecrets(secret, current, state.cipher); err != nil {
+				return tx.Rollback(fmt.Errorf("could not convert secrets of %s: %w", secret.table, err))
+			}
+		}
+		if _, err := tx.Exec("DELETE FROM secretsEncryption"); err != nil {
+			return tx.Rollback(err)
+		}
+		if encrypt {
+			if _, err := tx.Exec("INSERT INTO secretsEncryption (salt, verifier) VALUES (?, ?)", state.salt, verifier); err != nil {
+				return tx.Rollback(err)
+			}
+		}
+	}
+	if within != nil {
+		if err := within(tx); err != nil {
+			return tx.Rollback(err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	database.secrets.Store(state)
+	if convert && encrypt {
+		logger.Info("Encrypted swap secrets")
+	} else if convert {
+		logger.Info("Decrypted swap secrets")
+	}
+	return nil
+}
+
+func (transaction *Transaction) convertSecrets(secret secretColumns, from *wallet.Cipher, to *wallet.Cipher) error {
+	columns := append(append([]string{}, secret.keys...), secret.columns...)
+	rows, err := transaction.Query("SELECT " + strings.Join(columns, ", ") + " FROM " + secret.table)
+	if err != nil {
+		return err
+	}
+
+	var updates [][]any
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			rows.Close()
+			return err
+		}
+
+		keys, secretValues := values[:len(secret.keys)], values[len(secret.keys):]
+		var update []any
+		for _, value := range secretValues {
+			if value.Valid {
+				if value.String, err = convertSecret(value.String, from, to); err != nil {
+					rows.Close()
+					return err
+				}
+			}
+			update = append(update, value)
+		}
+		for _, key := range keys {
+			update = append(update, key)
+		}
+		updates = append(updates, update)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// the rows can only be updated after they are closed
+	query := "UPDATE " + secret.table + " SET " + strings.Join(secret.columns, " = ?, ") + " = ? WHERE " +
+		strings.Join(secret.keys, " = ? AND ") + " = ?"
+	for _, update := range updates {
+		if _, err := transaction.Exec(query, update...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+package database
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecrets(t *testing.T) {
+	db := Database{Path: ":memory:", EncryptSecrets: true}
+	require.NoError(t, db.Connect())
+
+	privateKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	preimage := []byte{1, 2, 3}
+
+	rawPrivateKey := func(t *testing.T, table string, id string) (value string) {
+		require.NoError(t, db.QueryRow("SELECT privateKey FROM "+table+" WHERE id = ?", id).Scan(&value))
+		return value
+	}
+	requireSecrets := func(t *testing.T) {
+		swap, err := db.QuerySwap("swap")
+		require.NoError(t, err)
+		require.Equal(t, privateKey, swap.PrivateKey)
+		require.Equal(t, preimage, swap.Preimage)
+
+		reverseSwap, err := db.QueryReverseSwap("reverse")
+		require.NoError(t, err)
+		require.Equal(t, privateKey, reverseSwap.PrivateKey)
+		require.Equal(t, preimage, reverseSwap.Preimage)
+
+		chainSwap, err := db.QueryChainSwap("chain")
+		require.NoError(t, err)
+		require.Equal(t, preimage, chainSwap.Preimage)
+		require.Equal(t, privateKey, chainSwap.FromData.PrivateKey)
+
+		rootstockKey, err := db.QueryRootstockKey()
+		require.NoError(t, err)
+		require.Equal(t, privateKey, rootstockKey)
+	}
+	rawRootstockKey := func(t *testing.T) (value string) {
+		require.NoError(t, db.QueryRow("SELECT privateKey FROM rootstockWallet").Scan(&value))
+		return value
+	}
+
+	require.NoError(t, db.CreateSwap(Swap{Id: "swap", Pair: boltz.PairBtc, PrivateKey: privateKey, Preimage: preimage}))
+	require.NoError(t, db.CreateReverseSwap(ReverseSwap{Id: "reverse", Pair: boltz.PairBtc, PrivateKey: privateKey, Preimage: preimage}))
+	require.NoError(t, db.CreateChainSwap(ChainSwap{
+		Id:       "chain",
+		Pair:     boltz.PairBtc,
+		Preimage: preimage,
+		FromData: &ChainSwapData{Id: "chain", Currency: boltz.CurrencyBtc, PrivateKey: privateKey},
+		ToData:   &ChainSwapData{Id: "chain", Currency: boltz.CurrencyLiquid, PrivateKey: privateKey},
+	}))
+	rootstockKey, err := db.QueryRootstockKey()
+	require.NoError(t, err)
+	require.Nil(t, rootstockKey)
+	require.NoError(t, db.CreateRootstockKey(privateKey))
+
+	// nothing can be encrypted without a wallet password
+	require.NoError(t, db.UnlockSecrets(""))
+	require.Equal(t, formatPrivateKey(privateKey), rawPrivateKey(t, "swaps", "swap"))
+
+	password := "password"
+	require.NoError(t, db.UnlockSecrets(password))
+	require.NotEqual(t, formatPrivateKey(privateKey), rawPrivateKey(t, "swaps", "swap"))
+	require.NotEqual(t, formatPrivateKey(privateKey), rawPrivateKey(t, "chainSwapsData", "chain"))
+	require.NotEqual(t, formatPrivateKey(privateKey), rawRootstockKey(t))
+	requireSecrets(t)
+
+	t.Run("Create", func(t *testing.T) {
+		require.NoError(t, db.CreateSwap(Swap{Id: "new", Pair: boltz.PairBtc, PrivateKey: privateKey}))
+		require.NotEqual(t, formatPrivateKey(privateKey), rawPrivateKey(t, "swaps", "new"))
+
+		swap, err := db.QuerySwap("new")
+		require.NoError(t, err)
+		require.Equal(t, privateKey, swap.PrivateKey)
+	})
+
+	t.Run("Locked", func(t *testing.T) {
+		// like after a restart
+		require.NoError(t, db.loadSecrets())
+		_, err := db.QuerySwap("swap")
+		require.ErrorIs(t, err, errSecretsLocked)
+		require.ErrorIs(t, db.CreateSwap(Swap{Id: "locked", Pair: boltz.PairBtc, PrivateKey: privateKey}), errSecretsLocked)
+		_, err = db.QueryRootstockKey()
+		require.ErrorIs(t, err, errSecretsLocked)
+
+		require.ErrorIs(t, db.VerifySecretsPassword("wrong"), ErrWrongPassword)
+		require.ErrorIs(t, db.UnlockSecrets("wrong"), ErrWrongPassword)
+		require.NoError(t, db.VerifySecretsPassword(password))
+		require.NoError(t, db.UnlockSecrets(password))
+		requireSecrets(t)
+	})
+
+	t.Run("ChangePassword", func(t *testing.T) {
+		require.ErrorIs(t, db.ChangeSecretsPassword("wrong", "new", nil), ErrWrongPassword)
+
+		before := rawPrivateKey(t, "swaps", "swap")
+
+		// nothing is changed when the data encrypted along with the secrets can not be updated
+		failed := errors.New("could not update credentials")
+		require.ErrorIs(t, db.ChangeSecretsPassword(password, "new", func(tx *Transaction) error {
+			return failed
+		}), failed)
+		require.Equal(t, before, rawPrivateKey(t, "swaps", "swap"))
+		require.NoError(t, db.VerifySecretsPassword(password))
+		requireSecrets(t)
+
+		// secrets are never written or read with the state of the other password
+		done := make(chan struct{})
+		queried := make(chan error)
+		go func() {
+			defer close(queried)
+			for i := 0; ; i++ {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				id := fmt.Sprint("concurrent", i)
+				err := db.CreateSwap(Swap{Id: id, Pair: boltz.PairBtc, PrivateKey: privateKey})
+				if err == nil {
+					_, err = db.QuerySwap(id)
+				}
+				if err != nil {
+					queried <- err
+					return
+				}
+			}
+		}()
+
+		called := false
+		require.NoError(t, db.ChangeSecretsPassword(password, "new", func(tx *Transaction) error {
+			called = true
+			return nil
+		}))
+		close(done)
+		require.NoError(t, <-queried)
+		require.True(t, called)
+		require.NotEqual(t, before, rawPrivateKey(t, "swaps", "swap"))
+		require.ErrorIs(t, db.VerifySecretsPassword(password), ErrWrongPassword)
+		requireSecrets(t)
+		password = "new"
+	})
+
+	t.Run("Disable", func(t *testing.T) {
+		db.EncryptSecrets = false
+		require.NoError(t, db.UnlockSecrets(password))
+		require.Equal(t, formatPrivateKey(privateKey), rawPrivateKey(t, "swaps", "swap"))
+		require.Equal(t, formatPrivateKey(privateKey), rawPrivateKey(t, "chainSwapsData", "chain"))
+		require.Equal(t, formatPrivateKey(privateKey), rawRootstockKey(t))
+		requireSecrets(t)
+
+		// any password is fine once the secrets are not encrypted anymore
+		require.NoError(t, db.VerifySecretsPassword("wrong"))
+	})
+}
+
+func TestRedacted(t *testing.T) {
+	privateKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	preimage := []byte{1, 2, 3}
+
+	swap := &Swap{Id: "swap", PrivateKey: privateKey, Preimage: preimage, BlindingKey: privateKey}
+	redactedSwap := marshalled(t, swap.Serialize().Redacted())
+	require.Contains(t, redactedSwap, swap.Id)
+
+	reverseSwap := &ReverseSwap{Id: "reverse", PrivateKey: privateKey, Preimage: preimage, BlindingKey: privateKey}
+	redactedReverseSwap := marshalled(t, reverseSwap.Serialize().Redacted())
+	require.Contains(t, redactedReverseSwap, reverseSwap.Id)
+
+	chainSwap := &ChainSwap{
+		Id:       "chain",
+		Preimage: preimage,
+		FromData: &ChainSwapData{Id: "chain", PrivateKey: privateKey, BlindingKey: privateKey},
+		ToData:   &ChainSwapData{Id: "chain", PrivateKey: privateKey},
+	}
+	serialized := chainSwap.Serialize()
+	redactedChainSwap := marshalled(t, serialized.Redacted())
+	require.Contains(t, redactedChainSwap, chainSwap.Id)
+	// the original is left untouched
+	require.Equal(t, formatPrivateKey(privateKey), serialized.FromData.PrivateKey)
+
+	for _, redacted := range []string{redactedSwap, redactedReverseSwap, redactedChainSwap} {
+		require.NotContains(t, redacted, formatPrivateKey(privateKey))
+		require.NotContains(t, redacted, hex.EncodeToString(preimage))
+	}
+}
+
+func marshalled(t *testing.T, value any) string {
+	encoded, err := json.Marshal(value)
+	require.NoError(t, err)
+	return string(encoded)
+}
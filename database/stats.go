@@ -8,10 +8,10 @@ func (database *Database) QueryStats(args SwapQuery) (*boltzrpc.SwapStats, error
 	where, values := args.ToWhereClause()
 	query := `
 		SELECT COALESCE(SUM(serviceFee + onchainFee), 0), COALESCE(SUM(expectedAmount), 0), COUNT(*)
-		FROM (SELECT serviceFee, onchainFee, expectedAmount, isAuto, createdAt
+		FROM (SELECT serviceFee, onchainFee, expectedAmount, isAuto, createdAt, label, externalId
 			  FROM swaps
 			  UNION ALL
-			  SELECT serviceFee, onchainFee + (routingFeeMsat / 1000), expectedAmount, isAuto, createdAt
+			  SELECT serviceFee, onchainFee + (routingFeeMsat / 1000), expectedAmount, isAuto, createdAt, label, externalId
 			  FROM reverseSwaps) stats
 		` + where
 
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/BoltzExchange/boltz-client/lightning"
+	"github.com/BoltzExchange/boltz-client/onchain"
 	"github.com/BoltzExchange/boltz-client/utils"
 
 	"github.com/BoltzExchange/boltz-client/boltz"
@@ -27,6 +28,7 @@ type Swap struct {
 	SwapTree            *boltz.SwapTree
 	ClaimPubKey         *btcec.PublicKey
 	Preimage            []byte
+	PaymentHash         []byte
 	RedeemScript        []byte
 	Invoice             string
 	Address             string
@@ -41,6 +43,16 @@ type Swap struct {
 	ServiceFeePercent   utils.Percentage
 	OnchainFee          *uint64
 	Wallet              string
+	// error returned by boltz the last time a cooperative refund was attempted
+	CooperativeError string
+	// whether the refund transaction spent the key path; nil if not refunded yet
+	RefundCooperative *bool
+	FeePolicy         *onchain.FeePolicy
+	// endpoint of the Boltz API the swap was created with; empty for swaps created before it was stored
+	BoltzUrl string
+	// free form description and reference in an external system set by the user; empty if not set
+	Label      string
+	ExternalId string
 }
 
 type SwapSerialized struct {
@@ -68,6 +80,9 @@ type SwapSerialized struct {
 	ServiceFeePercent   utils.Percentage
 	OnchainFee          *uint64
 	Wallet              string
+	CooperativeError    string
+	RefundCooperative   *bool
+	FeePolicy           *onchain.FeePolicy
 }
 
 func (swap *Swap) BlindingPubKey() *btcec.PublicKey {
@@ -108,9 +123,20 @@ func (swap *Swap) Serialize() SwapSerialized {
 		ServiceFeePercent:   swap.ServiceFeePercent,
 		OnchainFee:          swap.OnchainFee,
 		Wallet:              swap.Wallet,
+		CooperativeError:    swap.CooperativeError,
+		RefundCooperative:   swap.RefundCooperative,
+		FeePolicy:           swap.FeePolicy,
 	}
 }
 
+// Redacted returns a copy without the private key, preimage and blinding key, which may be logged
+func (swap SwapSerialized) Redacted() SwapSerialized {
+	swap.PrivateKey = ""
+	swap.Preimage = ""
+	swap.BlindingKey = ""
+	return swap
+}
+
 func (swap *Swap) InitTree() error {
 	return swap.SwapTree.Init(
 		swap.Pair.From == boltz.CurrencyLiquid,
@@ -119,19 +145,21 @@ func (swap *Swap) InitTree() error {
 	)
 }
 
-func parseSwap(rows *sql.Rows) (*Swap, error) {
+func (database *Database) parseSwap(rows *sql.Rows) (*Swap, error) {
 	var swap Swap
 
 	var status string
 	privateKey := PrivateKeyScanner{}
-	var preimage string
+	var preimage, paymentHash string
 	var redeemScript string
-	var wallet sql.NullString
+	var wallet, cooperativeError, boltzUrl sql.NullString
+	var refundCooperative sql.NullBool
 	blindingKey := PrivateKeyScanner{Nullable: true}
 	var createdAt, serviceFee, onchainFee sql.NullInt64
 	swapTree := JsonScanner[*boltz.SerializedTree]{Nullable: true}
 	claimPubKey := PublicKeyScanner{Nullable: true}
 	chanIds := JsonScanner[[]lightning.ChanId]{Nullable: true}
+	feePolicy := JsonScanner[*onchain.FeePolicy]{Nullable: true}
 
 	err := scanRow(
 		rows,
@@ -143,10 +171,10 @@ func parseSwap(rows *sql.Rows) (*Swap, error) {
 			"state":               &swap.State,
 			"error":               &swap.Error,
 			"status":              &status,
-			"privateKey":          &privateKey,
+			"privateKey":          database.secretScanner(&privateKey),
 			"claimPubKey":         &claimPubKey,
 			"swapTree":            &swapTree,
-			"preimage":            &preimage,
+			"preimage":            database.secretScanner(&preimage),
 			"redeemScript":        &redeemScript,
 			"invoice":             &swap.Invoice,
 			"address":             &swap.Address,
@@ -155,13 +183,20 @@ func parseSwap(rows *sql.Rows) (*Swap, error) {
 			"lockupTransactionId": &swap.LockupTransactionId,
 			"refundTransactionId": &swap.RefundTransactionId,
 			"refundAddress":       &swap.RefundAddress,
-			"blindingKey":         &blindingKey,
+			"blindingKey":         database.secretScanner(&blindingKey),
 			"isAuto":              &swap.IsAuto,
 			"serviceFee":          &serviceFee,
 			"serviceFeePercent":   &swap.ServiceFeePercent,
 			"onchainFee":          &onchainFee,
 			"createdAt":           &createdAt,
 			"wallet":              &wallet,
+			"cooperativeError":    &cooperativeError,
+			"refundCooperative":   &refundCooperative,
+			"feePolicy":           &feePolicy,
+			"boltzUrl":            &boltzUrl,
+			"paymentHash":         &paymentHash,
+			"label":               &swap.Label,
+			"externalId":          &swap.ExternalId,
 		},
 	)
 
@@ -178,6 +213,12 @@ func parseSwap(rows *sql.Rows) (*Swap, error) {
 	swap.BlindingKey = blindingKey.Value
 	swap.ClaimPubKey = claimPubKey.Value
 	swap.Wallet = wallet.String
+	swap.CooperativeError = cooperativeError.String
+	swap.FeePolicy = feePolicy.Value
+	swap.BoltzUrl = boltzUrl.String
+	if refundCooperative.Valid {
+		swap.RefundCooperative = &refundCooperative.Bool
+	}
 
 	if preimage != "" {
 		swap.Preimage, err = hex.DecodeString(preimage)
@@ -187,6 +228,12 @@ func parseSwap(rows *sql.Rows) (*Swap, error) {
 		}
 	}
 
+	swap.PaymentHash, err = hex.DecodeString(paymentHash)
+
+	if err != nil {
+		return nil, err
+	}
+
 	swap.RedeemScript, err = hex.DecodeString(redeemScript)
 
 	if err != nil {
@@ -206,6 +253,8 @@ func parseSwap(rows *sql.Rows) (*Swap, error) {
 }
 
 func (database *Database) QuerySwap(id string) (swap *Swap, err error) {
+	database.secretsLock.RLock()
+	defer database.secretsLock.RUnlock()
 	database.lock.RLock()
 	defer database.lock.RUnlock()
 	rows, err := database.Query("SELECT * FROM swaps WHERE id = '" + id + "'")
@@ -217,7 +266,7 @@ func (database *Database) QuerySwap(id string) (swap *Swap, err error) {
 	defer rows.Close()
 
 	if rows.Next() {
-		swap, err = parseSwap(rows)
+		swap, err = database.parseSwap(rows)
 
 		if err != nil {
 			return swap, err
@@ -230,6 +279,8 @@ func (database *Database) QuerySwap(id string) (swap *Swap, err error) {
 }
 
 func (database *Database) querySwaps(query string, args ...any) (swaps []Swap, err error) {
+	database.secretsLock.RLock()
+	defer database.secretsLock.RUnlock()
 	database.lock.RLock()
 	defer database.lock.RUnlock()
 	rows, err := database.Query(query, args...)
@@ -241,7 +292,7 @@ func (database *Database) querySwaps(query string, args ...any) (swaps []Swap, e
 	defer rows.Close()
 
 	for rows.Next() {
-		swap, err := parseSwap(rows)
+		swap, err := database.parseSwap(rows)
 
 		if err != nil {
 			return nil, err
@@ -253,9 +304,11 @@ func (database *Database) querySwaps(query string, args ...any) (swaps []Swap, e
 	return swaps, err
 }
 
+const swapSearchCondition = "paymentHash = ? OR invoice = ? OR address = ? OR lockupTransactionId = ? OR refundTransactionId = ?"
+
 func (database *Database) QuerySwaps(args SwapQuery) ([]Swap, error) {
-	where, values := args.ToWhereClause()
-	return database.querySwaps("SELECT * FROM swaps"+where, values...)
+	query, values := args.toQuery("swaps", swapSearchCondition)
+	return database.querySwaps(query, values...)
 }
 
 func (database *Database) QueryPendingSwaps() ([]Swap, error) {
@@ -285,17 +338,26 @@ func (database *Database) QueryRefundableSwaps(currentBlockHeight uint32, curren
 const insertSwapStatement = `
 INSERT INTO swaps (id, fromCurrency, toCurrency, chanIds, state, error, status, privateKey, preimage, redeemScript, invoice, address,
                    expectedAmount, timeoutBlockheight, lockupTransactionId, refundTransactionId, refundAddress,
-                   blindingKey, isAuto, createdAt, serviceFee, serviceFeePercent, onchainFee, wallet, claimPubKey, swapTree)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+                   blindingKey, isAuto, createdAt, serviceFee, serviceFeePercent, onchainFee, wallet, claimPubKey, swapTree, feePolicy,
+                   boltzUrl, paymentHash, label, externalId)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 func (database *Database) CreateSwap(swap Swap) error {
+	database.secretsLock.RLock()
+	defer database.secretsLock.RUnlock()
+
 	preimage := ""
 
 	if swap.Preimage != nil {
 		preimage = hex.EncodeToString(swap.Preimage)
 	}
 
+	privateKey, blindingKey := formatPrivateKey(swap.PrivateKey), formatPrivateKey(swap.BlindingKey)
+	if err := database.encryptSecrets(&privateKey, &preimage, &blindingKey); err != nil {
+		return err
+	}
+
 	_, err := database.Exec(
 		insertSwapStatement,
 		swap.Id,
@@ -305,7 +367,7 @@ func (database *Database) CreateSwap(swap Swap) error {
 		swap.State,
 		swap.Error,
 		swap.Status.String(),
-		formatPrivateKey(swap.PrivateKey),
+		privateKey,
 		preimage,
 		hex.EncodeToString(swap.RedeemScript),
 		swap.Invoice,
@@ -315,7 +377,7 @@ func (database *Database) CreateSwap(swap Swap) error {
 		swap.LockupTransactionId,
 		swap.RefundTransactionId,
 		swap.RefundAddress,
-		formatPrivateKey(swap.BlindingKey),
+		blindingKey,
 		swap.IsAuto,
 		FormatTime(swap.CreatedAt),
 		swap.ServiceFee,
@@ -324,23 +386,35 @@ func (database *Database) CreateSwap(swap Swap) error {
 		swap.Wallet,
 		formatPublicKey(swap.ClaimPubKey),
 		formatJson(swap.SwapTree.Serialize()),
+		formatJson(swap.FeePolicy),
+		swap.BoltzUrl,
+		hex.EncodeToString(swap.PaymentHash),
+		swap.Label,
+		swap.ExternalId,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	return database.createStateEvent(swap.Id, swap.Pair.From, swap.State, swap.Error)
 }
 
 func (database *Database) UpdateSwapState(swap *Swap, state boltzrpc.SwapState, error string) error {
 	swap.State = state
 	swap.Error = error
 
-	_, err := database.Exec("UPDATE swaps SET state = ?, error = ? WHERE id = ?", state, error, swap.Id)
-	return err
+	if _, err := database.Exec("UPDATE swaps SET state = ?, error = ? WHERE id = ?", state, error, swap.Id); err != nil {
+		return err
+	}
+	return database.createStateEvent(swap.Id, swap.Pair.From, state, error)
 }
 
 func (database *Database) UpdateSwapStatus(swap *Swap, status boltz.SwapUpdateEvent) error {
 	swap.Status = status
 
-	_, err := database.Exec("UPDATE swaps SET status = ? WHERE id = ?", status.String(), swap.Id)
-	return err
+	if _, err := database.Exec("UPDATE swaps SET status = ? WHERE id = ?", status.String(), swap.Id); err != nil {
+		return err
+	}
+	return database.createStatusEvent(swap.Id, swap.Pair.From, status)
 }
 
 func (database *Database) SetSwapInvoice(swap *Swap, invoice string) error {
@@ -353,8 +427,10 @@ func (database *Database) SetSwapInvoice(swap *Swap, invoice string) error {
 func (database *Database) SetSwapLockupTransactionId(swap *Swap, lockupTransactionId string) error {
 	swap.LockupTransactionId = lockupTransactionId
 
-	_, err := database.Exec("UPDATE swaps SET lockupTransactionId = ? WHERE id = ?", lockupTransactionId, swap.Id)
-	return err
+	if _, err := database.Exec("UPDATE swaps SET lockupTransactionId = ? WHERE id = ?", lockupTransactionId, swap.Id); err != nil {
+		return err
+	}
+	return database.createTransactionEvent(swap.Id, boltzrpc.SwapEventType_LOCKUP_TRANSACTION, swap.Pair.From, lockupTransactionId, nil)
 }
 
 func (database *Database) SetSwapExpectedAmount(swap *Swap, expectedAmount uint64) error {
@@ -364,12 +440,40 @@ func (database *Database) SetSwapExpectedAmount(swap *Swap, expectedAmount uint6
 	return err
 }
 
-func (database *Database) SetSwapRefundTransactionId(swap *Swap, refundTransactionId string, fee uint64) error {
+func (database *Database) SetSwapRefundTransactionId(swap *Swap, refundTransactionId string, fee uint64, cooperative bool) error {
+	// replacements of the refund transaction do not change the state again
+	stateChanged := swap.State != boltzrpc.SwapState_REFUNDED
 	swap.State = boltzrpc.SwapState_REFUNDED
 	swap.RefundTransactionId = refundTransactionId
 	swap.OnchainFee = addToOptional(swap.OnchainFee, fee)
+	swap.RefundCooperative = &cooperative
+
+	if _, err := database.Exec(
+		"UPDATE swaps SET state = ?, refundTransactionId = ?, onchainFee = ?, refundCooperative = ? WHERE id = ?",
+		swap.State, refundTransactionId, swap.OnchainFee, cooperative, swap.Id,
+	); err != nil {
+		return err
+	}
+	if err := database.createTransactionEvent(swap.Id, boltzrpc.SwapEventType_REFUND_TRANSACTION, swap.Pair.From, refundTransactionId, &fee); err != nil {
+		return err
+	}
+	if stateChanged {
+		return database.createStateEvent(swap.Id, swap.Pair.From, swap.State, "")
+	}
+	return nil
+}
+
+func (database *Database) SetSwapCooperativeError(swap *Swap, cooperativeError string) error {
+	swap.CooperativeError = cooperativeError
+
+	_, err := database.Exec("UPDATE swaps SET cooperativeError = ? WHERE id = ?", cooperativeError, swap.Id)
+	return err
+}
+
+func (database *Database) SetSwapFeePolicy(swap *Swap, feePolicy *onchain.FeePolicy) error {
+	swap.FeePolicy = feePolicy
 
-	_, err := database.Exec("UPDATE swaps SET state = ?, refundTransactionId = ?, onchainFee = ? WHERE id = ?", swap.State, refundTransactionId, swap.OnchainFee, swap.Id)
+	_, err := database.Exec("UPDATE swaps SET feePolicy = ? WHERE id = ?", formatJson(feePolicy), swap.Id)
 	return err
 }
 
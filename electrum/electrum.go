@@ -3,10 +3,16 @@ package electrum
 import (
 	"context"
 	"crypto/tls"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/BoltzExchange/boltz-client/logger"
 	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/BoltzExchange/boltz-client/proxy"
 	"github.com/checksum0/go-electrum/electrum"
 )
 
@@ -17,15 +23,22 @@ type Client struct {
 	blockHeight uint32
 }
 
-func NewClient(url string, ssl bool) (*Client, error) {
+func NewClient(url string, ssl bool, proxy *proxy.Proxy) (*Client, error) {
+	// the electrum library does not allow for a custom dialer, so connections are forwarded through the proxy
+	address, err := proxy.Forward("electrum-"+url, url)
+	if err != nil {
+		return nil, fmt.Errorf("could not forward electrum connection through proxy: %w", err)
+	}
+
 	// Establishing a new SSL connection to an ElectrumX server
 	ctx := context.Background()
 	c := &Client{ctx: ctx}
-	var err error
 	if ssl {
-		c.client, err = electrum.NewClientSSL(ctx, url, &tls.Config{})
+		// the certificate has to be verified for the actual host rather than the local forwarding address
+		host, _, _ := net.SplitHostPort(url)
+		c.client, err = electrum.NewClientSSL(ctx, address, &tls.Config{ServerName: host})
 	} else {
-		c.client, err = electrum.NewClientTCP(ctx, url)
+		c.client, err = electrum.NewClientTCP(ctx, address)
 	}
 	if err != nil {
 		return nil, err
@@ -59,10 +72,23 @@ func (c *Client) RegisterBlockListener(channel chan<- *onchain.BlockEpoch, stop
 			return nil
 		case result := <-results:
 			c.blockHeight = uint32(result.Height)
-			channel <- &onchain.BlockEpoch{Height: c.blockHeight}
+			channel <- &onchain.BlockEpoch{
+				Height:    c.blockHeight,
+				Timestamp: parseHeaderTimestamp(result.Hex),
+			}
 		}
 	}
 }
+
+// parseHeaderTimestamp reads the time of a serialized block header, which is at the same offset for bitcoin and elements
+func parseHeaderTimestamp(header string) time.Time {
+	raw, err := hex.DecodeString(header)
+	if err != nil || len(raw) < 72 {
+		return time.Time{}
+	}
+	return time.Unix(int64(binary.LittleEndian.Uint32(raw[68:72])), 0)
+}
+
 func (c *Client) GetBlockHeight() (uint32, error) {
 	return c.blockHeight, nil
 }
@@ -71,3 +97,22 @@ func (c *Client) EstimateFee(confTarget int32) (float64, error) {
 	fee, err := c.client.GetFee(c.ctx, uint32(confTarget))
 	return float64(fee), err
 }
+
+func (c *Client) GetTxHex(txId string) (string, error) {
+	return c.client.GetRawTransaction(c.ctx, txId)
+}
+
+func (c *Client) IsTransactionConfirmed(txId string) (bool, error) {
+	transaction, err := c.client.GetTransaction(c.ctx, txId)
+	if err != nil {
+		if strings.Contains(err.Error(), "No such mempool or blockchain transaction") {
+			return false, fmt.Errorf("%w: %s", onchain.ErrTransactionNotFound, err)
+		}
+		return false, err
+	}
+	return transaction.Confirmations > 0, nil
+}
+
+func (c *Client) BroadcastTransaction(txHex string) (string, error) {
+	return c.client.BroadcastTransaction(c.ctx, txHex)
+}
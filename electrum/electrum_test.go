@@ -13,7 +13,7 @@ import (
 var url = "localhost:19001"
 
 func client(t *testing.T) *Client {
-	client, err := NewClient(url, false)
+	client, err := NewClient(url, false, nil)
 	require.NoError(t, err)
 	return client
 }
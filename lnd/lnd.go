@@ -203,7 +203,7 @@ func (lnd *LND) ListChannels() ([]*lightning.LightningChannel, error) {
 	return results, nil
 }
 
-func (lnd *LND) CreateInvoice(value int64, preimage []byte, expiry int64, memo string) (*lightning.AddInvoiceResponse, error) {
+func (lnd *LND) CreateInvoice(value int64, preimage []byte, expiry int64, memo string, channelIds []lightning.ChanId) (*lightning.AddInvoiceResponse, error) {
 	request := &lnrpc.Invoice{
 		Memo:      memo,
 		Value:     value,
@@ -213,6 +213,13 @@ func (lnd *LND) CreateInvoice(value int64, preimage []byte, expiry int64, memo s
 	if lnd.regtest {
 		request.CltvExpiry = lightning.RegtestCltv
 	}
+	if len(channelIds) > 0 {
+		routeHints, err := lnd.getRouteHints(channelIds)
+		if err != nil {
+			return nil, err
+		}
+		request.RouteHints = routeHints
+	}
 	invoice, err := lnd.client.AddInvoice(lnd.ctx, request)
 	if err != nil {
 		return nil, err
@@ -223,6 +230,43 @@ func (lnd *LND) CreateInvoice(value int64, preimage []byte, expiry int64, memo s
 	}, nil
 }
 
+// getRouteHints returns a hop hint from our peer for each of the channels, based on the routing policy of the peer
+func (lnd *LND) getRouteHints(channelIds []lightning.ChanId) ([]*lnrpc.RouteHint, error) {
+	info, err := lnd.getInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	var routeHints []*lnrpc.RouteHint
+	for _, chanId := range channelIds {
+		channel, err := lnd.GetChannelInfo(chanId.ToLnd())
+		if err != nil {
+			return nil, fmt.Errorf("could not get info of channel %s: %w", chanId, err)
+		}
+
+		peer, policy := channel.Node1Pub, channel.Node1Policy
+		if peer == info.IdentityPubkey {
+			peer, policy = channel.Node2Pub, channel.Node2Policy
+		}
+		if policy == nil {
+			return nil, fmt.Errorf("routing policy of peer %s in channel %s is not known yet", peer, chanId)
+		}
+
+		routeHints = append(routeHints, &lnrpc.RouteHint{
+			HopHints: []*lnrpc.HopHint{
+				{
+					NodeId:                    peer,
+					ChanId:                    chanId.ToLnd(),
+					FeeBaseMsat:               uint32(policy.FeeBaseMsat),
+					FeeProportionalMillionths: uint32(policy.FeeRateMilliMsat),
+					CltvExpiryDelta:           policy.TimeLockDelta,
+				},
+			},
+		})
+	}
+	return routeHints, nil
+}
+
 func (lnd *LND) CheckInvoicePaid(paymentHash []byte) (bool, error) {
 	invoice, err := lnd.client.LookupInvoice(lnd.ctx, &lnrpc.PaymentHash{
 		RHash: paymentHash,
@@ -432,6 +476,17 @@ func (lnd *LND) SendToAddress(address string, amount uint64, satPerVbyte float64
 	return response.Txid, nil
 }
 
+func (lnd *LND) BumpFee(txId string, vout uint32, satPerVbyte float64) error {
+	_, err := lnd.walletKit.BumpFee(lnd.ctx, &walletrpc.BumpFeeRequest{
+		Outpoint: &lnrpc.OutPoint{
+			TxidStr:     txId,
+			OutputIndex: vout,
+		},
+		SatPerVbyte: uint64(math.Ceil(satPerVbyte)),
+	})
+	return err
+}
+
 func (lnd *LND) SubscribeSingleInvoice(preimageHash []byte, channel chan *lnrpc.Invoice, errChannel chan error) {
 	client, err := lnd.invoices.SubscribeSingleInvoice(lnd.ctx, &invoicesrpc.SubscribeSingleInvoiceRequest{
 		RHash: preimageHash,
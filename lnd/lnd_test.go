@@ -0,0 +1,68 @@
+package lnd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/lightning"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+const (
+	ourPubkey  = "02ours"
+	peerPubkey = "03peer"
+)
+
+type testClient struct {
+	lnrpc.LightningClient
+	channels map[uint64]*lnrpc.ChannelEdge
+}
+
+func (client *testClient) GetInfo(context.Context, *lnrpc.GetInfoRequest, ...grpc.CallOption) (*lnrpc.GetInfoResponse, error) {
+	return &lnrpc.GetInfoResponse{IdentityPubkey: ourPubkey}, nil
+}
+
+func (client *testClient) GetChanInfo(_ context.Context, request *lnrpc.ChanInfoRequest, _ ...grpc.CallOption) (*lnrpc.ChannelEdge, error) {
+	channel, ok := client.channels[request.ChanId]
+	if !ok {
+		return nil, errors.New("edge not found")
+	}
+	return channel, nil
+}
+
+func TestGetRouteHints(t *testing.T) {
+	policy := &lnrpc.RoutingPolicy{FeeBaseMsat: 1000, FeeRateMilliMsat: 100, TimeLockDelta: 40}
+	client := &testClient{channels: map[uint64]*lnrpc.ChannelEdge{
+		// we can be either node of the channel
+		1: {Node1Pub: ourPubkey, Node2Pub: peerPubkey, Node2Policy: policy},
+		2: {Node1Pub: peerPubkey, Node2Pub: ourPubkey, Node1Policy: policy},
+		3: {Node1Pub: ourPubkey, Node2Pub: peerPubkey},
+	}}
+	lnd := &LND{ctx: context.Background(), client: client}
+
+	routeHints, err := lnd.getRouteHints([]lightning.ChanId{1, 2})
+	require.NoError(t, err)
+	require.Len(t, routeHints, 2)
+	for i, routeHint := range routeHints {
+		require.Len(t, routeHint.HopHints, 1)
+		hopHint := routeHint.HopHints[0]
+		require.Equal(t, peerPubkey, hopHint.NodeId)
+		require.Equal(t, uint64(i+1), hopHint.ChanId)
+		require.Equal(t, uint32(1000), hopHint.FeeBaseMsat)
+		require.Equal(t, uint32(100), hopHint.FeeProportionalMillionths)
+		require.Equal(t, uint32(40), hopHint.CltvExpiryDelta)
+	}
+
+	t.Run("UnknownPolicy", func(t *testing.T) {
+		_, err := lnd.getRouteHints([]lightning.ChanId{3})
+		require.ErrorContains(t, err, "routing policy")
+	})
+
+	t.Run("UnknownChannel", func(t *testing.T) {
+		_, err := lnd.getRouteHints([]lightning.ChanId{4})
+		require.Error(t, err)
+	})
+}
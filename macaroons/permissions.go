@@ -58,10 +58,22 @@ var (
 			Entity: "info",
 			Action: "read",
 		}},
+		"/boltzrpc.Boltz/GetChainPair": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/boltzrpc.Boltz/GetQuote": {{
+			Entity: "info",
+			Action: "read",
+		}},
 		"/boltzrpc.Boltz/ListSwaps": {{
 			Entity: "swap",
 			Action: "read",
 		}},
+		"/boltzrpc.Boltz/ExportSwaps": {{
+			Entity: "swap",
+			Action: "read",
+		}},
 		"/boltzrpc.Boltz/GetSwapInfo": {{
 			Entity: "swap",
 			Action: "read",
@@ -70,6 +82,10 @@ var (
 			Entity: "swap",
 			Action: "read",
 		}},
+		"/boltzrpc.Boltz/UpdateSwapLabel": {{
+			Entity: "swap",
+			Action: "write",
+		}},
 		"/boltzrpc.Boltz/Deposit": {{
 			Entity: "swap",
 			Action: "write",
@@ -82,6 +98,10 @@ var (
 			Entity: "swap",
 			Action: "write",
 		}},
+		"/boltzrpc.Boltz/BumpTransaction": {{
+			Entity: "swap",
+			Action: "write",
+		}},
 		"/boltzrpc.Boltz/CreateChannel": {{
 			Entity: "swap",
 			Action: "write",
@@ -90,6 +110,10 @@ var (
 			Entity: "swap",
 			Action: "write",
 		}},
+		"/boltzrpc.Boltz/CreateChainSwap": {{
+			Entity: "swap",
+			Action: "write",
+		}},
 		"/boltzrpc.Boltz/CreateWallet": {{
 			Entity: "liquid",
 			Action: "write",
@@ -134,6 +158,14 @@ var (
 			Entity: "info",
 			Action: "write",
 		}},
+		"/boltzrpc.Boltz/BackupDatabase": {{
+			Entity: "info",
+			Action: "write",
+		}},
+		"/boltzrpc.Boltz/RestoreDatabase": {{
+			Entity: "info",
+			Action: "write",
+		}},
 		"/boltzrpc.Boltz/VerifyWalletPassword": {{
 			Entity: "info",
 			Action: "read",
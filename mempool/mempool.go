@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/BoltzExchange/boltz-client/logger"
 	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/BoltzExchange/boltz-client/proxy"
 	"github.com/btcsuite/websocket"
 )
 
@@ -24,28 +26,51 @@ type feeEstimation struct {
 	MinimumFee  float64 `json:"minimumFee"`
 }
 
+type txStatus struct {
+	Confirmed bool `json:"confirmed"`
+}
+
 type blockResponse struct {
 	Block struct {
-		Height uint32 `json:"height"`
+		Height    uint32 `json:"height"`
+		Timestamp int64  `json:"timestamp"`
 	} `json:"block"`
 }
 
 type Client struct {
 	api   string
 	apiv1 string
+
+	http    *http.Client
+	wsProxy func(network, addr string) (net.Conn, error)
 }
 
-func InitClient(endpoint string) *Client {
+func InitClient(endpoint string, proxy *proxy.Proxy) *Client {
 	endpointStripped := strings.TrimSuffix(endpoint, "/")
 	endpointV1 := endpointStripped
 	if !strings.HasSuffix(endpointV1, "/v1") {
 		endpointV1 += "/v1"
 	}
 
-	return &Client{
+	client := &Client{
 		api:   endpointStripped,
 		apiv1: endpointV1,
+		http:  http.DefaultClient,
+	}
+
+	if proxy != nil {
+		// the requests of every mempool instance get their own circuits
+		service := "mempool"
+		if parsed, err := url.Parse(endpointStripped); err == nil {
+			service += "-" + parsed.Host
+		}
+		client.http = proxy.HTTPClient(service)
+		client.wsProxy = func(network, addr string) (net.Conn, error) {
+			return proxy.Dial(service+"-ws", network, addr)
+		}
 	}
+
+	return client
 }
 
 func (c *Client) getFeeRecommendation() (*feeEstimation, error) {
@@ -54,7 +79,7 @@ func (c *Client) getFeeRecommendation() (*feeEstimation, error) {
 		return nil, err
 	}
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := c.http.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +109,7 @@ func (c *Client) EstimateFee(confTarget int32) (float64, error) {
 }
 
 func (c *Client) GetTxHex(txId string) (string, error) {
-	res, err := http.Get(c.api + "/tx/" + txId + "/hex")
+	res, err := c.http.Get(c.api + "/tx/" + txId + "/hex")
 	if err != nil {
 		return "", err
 	}
@@ -99,6 +124,44 @@ func (c *Client) GetTxHex(txId string) (string, error) {
 	return string(hex), nil
 }
 
+func (c *Client) IsTransactionConfirmed(txId string) (bool, error) {
+	res, err := c.http.Get(c.api + "/tx/" + txId + "/status")
+	if err != nil {
+		return false, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return false, onchain.ErrTransactionNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("could not get status of tx %s, failed with status: %d", txId, res.StatusCode)
+	}
+
+	var status txStatus
+	if err := json.NewDecoder(res.Body).Decode(&status); err != nil {
+		return false, err
+	}
+	return status.Confirmed, nil
+}
+
+func (c *Client) BroadcastTransaction(txHex string) (string, error) {
+	res, err := c.http.Post(c.api+"/tx", "text/plain", strings.NewReader(txHex))
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", err
+	}
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("could not broadcast transaction, failed with status %d: %s", res.StatusCode, string(body))
+	}
+	return string(body), nil
+}
+
 func (c *Client) RegisterBlockListener(channel chan<- *onchain.BlockEpoch, stop <-chan bool) error {
 	ws, err := url.Parse(c.apiv1)
 	if err != nil {
@@ -114,7 +177,8 @@ func (c *Client) RegisterBlockListener(channel chan<- *onchain.BlockEpoch, stop
 
 	logger.Info("Connecting to mempool websocket api: " + ws.String())
 
-	conn, _, err := websocket.DefaultDialer.Dial(ws.String(), nil)
+	dialer := &websocket.Dialer{NetDial: c.wsProxy}
+	conn, _, err := dialer.Dial(ws.String(), nil)
 	if err != nil {
 		return err
 	}
@@ -172,14 +236,15 @@ func (c *Client) RegisterBlockListener(channel chan<- *onchain.BlockEpoch, stop
 
 		if parsed.Block.Height != 0 {
 			channel <- &onchain.BlockEpoch{
-				Height: parsed.Block.Height,
+				Height:    parsed.Block.Height,
+				Timestamp: time.Unix(parsed.Block.Timestamp, 0),
 			}
 		}
 	}
 }
 
 func (c *Client) GetBlockHeight() (uint32, error) {
-	res, err := http.Get(c.apiv1 + "/blocks/tip/height")
+	res, err := c.http.Get(c.apiv1 + "/blocks/tip/height")
 	if err != nil {
 		return 0, err
 	}
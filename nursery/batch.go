@@ -0,0 +1,183 @@
+package nursery
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/BoltzExchange/boltz-client/logger"
+	"github.com/BoltzExchange/boltz-client/onchain"
+)
+
+// Reverse Swaps can only be claimed together if they share the currency and the destination of the claim
+type claimBatchKey struct {
+	currency boltz.Currency
+	address  string
+}
+
+type claimBatch struct {
+	outputs map[string]boltz.OutputDetails
+	timer   *time.Timer
+}
+
+// queueReverseSwapClaim adds the claim output of a Reverse Swap to the batch of its currency and claim address.
+// The batch is claimed once the claim batch window, which starts with the first output, has passed.
+func (nursery *Nursery) queueReverseSwapClaim(reverseSwap *database.ReverseSwap, output boltz.OutputDetails) {
+	nursery.claimBatchesLock.Lock()
+	defer nursery.claimBatchesLock.Unlock()
+
+	key := claimBatchKey{currency: reverseSwap.Pair.To, address: output.Address}
+	batch, ok := nursery.claimBatches[key]
+	if !ok {
+		batch = &claimBatch{outputs: make(map[string]boltz.OutputDetails)}
+		// released by the timer or, if the timer is stopped before it fires, by stopClaimBatches
+		nursery.waitGroup.Add(1)
+		batch.timer = time.AfterFunc(nursery.claimBatchWindow, func() {
+			defer nursery.waitGroup.Done()
+			nursery.claimBatch(key)
+		})
+		nursery.claimBatches[key] = batch
+	}
+
+	if _, queued := batch.outputs[reverseSwap.Id]; queued {
+		logger.Debugf("Claim of Reverse Swap %s is queued already", reverseSwap.Id)
+		return
+	}
+	batch.outputs[reverseSwap.Id] = output
+
+	logger.Infof("Queued claim of Reverse Swap %s; %d claims in batch for %s", reverseSwap.Id, len(batch.outputs), key.address)
+}
+
+func (nursery *Nursery) stopClaimBatches() {
+	nursery.claimBatchesLock.Lock()
+	defer nursery.claimBatchesLock.Unlock()
+
+	// swaps without a claim transaction will be queued again when their status is recovered on the next start
+	for key, batch := range nursery.claimBatches {
+		if batch.timer.Stop() {
+			nursery.waitGroup.Done()
+		}
+		delete(nursery.claimBatches, key)
+	}
+}
+
+func (nursery *Nursery) claimBatch(key claimBatchKey) {
+	nursery.claimBatchesLock.Lock()
+	batch, ok := nursery.claimBatches[key]
+	delete(nursery.claimBatches, key)
+	nursery.claimBatchesLock.Unlock()
+
+	if !ok {
+		return
+	}
+
+	var reverseSwaps []database.ReverseSwap
+	var outputs []boltz.OutputDetails
+	for id, output := range batch.outputs {
+		reverseSwap, err := nursery.database.QueryReverseSwap(id)
+		if err != nil {
+			logger.Errorf("Could not query Reverse Swap %s: %v", id, err)
+			continue
+		}
+		if reverseSwap.State != boltzrpc.SwapState_PENDING || reverseSwap.ClaimTransactionId != "" {
+			logger.Infof("Reverse Swap %s does not have to be claimed anymore", id)
+			continue
+		}
+		reverseSwaps = append(reverseSwaps, *reverseSwap)
+		outputs = append(outputs, output)
+	}
+
+	if len(outputs) == 0 {
+		return
+	}
+
+	err := nursery.claimReverseSwaps(key.currency, reverseSwaps, outputs)
+	if err == nil {
+		return
+	}
+	if len(reverseSwaps) == 1 {
+		nursery.failBatchedClaim(&reverseSwaps[0], err)
+		return
+	}
+
+	// a single swap, like one whose fee policy does not allow the fee, should not fail the whole batch
+	logger.Warnf("Could not claim batch of %d Reverse Swaps, claiming them separately: %v", len(reverseSwaps), err)
+	for i := range reverseSwaps {
+		if err := nursery.claimReverseSwaps(key.currency, reverseSwaps[i:i+1], outputs[i:i+1]); err != nil {
+			nursery.failBatchedClaim(&reverseSwaps[i], err)
+		}
+	}
+}
+
+func (nursery *Nursery) failBatchedClaim(reverseSwap *database.ReverseSwap, err error) {
+	logger.Errorf("Could not claim Reverse Swap %s: %v", reverseSwap.Id, err)
+
+	if dbErr := nursery.database.UpdateReverseSwapState(reverseSwap, boltzrpc.SwapState_ERROR, err.Error()); dbErr != nil {
+		logger.Error("Could not update Reverse Swap state: " + dbErr.Error())
+	}
+	nursery.sendReverseSwapUpdate(*reverseSwap)
+}
+
+// claimReverseSwaps claims all outputs in a single transaction. Outputs for which boltz refuses to cooperate are
+// claimed via the script path, which is always possible for Reverse Swaps since we know the preimage.
+func (nursery *Nursery) claimReverseSwaps(currency boltz.Currency, reverseSwaps []database.ReverseSwap, outputs []boltz.OutputDetails) error {
+	var feePolicies []*onchain.FeePolicy
+	for _, reverseSwap := range reverseSwaps {
+		feePolicies = append(feePolicies, reverseSwap.FeePolicy)
+	}
+
+	feeSatPerVbyte, err := nursery.getFeeEstimation(currency, feePolicies...)
+	if err != nil {
+		return errors.New("Could not get fee estimation: " + err.Error())
+	}
+
+	logger.Info(fmt.Sprintf("Using fee of %v sat/vbyte for claim transaction", feeSatPerVbyte))
+
+	claimTransactionId, totalClaimFee, err := nursery.createTransaction(currency, outputs, feeSatPerVbyte)
+	if err != nil {
+		var cooperativeErr *boltz.CooperativeError
+		isCooperativeErr := errors.As(err, &cooperativeErr)
+		for i := range outputs {
+			if isCooperativeErr {
+				if swapErr, failed := cooperativeErr.Errors[outputs[i].SwapId]; failed {
+					logger.Warnf("Could not claim Reverse Swap %s cooperatively: %s", outputs[i].SwapId, swapErr)
+					outputs[i].Cooperative = false
+				}
+			} else {
+				outputs[i].Cooperative = false
+			}
+		}
+		if !isCooperativeErr {
+			logger.Warnf("Could not construct cooperative claim transaction: %v", err)
+		}
+		claimTransactionId, totalClaimFee, err = nursery.createTransaction(currency, outputs, feeSatPerVbyte)
+		if err != nil {
+			return errors.New("Could not construct claim transaction: " + err.Error())
+		}
+	}
+
+	logger.Infof("Constructed claim transaction for %d Reverse Swaps: %s", len(outputs), claimTransactionId)
+
+	count := uint64(len(reverseSwaps))
+	claimFee := totalClaimFee / count
+	for i, reverseSwap := range reverseSwaps {
+		// distribute the remainder of the fee to the last swap
+		if i == int(count)-1 {
+			claimFee += totalClaimFee % count
+		}
+		err = nursery.database.SetReverseSwapClaimTransactionId(&reverseSwap, claimTransactionId, claimFee)
+
+		if err != nil {
+			logger.Error("Could not set claim transaction id in database: " + err.Error())
+			continue
+		}
+
+		nursery.sendReverseSwapUpdate(reverseSwap)
+
+		logger.Infof("Claimed Reverse Swap %s with claim transaction %s", reverseSwap.Id, claimTransactionId)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package nursery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/stretchr/testify/require"
+)
+
+// lockupReverseSwaps registers reverse swaps which claim to the same address and confirms their lockups
+func (test *testNursery) lockupReverseSwaps(t *testing.T, options ...func(reverseSwap *database.ReverseSwap)) (ids []string, lockupIds []string) {
+	claimAddress := newAddress(t)
+	for _, option := range options {
+		reverseSwap := test.createReverseSwap(t, reverseSwapAmount, func(reverseSwap *database.ReverseSwap) {
+			reverseSwap.ClaimAddress = claimAddress
+		}, option)
+		require.NoError(t, test.RegisterReverseSwap(*reverseSwap))
+		ids = append(ids, reverseSwap.Id)
+	}
+	for _, id := range ids {
+		lockupId, err := test.server.Lockup(id)
+		require.NoError(t, err)
+		require.NoError(t, test.server.Confirm(id))
+		lockupIds = append(lockupIds, lockupId)
+	}
+	return ids, lockupIds
+}
+
+func (test *testNursery) requireReverseSwapState(t *testing.T, id string, state boltzrpc.SwapState) *database.ReverseSwap {
+	var reverseSwap *database.ReverseSwap
+	requireEventually(t, func() bool {
+		var err error
+		reverseSwap, err = test.database.QueryReverseSwap(id)
+		require.NoError(t, err)
+		return reverseSwap.State == state
+	})
+	return reverseSwap
+}
+
+func noOption(*database.ReverseSwap) {}
+
+func TestClaimBatch(t *testing.T) {
+	window := 200 * time.Millisecond
+
+	t.Run("Batched", func(t *testing.T) {
+		test := newTestNursery(t, Config{ClaimBatchWindow: window}, nil)
+		ids, lockupIds := test.lockupReverseSwaps(t, noOption, noOption)
+
+		var claims []string
+		for _, id := range ids {
+			requireEventually(t, func() bool {
+				reverseSwap, err := test.database.QueryReverseSwap(id)
+				require.NoError(t, err)
+				if reverseSwap.ClaimTransactionId == "" {
+					return false
+				}
+				claims = append(claims, reverseSwap.ClaimTransactionId)
+				return true
+			})
+		}
+		require.Equal(t, claims[0], claims[1])
+		require.Equal(t, 1, test.btc.broadcastCount())
+
+		claim := test.btc.lastBroadcast(t)
+		require.Len(t, claim.TxIn, 2)
+		for _, lockupId := range lockupIds {
+			require.True(t, spends(claim, lockupId))
+		}
+		for _, input := range claim.TxIn {
+			require.True(t, isKeyPath(input))
+		}
+	})
+
+	t.Run("FailureIsolated", func(t *testing.T) {
+		test := newTestNursery(t, Config{ClaimBatchWindow: window}, nil)
+		maxFee := uint64(1)
+		ids, lockupIds := test.lockupReverseSwaps(t, noOption, func(reverseSwap *database.ReverseSwap) {
+			reverseSwap.FeePolicy = &onchain.FeePolicy{MaxFee: &maxFee}
+		})
+
+		failed := test.requireReverseSwapState(t, ids[1], boltzrpc.SwapState_ERROR)
+		require.Contains(t, failed.Error, onchain.ErrFeeTooHigh.Error())
+		require.Empty(t, failed.ClaimTransactionId)
+
+		requireEventually(t, func() bool {
+			return test.btc.broadcastCount() == 1
+		})
+		claim := test.btc.lastBroadcast(t)
+		require.Len(t, claim.TxIn, 1)
+		require.True(t, spends(claim, lockupIds[0]))
+
+		claimed, err := test.database.QueryReverseSwap(ids[0])
+		require.NoError(t, err)
+		require.Equal(t, claim.TxHash().String(), claimed.ClaimTransactionId)
+	})
+
+	t.Run("Stopped", func(t *testing.T) {
+		test := newTestNursery(t, Config{ClaimBatchWindow: time.Hour}, nil)
+		test.lockupReverseSwaps(t, noOption)
+
+		requireEventually(t, func() bool {
+			test.claimBatchesLock.Lock()
+			defer test.claimBatchesLock.Unlock()
+			return len(test.claimBatches) == 1
+		})
+
+		stopped := make(chan struct{})
+		go func() {
+			test.Stop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "nursery did not stop with a queued claim")
+		}
+		require.Zero(t, test.btc.broadcastCount())
+	})
+}
@@ -0,0 +1,487 @@
+package nursery
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/BoltzExchange/boltz-client/logger"
+	"github.com/BoltzExchange/boltz-client/onchain"
+)
+
+// claim and refund transactions which are not confirmed after this many blocks get bumped
+const bumpAfterBlocks = 3
+
+// claims of swaps which are this close to their timeout get bumped on every block,
+// because boltz can refund its lockup once the timeout is reached
+const urgentTimeoutDelta = 10
+
+// rough size of a transaction spending a single output back to the wallet, used to calculate the fee rate of CPFP transactions
+const cpfpChildVsize = 150
+
+// minimal increase of the fee rate of a replacement transaction
+var minFeeBump = map[boltz.Currency]float64{
+	boltz.CurrencyBtc:    1,
+	boltz.CurrencyLiquid: 0.1,
+}
+
+type bumpOutput struct {
+	boltz.OutputDetails
+
+	// zero for refunds
+	claimTimeoutBlockHeight uint32
+	feePolicy               *onchain.FeePolicy
+	// feeChange is the difference to the fee of the transaction which was recorded for the swap before
+	setTransactionId func(transactionId string, feeChange int64, cooperative bool) error
+}
+
+// applyFeeChange lowers the recorded onchain fee of a swap for negative changes and returns the amount which has to be
+// added to it for positive ones
+func applyFeeChange(onchainFee *uint64, feeChange int64) uint64 {
+	if feeChange >= 0 {
+		return uint64(feeChange)
+	}
+	if onchainFee != nil {
+		*onchainFee -= min(*onchainFee, uint64(-feeChange))
+	}
+	return 0
+}
+
+// splitFeeChange distributes the change of the fee of a transaction between the swaps it spends;
+// the remainder goes to the last one
+func splitFeeChange(feeChange int64, count int) []int64 {
+	shares := make([]int64, count)
+	for i := range shares {
+		shares[i] = feeChange / int64(count)
+	}
+	shares[count-1] += feeChange % int64(count)
+	return shares
+}
+
+func (nursery *Nursery) trackTransaction(transactionId string, currency boltz.Currency, outputs []boltz.OutputDetails, fee uint64, satPerVbyte float64) {
+	blockHeight, err := nursery.onchain.GetBlockHeight(currency)
+	if err != nil {
+		logger.Warnf("Could not track transaction %s: %v", transactionId, err)
+		return
+	}
+
+	for _, output := range outputs {
+		err := nursery.database.CreatePendingTransaction(database.PendingTransaction{
+			Id:          transactionId,
+			SwapId:      output.SwapId,
+			SwapType:    output.SwapType,
+			IsRefund:    output.IsRefund(),
+			Currency:    currency,
+			Fee:         fee,
+			SatPerVbyte: satPerVbyte,
+			BlockHeight: blockHeight,
+			Cooperative: output.Cooperative,
+		})
+		if err != nil {
+			logger.Warnf("Could not track transaction %s: %v", transactionId, err)
+		}
+	}
+}
+
+func (nursery *Nursery) checkPendingTransactions(currency boltz.Currency, blockHeight uint32) {
+	pending, err := nursery.database.QueryPendingTransactions(currency)
+	if err != nil {
+		logger.Error("Could not query pending transactions: " + err.Error())
+		return
+	}
+
+	var ids []string
+	transactions := make(map[string][]database.PendingTransaction)
+	// the transactions which were replaced by the pending one of the key
+	replaced := make(map[string][]database.PendingTransaction)
+	for _, transaction := range pending {
+		if transaction.ReplacedBy != "" {
+			replaced[transaction.ReplacedBy] = append(replaced[transaction.ReplacedBy], transaction)
+			continue
+		}
+		if _, ok := transactions[transaction.Id]; !ok {
+			ids = append(ids, transaction.Id)
+		}
+		transactions[transaction.Id] = append(transactions[transaction.Id], transaction)
+	}
+
+	for _, id := range ids {
+		confirmed, err := nursery.onchain.IsTransactionConfirmed(currency, id)
+		// transactions paying less than the mempool minimum fee get evicted during fee spikes
+		evicted := errors.Is(err, onchain.ErrTransactionNotFound)
+		if err != nil && !evicted {
+			if !errors.Is(err, errors.ErrUnsupported) {
+				logger.Warnf("Could not check confirmation of transaction %s: %v", id, err)
+			}
+			continue
+		}
+
+		if confirmed {
+			logger.Debugf("Transaction %s confirmed", id)
+			if err := nursery.database.DeletePendingTransaction(id); err != nil {
+				logger.Error("Could not delete pending transaction: " + err.Error())
+			}
+			continue
+		}
+
+		outputs, err := nursery.getBumpOutputs(transactions[id])
+		if err != nil {
+			logger.Errorf("Could not get outputs of transaction %s: %v", id, err)
+			continue
+		}
+
+		// the replacement disappears if one of the transactions it replaced confirmed instead
+		if conflict := nursery.findConfirmedConflict(currency, replaced[id]); conflict != nil {
+			nursery.revertReplacement(transactions[id], outputs, conflict)
+			continue
+		}
+
+		urgent := false
+		for _, output := range outputs {
+			if output.claimTimeoutBlockHeight != 0 && blockHeight+urgentTimeoutDelta >= output.claimTimeoutBlockHeight {
+				urgent = true
+			}
+		}
+
+		if !evicted && !urgent && blockHeight < transactions[id][0].BlockHeight+bumpAfterBlocks {
+			continue
+		}
+
+		logger.Infof("Bumping transaction %s (evicted: %t, urgent: %t)", id, evicted, urgent)
+		if _, err := nursery.bumpTransaction(transactions[id], outputs, nil, urgent); err != nil {
+			logger.Errorf("Could not bump transaction %s: %v", id, err)
+		}
+	}
+}
+
+// BumpTransaction increases the fee of a pending claim or refund transaction. The transaction is replaced if possible,
+// otherwise one of its outputs is spent with a higher fee by an internal wallet. Returns the id of the bumped transaction.
+func (nursery *Nursery) BumpTransaction(transactionId string, satPerVbyte *float64) (string, error) {
+	transactions, err := nursery.database.QueryPendingTransaction(transactionId)
+	if err != nil {
+		return "", err
+	}
+	if len(transactions) == 0 {
+		return "", fmt.Errorf("transaction %s is not a pending claim or refund transaction", transactionId)
+	}
+
+	outputs, err := nursery.getBumpOutputs(transactions)
+	if err != nil {
+		return "", err
+	}
+
+	return nursery.bumpTransaction(transactions, outputs, satPerVbyte, false)
+}
+
+func (nursery *Nursery) bumpTransaction(
+	transactions []database.PendingTransaction,
+	outputs []bumpOutput,
+	satPerVbyte *float64,
+	urgent bool,
+) (string, error) {
+	nursery.bumpLock.Lock()
+	defer nursery.bumpLock.Unlock()
+
+	pending := transactions[0]
+
+	var feeRate float64
+	if satPerVbyte != nil {
+		feeRate = *satPerVbyte
+	} else {
+		var policies []*onchain.FeePolicy
+		for _, output := range outputs {
+			if output.feePolicy != nil && output.feePolicy.SatPerVbyte != nil {
+				return "", fmt.Errorf("fee rate of swap %s is fixed by its fee policy", output.SwapId)
+			}
+			policies = append(policies, output.feePolicy)
+		}
+		var estimation float64
+		var err error
+		if urgent {
+			estimation, err = nursery.onchain.EstimateFee(pending.Currency, 1)
+		} else {
+			estimation, err = nursery.getFeeEstimation(pending.Currency, policies...)
+		}
+		if err != nil {
+			return "", fmt.Errorf("could not get fee estimation: %w", err)
+		}
+		feeRate = max(estimation, pending.SatPerVbyte+minFeeBump[pending.Currency])
+	}
+
+	if feeRate <= pending.SatPerVbyte {
+		return "", fmt.Errorf("fee rate has to be higher than the current one of %v sat/vbyte", pending.SatPerVbyte)
+	}
+
+	blockHeight, err := nursery.onchain.GetBlockHeight(pending.Currency)
+	if err != nil {
+		return "", fmt.Errorf("could not get block height: %w", err)
+	}
+
+	logger.Infof("Bumping transaction %s from %v to %v sat/vbyte", pending.Id, pending.SatPerVbyte, feeRate)
+
+	transactionId, err := nursery.replaceTransaction(pending, outputs, feeRate, blockHeight)
+	if err == nil {
+		nursery.recordFeeBump(transactions, transactionId, feeRate, blockHeight)
+		return transactionId, nil
+	}
+	logger.Warnf("Could not replace transaction %s: %v", pending.Id, err)
+
+	if err := nursery.childPaysForParent(pending, outputs, feeRate); err != nil {
+		return "", fmt.Errorf("could neither replace transaction nor spend its output: %w", err)
+	}
+
+	if err := nursery.database.UpdatePendingTransaction(pending.Id, feeRate, blockHeight); err != nil {
+		logger.Error("Could not update pending transaction: " + err.Error())
+	}
+	nursery.recordFeeBump(transactions, pending.Id, feeRate, blockHeight)
+	return pending.Id, nil
+}
+
+// recordFeeBump adds the bump to the history of every swap spent by the transaction
+func (nursery *Nursery) recordFeeBump(transactions []database.PendingTransaction, transactionId string, satPerVbyte float64, blockHeight uint32) {
+	for _, transaction := range transactions {
+		err := nursery.database.CreateSwapEvent(database.SwapEvent{
+			SwapId:        transaction.SwapId,
+			Type:          boltzrpc.SwapEventType_FEE_BUMP,
+			Currency:      transaction.Currency,
+			BlockHeight:   blockHeight,
+			TransactionId: transactionId,
+			SatPerVbyte:   satPerVbyte,
+		})
+		if err != nil {
+			logger.Errorf("Could not record fee bump of swap %s: %v", transaction.SwapId, err)
+		}
+	}
+}
+
+func (nursery *Nursery) replaceTransaction(pending database.PendingTransaction, outputs []bumpOutput, feeRate float64, blockHeight uint32) (string, error) {
+	details := make([]boltz.OutputDetails, len(outputs))
+	for i, output := range outputs {
+		details[i] = output.OutputDetails
+	}
+
+	transactionId, fee, err := nursery.createTransaction(pending.Currency, details, feeRate)
+	var cooperativeErr *boltz.CooperativeError
+	if errors.As(err, &cooperativeErr) {
+		for i := range details {
+			if _, failed := cooperativeErr.Errors[details[i].SwapId]; failed {
+				// the timeout leaf can not be used before the timeout is reached
+				if details[i].IsRefund() && details[i].TimeoutBlockHeight > blockHeight {
+					return "", err
+				}
+				details[i].Cooperative = false
+			}
+		}
+		transactionId, fee, err = nursery.createTransaction(pending.Currency, details, feeRate)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	logger.Infof("Replaced transaction %s with %s", pending.Id, transactionId)
+
+	if err := nursery.database.ReplacePendingTransaction(pending.Id, transactionId); err != nil {
+		logger.Error("Could not mark pending transaction as replaced: " + err.Error())
+	}
+
+	shares := splitFeeChange(int64(fee)-int64(pending.Fee), len(outputs))
+	for i, output := range outputs {
+		if err := output.setTransactionId(transactionId, shares[i], details[i].Cooperative); err != nil {
+			logger.Errorf("Could not set transaction id of swap %s in database: %v", output.SwapId, err)
+		}
+	}
+
+	return transactionId, nil
+}
+
+// findConfirmedConflict returns the entries of the replaced transaction which confirmed, if any
+func (nursery *Nursery) findConfirmedConflict(currency boltz.Currency, replaced []database.PendingTransaction) []database.PendingTransaction {
+	conflicts := make(map[string][]database.PendingTransaction)
+	for _, transaction := range replaced {
+		conflicts[transaction.Id] = append(conflicts[transaction.Id], transaction)
+	}
+	for id, conflict := range conflicts {
+		confirmed, err := nursery.onchain.IsTransactionConfirmed(currency, id)
+		if err != nil {
+			if !errors.Is(err, onchain.ErrTransactionNotFound) && !errors.Is(err, errors.ErrUnsupported) {
+				logger.Warnf("Could not check confirmation of replaced transaction %s: %v", id, err)
+			}
+			continue
+		}
+		if confirmed {
+			return conflict
+		}
+	}
+	return nil
+}
+
+// revertReplacement sets the transaction of the swaps back to a replaced one which confirmed
+func (nursery *Nursery) revertReplacement(transactions []database.PendingTransaction, outputs []bumpOutput, conflict []database.PendingTransaction) {
+	pending, confirmed := transactions[0], conflict[0]
+	logger.Infof("Replaced transaction %s confirmed instead of %s", confirmed.Id, pending.Id)
+
+	shares := splitFeeChange(int64(confirmed.Fee)-int64(pending.Fee), len(outputs))
+	for i, output := range outputs {
+		cooperative := false
+		for _, transaction := range conflict {
+			if transaction.SwapId == output.SwapId {
+				cooperative = transaction.Cooperative
+			}
+		}
+		if err := output.setTransactionId(confirmed.Id, shares[i], cooperative); err != nil {
+			logger.Errorf("Could not set transaction id of swap %s in database: %v", output.SwapId, err)
+		}
+	}
+
+	if err := nursery.database.DeletePendingTransaction(pending.Id); err != nil {
+		logger.Error("Could not delete pending transaction: " + err.Error())
+	}
+}
+
+func (nursery *Nursery) childPaysForParent(pending database.PendingTransaction, outputs []bumpOutput, feeRate float64) error {
+	parent, err := nursery.onchain.GetTransaction(pending.Currency, pending.Id, nil)
+	if err != nil {
+		return fmt.Errorf("could not fetch transaction: %w", err)
+	}
+
+	// the child has to pay for the parent as well to reach the desired fee rate for the whole package
+	packageFee := feeRate * float64(parent.VSize()+cpfpChildVsize)
+	childFeeRate := max(feeRate, (packageFee-float64(pending.Fee))/cpfpChildVsize)
+
+	share := (pending.Fee + uint64(childFeeRate*cpfpChildVsize)) / uint64(len(outputs))
+	for _, output := range outputs {
+		if err := onchain.CheckMaxFee(share, output.MaxFee); err != nil {
+			return fmt.Errorf("swap %s: %w", output.SwapId, err)
+		}
+	}
+
+	var errs []error
+	for _, output := range outputs {
+		vout, err := boltz.FindOutputIndex(nursery.network, parent, output.Address)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, wallet := range nursery.onchain.Wallets {
+			bumper, ok := wallet.(onchain.FeeBumper)
+			if !ok || wallet.Currency() != pending.Currency || wallet.Readonly() {
+				continue
+			}
+			if err := bumper.BumpFee(pending.Id, vout, childFeeRate); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", wallet.Name(), err))
+				continue
+			}
+			logger.Infof("Bumped transaction %s with a child paying %v sat/vbyte from wallet %s", pending.Id, childFeeRate, wallet.Name())
+			return nil
+		}
+	}
+	if len(errs) == 0 {
+		return errors.New("no wallet which is able to bump the fee")
+	}
+	return errors.Join(errs...)
+}
+
+func (nursery *Nursery) getBumpOutputs(transactions []database.PendingTransaction) ([]bumpOutput, error) {
+	var outputs []bumpOutput
+	for _, transaction := range transactions {
+		output, err := nursery.getBumpOutput(transaction)
+		if err != nil {
+			return nil, fmt.Errorf("swap %s: %w", transaction.SwapId, err)
+		}
+		outputs = append(outputs, *output)
+	}
+	return outputs, nil
+}
+
+func (nursery *Nursery) getBumpOutput(pending database.PendingTransaction) (*bumpOutput, error) {
+	switch pending.SwapType {
+	case boltz.NormalSwap:
+		swap, err := nursery.database.QuerySwap(pending.SwapId)
+		if err != nil {
+			return nil, err
+		}
+		output, err := nursery.getRefundOutput(swap)
+		if err != nil {
+			return nil, err
+		}
+		return &bumpOutput{
+			OutputDetails: *output,
+			feePolicy:     swap.FeePolicy,
+			setTransactionId: func(transactionId string, feeChange int64, cooperative bool) error {
+				fee := applyFeeChange(swap.OnchainFee, feeChange)
+				if err := nursery.database.SetSwapRefundTransactionId(swap, transactionId, fee, cooperative); err != nil {
+					return err
+				}
+				nursery.sendSwapUpdate(*swap)
+				return nil
+			},
+		}, nil
+	case boltz.ReverseSwap:
+		reverseSwap, err := nursery.database.QueryReverseSwap(pending.SwapId)
+		if err != nil {
+			return nil, err
+		}
+		lockupTx, err := nursery.onchain.GetTransaction(reverseSwap.Pair.To, reverseSwap.LockupTransactionId, reverseSwap.BlindingKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch lockup transaction: %w", err)
+		}
+		output, err := nursery.getReverseSwapClaimOutput(reverseSwap, lockupTx)
+		if err != nil {
+			return nil, err
+		}
+		return &bumpOutput{
+			OutputDetails:           *output,
+			claimTimeoutBlockHeight: reverseSwap.TimeoutBlockHeight,
+			feePolicy:               reverseSwap.FeePolicy,
+			setTransactionId: func(transactionId string, feeChange int64, _ bool) error {
+				fee := applyFeeChange(reverseSwap.OnchainFee, feeChange)
+				if reverseSwap.OnchainFee != nil {
+					fee += *reverseSwap.OnchainFee
+				}
+				if err := nursery.database.SetReverseSwapClaimTransactionId(reverseSwap, transactionId, fee); err != nil {
+					return err
+				}
+				nursery.sendReverseSwapUpdate(*reverseSwap)
+				return nil
+			},
+		}, nil
+	case boltz.ChainSwap:
+		chainSwap, err := nursery.database.QueryChainSwap(pending.SwapId)
+		if err != nil {
+			return nil, err
+		}
+		var output *boltz.OutputDetails
+		var claimTimeoutBlockHeight uint32
+		data := chainSwap.FromData
+		if pending.IsRefund {
+			output, err = nursery.getChainSwapRefundOutput(chainSwap)
+		} else {
+			data = chainSwap.ToData
+			claimTimeoutBlockHeight = data.TimeoutBlockHeight
+			var lockupTx boltz.Transaction
+			lockupTx, err = nursery.onchain.GetTransaction(data.Currency, data.LockupTransactionId, data.BlindingKey)
+			if err != nil {
+				return nil, fmt.Errorf("could not fetch lockup transaction: %w", err)
+			}
+			output, err = nursery.getChainSwapClaimOutput(chainSwap, lockupTx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &bumpOutput{
+			OutputDetails:           *output,
+			claimTimeoutBlockHeight: claimTimeoutBlockHeight,
+			setTransactionId: func(transactionId string, feeChange int64, _ bool) error {
+				fee := applyFeeChange(chainSwap.OnchainFee, feeChange)
+				if err := nursery.database.SetChainSwapTransactionId(chainSwap, data, transactionId, fee); err != nil {
+					return err
+				}
+				nursery.sendChainSwapUpdate(*chainSwap)
+				return nil
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown swap type: %s", pending.SwapType)
+}
@@ -0,0 +1,158 @@
+package nursery
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/stretchr/testify/require"
+)
+
+// refundedSwap returns a swap which was refunded cooperatively and the pending refund transaction
+func refundedSwap(t *testing.T, test *testNursery, options ...func(swap *database.Swap)) (*database.Swap, string) {
+	swap := test.createLockedSwap(t, swapAmount, options...)
+	require.NoError(t, test.server.FailLockup(swap.Id))
+	require.NoError(t, test.RefundSwaps([]database.Swap{*swap}, true))
+
+	swap, err := test.database.QuerySwap(swap.Id)
+	require.NoError(t, err)
+	require.NotEmpty(t, swap.RefundTransactionId)
+
+	pending, err := test.database.QueryPendingTransaction(swap.RefundTransactionId)
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	require.True(t, pending[0].Cooperative)
+	return swap, swap.RefundTransactionId
+}
+
+func feeRate(satPerVbyte float64) *float64 {
+	return &satPerVbyte
+}
+
+func TestBumpTransaction(t *testing.T) {
+	t.Run("Replace", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		swap, original := refundedSwap(t, test)
+
+		_, err := test.BumpTransaction(original, feeRate(1))
+		require.ErrorContains(t, err, "has to be higher")
+
+		replacement, err := test.BumpTransaction(original, feeRate(10))
+		require.NoError(t, err)
+		require.NotEqual(t, original, replacement)
+
+		bumped, err := test.database.QuerySwap(swap.Id)
+		require.NoError(t, err)
+		require.Equal(t, replacement, bumped.RefundTransactionId)
+		require.Greater(t, *bumped.OnchainFee, *swap.OnchainFee)
+
+		tx := test.btc.lastBroadcast(t)
+		require.Equal(t, replacement, tx.TxHash().String())
+		require.True(t, spends(tx, swap.LockupTransactionId))
+
+		// the replaced transaction can not be bumped anymore
+		_, err = test.BumpTransaction(original, feeRate(20))
+		require.Error(t, err)
+
+		pending, err := test.database.QueryPendingTransactions(boltz.CurrencyBtc)
+		require.NoError(t, err)
+		require.Len(t, pending, 2)
+		for _, transaction := range pending {
+			if transaction.Id == original {
+				require.Equal(t, replacement, transaction.ReplacedBy)
+			} else {
+				require.Empty(t, transaction.ReplacedBy)
+				require.Equal(t, float64(10), transaction.SatPerVbyte)
+			}
+		}
+	})
+
+	t.Run("ReplacedConfirms", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		swap, original := refundedSwap(t, test)
+
+		replacement, err := test.BumpTransaction(original, feeRate(10))
+		require.NoError(t, err)
+		broadcasts := test.btc.broadcastCount()
+
+		// the original made it into a block before the replacement, which disappears from the mempool
+		test.btc.confirm(original)
+		test.btc.evict(replacement)
+		test.btc.mine(startHeight + 1)
+
+		requireEventually(t, func() bool {
+			pending, err := test.database.QueryPendingTransactions(boltz.CurrencyBtc)
+			require.NoError(t, err)
+			return len(pending) == 0
+		})
+
+		reverted, err := test.database.QuerySwap(swap.Id)
+		require.NoError(t, err)
+		require.Equal(t, original, reverted.RefundTransactionId)
+		require.Equal(t, *swap.OnchainFee, *reverted.OnchainFee)
+		require.True(t, *reverted.RefundCooperative)
+		require.Equal(t, broadcasts, test.btc.broadcastCount())
+	})
+
+	t.Run("Evicted", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		swap, original := refundedSwap(t, test)
+
+		test.btc.evict(original)
+		test.btc.mine(startHeight + 1)
+
+		requireEventually(t, func() bool {
+			bumped, err := test.database.QuerySwap(swap.Id)
+			require.NoError(t, err)
+			return bumped.RefundTransactionId != original
+		})
+	})
+
+	t.Run("MaxFee", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		maxFee := uint64(1000)
+		swap, original := refundedSwap(t, test, func(swap *database.Swap) {
+			swap.FeePolicy = &onchain.FeePolicy{MaxFee: &maxFee}
+		})
+		broadcasts := test.btc.broadcastCount()
+
+		_, err := test.BumpTransaction(original, feeRate(50))
+		require.ErrorIs(t, err, onchain.ErrFeeTooHigh)
+		require.Equal(t, broadcasts, test.btc.broadcastCount())
+
+		notBumped, err := test.database.QuerySwap(swap.Id)
+		require.NoError(t, err)
+		require.Equal(t, original, notBumped.RefundTransactionId)
+	})
+
+	t.Run("FixedFeeRate", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		_, original := refundedSwap(t, test, func(swap *database.Swap) {
+			swap.FeePolicy = &onchain.FeePolicy{SatPerVbyte: feeRate(2)}
+		})
+
+		transactions, err := test.database.QueryPendingTransaction(original)
+		require.NoError(t, err)
+		outputs, err := test.getBumpOutputs(transactions)
+		require.NoError(t, err)
+
+		_, err = test.bumpTransaction(transactions, outputs, nil, false)
+		require.ErrorContains(t, err, "fixed by its fee policy")
+
+		// the user can still choose a higher fee rate
+		_, err = test.bumpTransaction(transactions, outputs, feeRate(5), false)
+		require.NoError(t, err)
+	})
+}
+
+func TestSplitFeeChange(t *testing.T) {
+	require.Equal(t, []int64{3, 3, 4}, splitFeeChange(10, 3))
+	require.Equal(t, []int64{-3, -3, -4}, splitFeeChange(-10, 3))
+
+	fee := uint64(100)
+	require.Zero(t, applyFeeChange(&fee, -30))
+	require.Equal(t, uint64(70), fee)
+	require.Equal(t, uint64(30), applyFeeChange(&fee, 30))
+	require.Equal(t, uint64(70), fee)
+}
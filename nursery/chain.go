@@ -0,0 +1,419 @@
+package nursery
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/BoltzExchange/boltz-client/logger"
+)
+
+func (nursery *Nursery) sendChainSwapUpdate(chainSwap database.ChainSwap) {
+	nursery.sendUpdate(chainSwap.Id, SwapUpdate{
+		ChainSwap: &chainSwap,
+		IsFinal:   chainSwap.State != boltzrpc.SwapState_PENDING,
+	})
+}
+
+func (nursery *Nursery) RegisterChainSwap(chainSwap database.ChainSwap) error {
+	if err := nursery.registerSwap(chainSwap.Id); err != nil {
+		return err
+	}
+	nursery.sendChainSwapUpdate(chainSwap)
+	return nil
+}
+
+func (nursery *Nursery) getChainSwapAddress(data *database.ChainSwapData) (string, error) {
+	if data.Address != "" {
+		return data.Address, nil
+	}
+	wallet, err := nursery.onchain.GetAnyWallet(data.Currency, true)
+	if data.Wallet != "" {
+		wallet, err = nursery.onchain.GetWallet(data.Wallet, data.Currency, true)
+	}
+	if err != nil {
+		return "", fmt.Errorf("no address set and no wallet for currency %s is available: %w", data.Currency, err)
+	}
+	address, err := wallet.NewAddress()
+	if err != nil {
+		return "", fmt.Errorf("could not get address from wallet %s: %w", wallet.Name(), err)
+	}
+	if err := nursery.database.SetChainSwapAddress(data, address); err != nil {
+		return "", fmt.Errorf("could not set address in database: %w", err)
+	}
+	return address, nil
+}
+
+func (nursery *Nursery) getChainSwapServerLockup(chainSwap *database.ChainSwap, status boltz.SwapStatusResponse) (boltz.Transaction, error) {
+	transactionHex := status.Transaction.Hex
+	if transactionHex == "" {
+		transactions, err := nursery.boltz.GetChainSwapTransactions(chainSwap.Id)
+		if err != nil {
+			return nil, fmt.Errorf("could not get lockup transaction from boltz: %w", err)
+		}
+		if transactions.ServerLock == nil {
+			return nil, errors.New("boltz did not return lockup transaction")
+		}
+		transactionHex = transactions.ServerLock.Transaction.Hex
+	}
+	return boltz.NewTxFromHex(chainSwap.Pair.To, transactionHex, chainSwap.ToData.BlindingKey)
+}
+
+func (nursery *Nursery) claimChainSwap(chainSwap *database.ChainSwap, lockupTx boltz.Transaction) error {
+	data := chainSwap.ToData
+
+	if err := nursery.database.SetChainSwapLockupTransactionId(data, lockupTx.Hash()); err != nil {
+		return fmt.Errorf("could not set lockup transaction id in database: %w", err)
+	}
+
+	output, err := nursery.getChainSwapClaimOutput(chainSwap, lockupTx)
+	if err != nil {
+		return err
+	}
+
+	feeSatPerVbyte, err := nursery.getFeeEstimation(data.Currency)
+	if err != nil {
+		return fmt.Errorf("could not get fee estimation: %w", err)
+	}
+
+	logger.Infof("Using fee of %v sat/vbyte for claim transaction", feeSatPerVbyte)
+	logger.Info("Constructing claim transaction for Chain Swap " + chainSwap.Id + " with output: " + lockupTx.Hash() + ":" + strconv.Itoa(int(output.Vout)))
+
+	claimTransactionId, claimFee, err := nursery.createTransaction(data.Currency, []boltz.OutputDetails{*output}, feeSatPerVbyte)
+	if err != nil {
+		logger.Warnf("Could not construct cooperative claim transaction: %v", err)
+		output.Cooperative = false
+		claimTransactionId, claimFee, err = nursery.createTransaction(data.Currency, []boltz.OutputDetails{*output}, feeSatPerVbyte)
+		if err != nil {
+			return fmt.Errorf("could not construct claim transaction: %w", err)
+		}
+	}
+
+	logger.Infof("Claimed Chain Swap %s with transaction %s", chainSwap.Id, claimTransactionId)
+
+	if err := nursery.database.SetChainSwapTransactionId(chainSwap, data, claimTransactionId, claimFee); err != nil {
+		return fmt.Errorf("could not set claim transaction id in database: %w", err)
+	}
+	return nil
+}
+
+func (nursery *Nursery) getChainSwapClaimOutput(chainSwap *database.ChainSwap, lockupTx boltz.Transaction) (*boltz.OutputDetails, error) {
+	data := chainSwap.ToData
+
+	lockupVout, lockupValue, err := lockupTx.FindVout(nursery.network, data.LockupAddress)
+	if err != nil {
+		return nil, fmt.Errorf("could not find lockup vout of Chain Swap %s: %w", chainSwap.Id, err)
+	}
+
+	if lockupValue < data.Amount {
+		return nil, errors.New("boltz locked up less onchain coins than expected")
+	}
+
+	address, err := nursery.getChainSwapAddress(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &boltz.OutputDetails{
+		SwapId:            chainSwap.Id,
+		SwapType:          boltz.ChainSwap,
+		LockupTransaction: lockupTx,
+		Vout:              lockupVout,
+		Address:           address,
+		PrivateKey:        data.PrivateKey,
+		Preimage:          chainSwap.Preimage,
+		SwapTree:          data.Tree,
+		Cooperative:       true,
+	}, nil
+}
+
+// cooperativeChainSwapClaim signs the transaction with which boltz claims our lockup
+func (nursery *Nursery) cooperativeChainSwapClaim(chainSwap *database.ChainSwap) error {
+	logger.Debugf("Trying to sign claim of Chain Swap %s cooperatively", chainSwap.Id)
+
+	claimDetails, err := nursery.boltz.GetChainSwapClaimDetails(chainSwap.Id)
+	if err != nil {
+		return fmt.Errorf("could not get claim details from boltz: %w", err)
+	}
+
+	session, err := boltz.NewSigningSession(chainSwap.FromData.Tree)
+	if err != nil {
+		return fmt.Errorf("could not create signing session: %w", err)
+	}
+
+	partial, err := session.Sign(claimDetails.TransactionHash, claimDetails.PubNonce)
+	if err != nil {
+		return fmt.Errorf("could not create partial signature: %w", err)
+	}
+
+	if err := nursery.boltz.SendChainSwapClaimSignature(chainSwap.Id, partial); err != nil {
+		return fmt.Errorf("could not send partial signature to boltz: %w", err)
+	}
+	return nil
+}
+
+func (nursery *Nursery) getChainSwapRefundOutput(chainSwap *database.ChainSwap) (*boltz.OutputDetails, error) {
+	data := chainSwap.FromData
+
+	lockupTransaction, err := nursery.onchain.GetTransaction(data.Currency, data.LockupTransactionId, data.BlindingKey)
+	if err != nil {
+		return nil, errors.New("could not fetch lockup transaction: " + err.Error())
+	}
+
+	lockupVout, _, err := lockupTransaction.FindVout(nursery.network, data.LockupAddress)
+	if err != nil {
+		return nil, fmt.Errorf("could not find lockup vout of Chain Swap %s: %w", chainSwap.Id, err)
+	}
+
+	address, err := nursery.getChainSwapAddress(data)
+	if err != nil {
+		return nil, fmt.Errorf("Chain Swap %s can not be refunded: %w", chainSwap.Id, err)
+	}
+
+	return &boltz.OutputDetails{
+		SwapId:             chainSwap.Id,
+		SwapType:           boltz.ChainSwap,
+		LockupTransaction:  lockupTransaction,
+		Vout:               lockupVout,
+		Address:            address,
+		PrivateKey:         data.PrivateKey,
+		Preimage:           []byte{},
+		TimeoutBlockHeight: data.TimeoutBlockHeight,
+		SwapTree:           data.Tree,
+		Cooperative:        true,
+	}, nil
+}
+
+func (nursery *Nursery) RefundChainSwaps(swapsToRefund []database.ChainSwap, cooperative bool) error {
+	currency := swapsToRefund[0].Pair.From
+
+	var refundedSwaps []database.ChainSwap
+	var refundOutputs []boltz.OutputDetails
+
+	for _, swapToRefund := range swapsToRefund {
+		refundOutput, err := nursery.getChainSwapRefundOutput(&swapToRefund)
+		if err != nil {
+			return fmt.Errorf("Could not get refund output of Chain Swap %s: %v", swapToRefund.Id, err)
+		}
+
+		refundOutput.Cooperative = cooperative
+		refundedSwaps = append(refundedSwaps, swapToRefund)
+		refundOutputs = append(refundOutputs, *refundOutput)
+	}
+
+	if len(refundOutputs) == 0 {
+		logger.Info("Did not find any outputs to refund")
+		return nil
+	}
+
+	feeSatPerVbyte, err := nursery.getFeeEstimation(currency)
+	if err != nil {
+		return errors.New("Could not get fee estimation: " + err.Error())
+	}
+
+	logger.Info(fmt.Sprintf("Using fee of %v sat/vbyte for refund transaction", feeSatPerVbyte))
+
+	refundTransactionId, totalRefundFee, err := nursery.createTransaction(currency, refundOutputs, feeSatPerVbyte)
+	var cooperativeErr *boltz.CooperativeError
+	if errors.As(err, &cooperativeErr) {
+		refundedSwaps, refundOutputs, err = nursery.handleCooperativeChainRefundError(currency, refundedSwaps, refundOutputs, cooperativeErr)
+		if err != nil {
+			return err
+		}
+		if len(refundOutputs) == 0 {
+			return cooperativeErr
+		}
+		refundTransactionId, totalRefundFee, err = nursery.createTransaction(currency, refundOutputs, feeSatPerVbyte)
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("Constructed refund transaction for %d Chain Swaps: %s", len(refundOutputs), refundTransactionId)
+
+	count := uint64(len(refundedSwaps))
+	refundFee := totalRefundFee / count
+	for i, refundedSwap := range refundedSwaps {
+		// distribute the remainder of the fee to the last swap
+		if i == int(count)-1 {
+			refundFee += totalRefundFee % count
+		}
+		if err := nursery.database.SetChainSwapTransactionId(&refundedSwap, refundedSwap.FromData, refundTransactionId, refundFee); err != nil {
+			logger.Error("Could not set refund transaction id in database: " + err.Error())
+			continue
+		}
+
+		if err := nursery.database.UpdateChainSwapState(&refundedSwap, boltzrpc.SwapState_REFUNDED, ""); err != nil {
+			logger.Error("Could not update state of Chain Swap: " + err.Error())
+			continue
+		}
+
+		nursery.sendChainSwapUpdate(refundedSwap)
+
+		logger.Infof("Refunded Chain Swap %s with refund transaction %s", refundedSwap.Id, refundTransactionId)
+	}
+	return nil
+}
+
+// handleCooperativeChainRefundError switches the outputs for which boltz refused to cooperate to the script path.
+// Outputs whose timeout has not been reached yet are removed from the batch; the block listener refunds them once they time out.
+func (nursery *Nursery) handleCooperativeChainRefundError(
+	currency boltz.Currency,
+	chainSwaps []database.ChainSwap,
+	outputs []boltz.OutputDetails,
+	cooperativeErr *boltz.CooperativeError,
+) ([]database.ChainSwap, []boltz.OutputDetails, error) {
+	blockHeight, err := nursery.onchain.GetBlockHeight(currency)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get block height: %w", err)
+	}
+
+	var remainingSwaps []database.ChainSwap
+	var remainingOutputs []boltz.OutputDetails
+	for i, chainSwap := range chainSwaps {
+		output := outputs[i]
+		if swapErr, failed := cooperativeErr.Errors[chainSwap.Id]; failed {
+			logger.Warnf("Could not refund Chain Swap %s cooperatively: %s", chainSwap.Id, swapErr)
+
+			if timeout := chainSwap.FromData.TimeoutBlockHeight; timeout > blockHeight {
+				logger.Infof("Chain Swap %s will be refunded via the script path at block %d", chainSwap.Id, timeout)
+				continue
+			}
+			output.Cooperative = false
+		}
+		remainingSwaps = append(remainingSwaps, chainSwap)
+		remainingOutputs = append(remainingOutputs, output)
+	}
+
+	return remainingSwaps, remainingOutputs, nil
+}
+
+func (nursery *Nursery) handleChainSwapStatus(chainSwap *database.ChainSwap, status boltz.SwapStatusResponse) {
+	parsedStatus := boltz.ParseEvent(status.Status)
+
+	isPending := chainSwap.State == boltzrpc.SwapState_PENDING
+	// a failed status might have been saved without the swap being moved into a terminal state
+	isStale := isPending && parsedStatus.IsFailedStatus()
+	// the claim might not have been broadcast before shutting down
+	isUnclaimed := isPending && chainSwap.ToData.TransactionId == "" &&
+		(parsedStatus == boltz.TransactionServerConfirmed || parsedStatus == boltz.TransactionServerMempool && chainSwap.AcceptZeroConf)
+	if parsedStatus == chainSwap.Status && !isStale && !isUnclaimed {
+		logger.Info("Status of Chain Swap " + chainSwap.Id + " is " + parsedStatus.String() + " already")
+		return
+	}
+
+	handleError := func(err string) {
+		if dbErr := nursery.database.UpdateChainSwapState(chainSwap, boltzrpc.SwapState_ERROR, err); dbErr != nil {
+			logger.Error("Could not update Chain Swap state: " + dbErr.Error())
+		}
+		logger.Error(err)
+		nursery.sendChainSwapUpdate(*chainSwap)
+	}
+
+	switch parsedStatus {
+	case boltz.TransactionMempool:
+		fallthrough
+
+	case boltz.TransactionConfirmed:
+		if chainSwap.FromData.LockupTransactionId != "" {
+			break
+		}
+
+		if err := nursery.database.SetChainSwapLockupTransactionId(chainSwap.FromData, status.Transaction.Id); err != nil {
+			handleError("Could not set lockup transaction id in database: " + err.Error())
+			return
+		}
+
+		logger.Infof("Found lockup transaction %s of Chain Swap %s", status.Transaction.Id, chainSwap.Id)
+
+		// dont add onchain fee if the swap was paid externally as it might have been part of a larger transaction
+		if chainSwap.FromData.Wallet != "" {
+			fee, err := nursery.onchain.GetTransactionFee(chainSwap.Pair.From, status.Transaction.Id)
+			if err != nil {
+				handleError("Could not get lockup transaction fee: " + err.Error())
+				return
+			}
+			if err := nursery.database.SetChainSwapOnchainFee(chainSwap, fee); err != nil {
+				handleError("Could not set lockup transaction fee in database: " + err.Error())
+				return
+			}
+		}
+
+	case boltz.TransactionServerMempool:
+		fallthrough
+
+	case boltz.TransactionServerConfirmed:
+		if parsedStatus == boltz.TransactionServerMempool && !chainSwap.AcceptZeroConf {
+			break
+		}
+
+		if chainSwap.ToData.TransactionId != "" {
+			break
+		}
+
+		lockupTx, err := nursery.getChainSwapServerLockup(chainSwap, status)
+		if err != nil {
+			handleError("Could not decode lockup transaction: " + err.Error())
+			return
+		}
+
+		if err := nursery.claimChainSwap(chainSwap, lockupTx); err != nil {
+			handleError("Could not claim Chain Swap " + chainSwap.Id + ": " + err.Error())
+			return
+		}
+
+	case boltz.TransactionClaimPending:
+		if err := nursery.cooperativeChainSwapClaim(chainSwap); err != nil {
+			logger.Warnf("Could not sign claim of Chain Swap %s cooperatively: %s", chainSwap.Id, err)
+		}
+	}
+
+	if err := nursery.database.UpdateChainSwapStatus(chainSwap, parsedStatus); err != nil {
+		handleError("Could not update status of Chain Swap " + chainSwap.Id + ": " + err.Error())
+		return
+	}
+
+	if parsedStatus.IsCompletedStatus() {
+		if chainSwap.ToData.TransactionId == "" {
+			logger.Warnf("Chain Swap %s completed but no claim transaction was broadcast", chainSwap.Id)
+		}
+
+		sent := chainSwap.FromData.Amount
+		serviceFee := uint64(chainSwap.ServiceFeePercent.Calculate(float64(sent)))
+		var boltzOnchainFee uint64
+		if sent > chainSwap.ToData.Amount+serviceFee {
+			boltzOnchainFee = sent - chainSwap.ToData.Amount - serviceFee
+		}
+
+		logger.Infof("Chain Swap service fee: %dsat; boltz onchain fee: %dsat", serviceFee, boltzOnchainFee)
+
+		if err := nursery.database.SetChainSwapServiceFee(chainSwap, serviceFee, boltzOnchainFee); err != nil {
+			handleError("Could not set chain swap service fee in database: " + err.Error())
+			return
+		}
+		if err := nursery.database.UpdateChainSwapState(chainSwap, boltzrpc.SwapState_SUCCESSFUL, ""); err != nil {
+			handleError("Could not update state of Chain Swap " + chainSwap.Id + ": " + err.Error())
+			return
+		}
+	} else if parsedStatus.IsFailedStatus() {
+		if chainSwap.State == boltzrpc.SwapState_PENDING {
+			if err := nursery.database.UpdateChainSwapState(chainSwap, boltzrpc.SwapState_SERVER_ERROR, ""); err != nil {
+				handleError("Could not update state of Chain Swap " + chainSwap.Id + ": " + err.Error())
+				return
+			}
+		}
+
+		if chainSwap.FromData.LockupTransactionId != "" && chainSwap.FromData.TransactionId == "" {
+			logger.Infof("Chain Swap %s failed, trying to refund cooperatively", chainSwap.Id)
+			if err := nursery.RefundChainSwaps([]database.ChainSwap{*chainSwap}, true); err != nil {
+				handleError("Could not refund Chain Swap " + chainSwap.Id + ": " + err.Error())
+			}
+			return
+		}
+	}
+
+	nursery.sendChainSwapUpdate(*chainSwap)
+}
@@ -0,0 +1,154 @@
+package nursery
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	liquidToBtc = boltz.Pair{From: boltz.CurrencyLiquid, To: boltz.CurrencyBtc}
+	btcToLiquid = boltz.Pair{From: boltz.CurrencyBtc, To: boltz.CurrencyLiquid}
+)
+
+const chainSwapAmount = 100000
+
+func TestChainSwapClaim(t *testing.T) {
+	test := newTestNursery(t, Config{}, nil)
+	chainSwap := test.createChainSwap(t, liquidToBtc, chainSwapAmount)
+
+	lockupId, err := test.server.Lockup(chainSwap.Id)
+	require.NoError(t, err)
+	require.NoError(t, test.server.Confirm(chainSwap.Id))
+
+	// the mock server considers the swap claimed as soon as it cosigned the claim
+	var claimed *database.ChainSwap
+	requireEventually(t, func() bool {
+		claimed, err = test.database.QueryChainSwap(chainSwap.Id)
+		require.NoError(t, err)
+		return claimed.ToData.TransactionId != ""
+	})
+	require.Equal(t, lockupId, claimed.ToData.LockupTransactionId)
+
+	claim := test.btc.lastBroadcast(t)
+	require.True(t, spends(claim, lockupId))
+	require.True(t, isKeyPath(claim.TxIn[0]))
+
+	test.requireChainSwapState(t, chainSwap.Id, boltzrpc.SwapState_SUCCESSFUL)
+
+	// there are no updates to wait for anymore
+	requireEventually(t, func() bool {
+		return !slices.Contains(test.boltzWs.SwapIds(), chainSwap.Id)
+	})
+}
+
+func lockupChainSwap(t *testing.T, test *testNursery, id string) string {
+	chainSwap, err := test.database.QueryChainSwap(id)
+	require.NoError(t, err)
+	lockupId := test.lockup(t, chainSwap.FromData.LockupAddress, chainSwap.FromData.Amount)
+	requireEventually(t, func() bool {
+		chainSwap, err := test.database.QueryChainSwap(id)
+		require.NoError(t, err)
+		return chainSwap.FromData.LockupTransactionId == lockupId
+	})
+	return lockupId
+}
+
+func TestChainSwapRefund(t *testing.T) {
+	t.Run("Cooperative", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		chainSwap := test.createChainSwap(t, btcToLiquid, chainSwapAmount)
+		lockupId := lockupChainSwap(t, test, chainSwap.Id)
+
+		require.NoError(t, test.server.FailLockup(chainSwap.Id))
+
+		refunded := test.requireChainSwapState(t, chainSwap.Id, boltzrpc.SwapState_REFUNDED)
+		require.NotEmpty(t, refunded.FromData.TransactionId)
+
+		refund := test.btc.lastBroadcast(t)
+		require.Equal(t, refunded.FromData.TransactionId, refund.TxHash().String())
+		require.True(t, spends(refund, lockupId))
+		require.True(t, isKeyPath(refund.TxIn[0]))
+	})
+
+	t.Run("ScriptPath", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		test.server.RefuseCosigning(true)
+		chainSwap := test.createChainSwap(t, btcToLiquid, chainSwapAmount)
+		lockupId := lockupChainSwap(t, test, chainSwap.Id)
+
+		require.NoError(t, test.server.FailLockup(chainSwap.Id))
+
+		// the timeout has not been reached yet, so the refund has to wait for it
+		failed := test.requireChainSwapState(t, chainSwap.Id, boltzrpc.SwapState_ERROR)
+		require.Empty(t, failed.FromData.TransactionId)
+		require.Zero(t, test.btc.broadcastCount())
+
+		test.btc.mine(chainSwap.FromData.TimeoutBlockHeight)
+
+		refunded := test.requireChainSwapState(t, chainSwap.Id, boltzrpc.SwapState_REFUNDED)
+		refund := test.btc.lastBroadcast(t)
+		require.Equal(t, refunded.FromData.TransactionId, refund.TxHash().String())
+		require.True(t, spends(refund, lockupId))
+		require.False(t, isKeyPath(refund.TxIn[0]))
+	})
+
+	t.Run("TimedOut", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		test.server.RefuseCosigning(true)
+		chainSwap := test.createChainSwap(t, btcToLiquid, chainSwapAmount)
+		lockupId := lockupChainSwap(t, test, chainSwap.Id)
+		test.btc.setHeight(chainSwap.FromData.TimeoutBlockHeight)
+
+		require.NoError(t, test.server.FailLockup(chainSwap.Id))
+
+		// the timeout has been reached already, so the refund falls back to the script path right away
+		refunded := test.requireChainSwapState(t, chainSwap.Id, boltzrpc.SwapState_REFUNDED)
+		refund := test.btc.lastBroadcast(t)
+		require.Equal(t, refunded.FromData.TransactionId, refund.TxHash().String())
+		require.True(t, spends(refund, lockupId))
+		require.False(t, isKeyPath(refund.TxIn[0]))
+	})
+}
+
+func TestChainSwapRecovery(t *testing.T) {
+	t.Run("Unclaimed", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		chainSwap := test.createChainSwap(t, liquidToBtc, chainSwapAmount)
+		test.Stop()
+
+		lockupId, err := test.server.Lockup(chainSwap.Id)
+		require.NoError(t, err)
+		require.NoError(t, test.server.Confirm(chainSwap.Id))
+		// the status was saved, but the claim was not broadcast before shutting down
+		require.NoError(t, test.database.UpdateChainSwapStatus(chainSwap, boltz.TransactionServerConfirmed))
+
+		restarted := startTestNursery(t, test.server, Config{}, test.database)
+		requireEventually(t, func() bool {
+			claimed, err := restarted.database.QueryChainSwap(chainSwap.Id)
+			require.NoError(t, err)
+			return claimed.ToData.TransactionId != ""
+		})
+		require.True(t, spends(restarted.btc.lastBroadcast(t), lockupId))
+	})
+
+	t.Run("Stale", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		chainSwap := test.createChainSwap(t, btcToLiquid, chainSwapAmount)
+		lockupChainSwap(t, test, chainSwap.Id)
+		test.Stop()
+
+		require.NoError(t, test.server.FailLockup(chainSwap.Id))
+		// the failed status was saved, but the swap was not moved into a terminal state before shutting down
+		chainSwap, err := test.database.QueryChainSwap(chainSwap.Id)
+		require.NoError(t, err)
+		require.NoError(t, test.database.UpdateChainSwapStatus(chainSwap, boltz.TransactionLockupFailed))
+
+		restarted := startTestNursery(t, test.server, Config{}, test.database)
+		restarted.requireChainSwapState(t, chainSwap.Id, boltzrpc.SwapState_REFUNDED)
+	})
+}
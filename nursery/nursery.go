@@ -1,9 +1,12 @@
 package nursery
 
 import (
+	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/BoltzExchange/boltz-client/utils"
@@ -31,23 +34,55 @@ type Nursery struct {
 	globalListener     swapListener
 	waitGroup          sync.WaitGroup
 	stop               *utils.ChannelForwarder[bool]
+	bumpLock           sync.Mutex
 
-	stopped bool
+	claimBatchWindow time.Duration
+	claimBatches     map[claimBatchKey]*claimBatch
+	claimBatchesLock sync.Mutex
+
+	blockSamples       map[boltz.Currency][]blockSample
+	blockSamplesLock   sync.Mutex
+	timeoutWarnings    []uint32
+	warnedTimeouts     map[string]uint32
+	warnedTimeoutsLock sync.Mutex
+
+	statusPolls chan bool
+
+	stopped atomic.Bool
 }
 
 const retryInterval = 15
 
+// how often the status of pending swaps is polled while the websocket is not connected
+const statusPollInterval = 30 * time.Second
+
+var ErrBelowTargetAmount = errors.New("claim output is below the target amount")
+
+type Config struct {
+	// time to wait for more Reverse Swaps to claim in the same transaction; 0 disables batching
+	ClaimBatchWindow time.Duration
+	// amounts of blocks left until the timeout of a swap at which a warning is emitted
+	TimeoutWarnings []uint32
+	// how often the endpoints of the Boltz API are checked if there are fallbacks; 0 disables the checks
+	HealthCheckInterval time.Duration
+}
+
 type SwapUpdate struct {
-	Swap        *database.Swap
-	ReverseSwap *database.ReverseSwap
-	IsFinal     bool
+	Swap           *database.Swap
+	ReverseSwap    *database.ReverseSwap
+	ChainSwap      *database.ChainSwap
+	IsFinal        bool
+	TimeoutWarning *TimeoutWarning
 }
 
 type swapListener = *utils.ChannelForwarder[SwapUpdate]
 
 func (nursery *Nursery) sendUpdate(id string, update SwapUpdate) {
 	nursery.globalListener.Send(update)
-	if listener, ok := nursery.eventListeners[id]; ok {
+	nursery.eventListenersLock.Lock()
+	listener, ok := nursery.eventListeners[id]
+	nursery.eventListenersLock.Unlock()
+	if ok {
 		listener.Send(update)
 		logger.Debugf("Sent update for swap %s", id)
 
@@ -57,6 +92,16 @@ func (nursery *Nursery) sendUpdate(id string, update SwapUpdate) {
 	} else {
 		logger.Debugf("No listener for swap %s", id)
 	}
+
+	if update.IsFinal {
+		nursery.warnedTimeoutsLock.Lock()
+		delete(nursery.warnedTimeouts, id)
+		nursery.warnedTimeoutsLock.Unlock()
+
+		if err := nursery.boltzWs.Unsubscribe([]string{id}); err != nil {
+			logger.Warnf("Could not unsubscribe from updates of swap %s: %v", id, err)
+		}
+	}
 }
 
 func (nursery *Nursery) SwapUpdates(id string) (<-chan SwapUpdate, func()) {
@@ -84,6 +129,7 @@ func (nursery *Nursery) Init(
 	chain *onchain.Onchain,
 	boltzClient *boltz.Boltz,
 	database *database.Database,
+	config Config,
 ) error {
 	nursery.network = network
 	nursery.lightning = lightning
@@ -91,35 +137,54 @@ func (nursery *Nursery) Init(
 	nursery.database = database
 	nursery.onchain = chain
 	nursery.eventListeners = make(map[string]swapListener)
+	nursery.claimBatchWindow = config.ClaimBatchWindow
+	nursery.claimBatches = make(map[claimBatchKey]*claimBatch)
+	nursery.blockSamples = make(map[boltz.Currency][]blockSample)
+	nursery.timeoutWarnings = config.TimeoutWarnings
+	nursery.warnedTimeouts = make(map[string]uint32)
+	nursery.statusPolls = make(chan bool, 1)
 	nursery.globalListener = utils.ForwardChannel(make(chan SwapUpdate), 0, false)
 	nursery.stop = utils.ForwardChannel(make(chan bool), 0, false)
-	nursery.boltzWs = boltz.NewBoltzWebsocket(boltzClient.URL)
+	nursery.boltzWs = boltz.NewBoltzWebsocket(boltzClient)
+
+	boltzClient.SetEndpointResolver(nursery.swapEndpoint)
+	database.SetBlockHeightResolver(nursery.cachedBlockHeight)
 
 	logger.Info("Starting nursery")
 
 	if err := nursery.boltzWs.Connect(); err != nil {
-		return fmt.Errorf("could not connect to boltz websocket: %v", err)
+		logger.Errorf("Could not connect to boltz websocket, polling status of pending swaps until it is available: %v", err)
+		go nursery.boltzWs.Reconnect()
 	}
 
 	nursery.startBlockListener(boltz.CurrencyBtc)
 	nursery.startBlockListener(boltz.CurrencyLiquid)
+	if nursery.onchain.Rootstock != nil {
+		nursery.startBlockListener(boltz.CurrencyRootstock)
+	}
 
 	nursery.startSwapListener()
 
+	if config.HealthCheckInterval > 0 && len(boltzClient.FallbackURLs) > 0 {
+		nursery.startHealthMonitor(config.HealthCheckInterval)
+	}
+
 	return nursery.recoverPending()
 }
 
 func (nursery *Nursery) Stop() {
-	nursery.stopped = true
+	nursery.stopped.Store(true)
 	nursery.stop.Send(true)
 	logger.Debugf("Sent stop signal to block listener")
+	nursery.stopClaimBatches()
+	nursery.boltzWs.Close()
+	// the listeners are closed last, because swaps which are still being handled send updates to them
+	nursery.waitGroup.Wait()
 	for id := range nursery.eventListeners {
 		nursery.removeSwapListener(id)
 	}
 	nursery.globalListener.Close()
 	logger.Debugf("Closed all event listeners")
-	nursery.boltzWs.Close()
-	nursery.waitGroup.Wait()
 }
 
 func (nursery *Nursery) registerSwap(id string) error {
@@ -140,25 +205,39 @@ func (nursery *Nursery) registerSwap(id string) error {
 func (nursery *Nursery) recoverPending() error {
 	logger.Info("Recovering pending Swaps")
 
-	swaps, err := nursery.database.QueryPendingSwaps()
+	reverseSwaps, err := nursery.database.QueryPendingReverseSwaps()
 	if err != nil {
 		return err
 	}
 
-	reverseSwaps, err := nursery.database.QueryPendingReverseSwaps()
+	for _, reverseSwap := range reverseSwaps {
+		if reverseSwap.Status.IsFailedStatus() {
+			if err := nursery.failReverseSwap(&reverseSwap, reverseSwap.Status); err != nil {
+				logger.Errorf("Could not fail Reverse Swap %s: %v", reverseSwap.Id, err)
+				continue
+			}
+			nursery.sendReverseSwapUpdate(reverseSwap)
+		}
+	}
+
+	pendingSwaps, err := nursery.pendingSwaps()
 	if err != nil {
 		return err
 	}
 
 	var swapIds []string
-	for _, swap := range swaps {
-		swapIds = append(swapIds, swap.Id)
+	for _, pending := range pendingSwaps {
+		swapIds = append(swapIds, pending.id)
 	}
-	for _, reverseSwap := range reverseSwaps {
-		swapIds = append(swapIds, reverseSwap.Id)
+
+	if err := nursery.boltzWs.Subscribe(swapIds); err != nil {
+		return err
 	}
 
-	return nursery.boltzWs.Subscribe(swapIds)
+	// the websocket only sends updates which happen after subscribing
+	nursery.requestStatusPoll()
+
+	return nil
 }
 
 func (nursery *Nursery) startSwapListener() {
@@ -166,29 +245,169 @@ func (nursery *Nursery) startSwapListener() {
 
 	nursery.waitGroup.Add(1)
 
-	go func() {
-		for status := range nursery.boltzWs.Updates {
-			logger.Infof("Swap %s status update: %s", status.Id, status.Status)
+	stop := nursery.stop.Get()
+	updates := nursery.boltzWs.Updates
+	ticker := time.NewTicker(statusPollInterval)
 
-			swap, reverseSwap, err := nursery.database.QueryAnySwap(status.Id)
-			if err != nil {
-				logger.Errorf("Could not query swap %s: %v", status.Id, status.Status)
-				continue
-			}
-			if status.Error != "" {
-				logger.Warnf("Boltz could not find Swap %s: %s ", status.Id, status.Error)
-				continue
-			}
-			if swap != nil {
-				nursery.handleSwapStatus(swap, status.SwapStatusResponse)
-			} else if reverseSwap != nil {
-				nursery.handleReverseSwapStatus(reverseSwap, status.SwapStatusResponse)
+	go func() {
+		defer func() {
+			ticker.Stop()
+			nursery.stop.Remove(stop)
+			nursery.waitGroup.Done()
+		}()
+		// all status updates are handled in this goroutine, so polled ones can't race with the ones of the websocket
+		for {
+			select {
+			case status, ok := <-updates:
+				if !ok {
+					// the websocket is only closed when stopping, which is handled by the stop channel
+					updates = nil
+					continue
+				}
+				nursery.handleStatusUpdate(status)
+			case <-nursery.boltzWs.Reconnects:
+				logger.Info("Reconnected to boltz websocket, polling status of pending swaps")
+				nursery.pollPendingStatuses(false)
+			case <-nursery.statusPolls:
+				nursery.pollPendingStatuses(false)
+			case <-ticker.C:
+				if !nursery.boltzWs.Connected() {
+					logger.Info("Boltz websocket is not connected, polling status of pending swaps")
+					nursery.pollPendingStatuses(false)
+				} else {
+					nursery.pollPendingStatuses(true)
+				}
+			case <-stop:
+				return
 			}
 		}
-		nursery.waitGroup.Done()
 	}()
 }
 
+// startHealthMonitor switches back to the preferred Boltz endpoint once it is healthy again
+func (nursery *Nursery) startHealthMonitor(interval time.Duration) {
+	nursery.waitGroup.Add(1)
+
+	stop := nursery.stop.Get()
+	go func() {
+		defer func() {
+			nursery.stop.Remove(stop)
+			nursery.waitGroup.Done()
+		}()
+		nursery.boltz.MonitorHealth(interval, stop)
+	}()
+}
+
+func (nursery *Nursery) handleStatusUpdate(status boltz.SwapUpdate) {
+	logger.Infof("Swap %s status update: %s", status.Id, status.Status)
+
+	swap, reverseSwap, chainSwap, err := nursery.database.QueryAnySwap(status.Id)
+	if err != nil {
+		logger.Errorf("Could not query swap %s: %v", status.Id, status.Status)
+		return
+	}
+	if status.Error != "" {
+		logger.Warnf("Boltz could not find Swap %s: %s ", status.Id, status.Error)
+		return
+	}
+	if swap != nil {
+		nursery.handleSwapStatus(swap, status.SwapStatusResponse)
+	} else if reverseSwap != nil {
+		nursery.handleReverseSwapStatus(reverseSwap, status.SwapStatusResponse)
+	} else if chainSwap != nil {
+		nursery.handleChainSwapStatus(chainSwap, status.SwapStatusResponse)
+	}
+}
+
+// requestStatusPoll makes the swap listener poll the status of all pending swaps
+func (nursery *Nursery) requestStatusPoll() {
+	select {
+	case nursery.statusPolls <- true:
+	default:
+		// a poll is queued already
+	}
+}
+
+// pollPendingStatuses fetches the current status of pending swaps from the API, which catches up on updates that were
+// missed while the websocket was not connected. Swaps which were created with another endpoint than the one
+// the websocket is connected to never get updates from it, so only those are polled when onlyUnsubscribed is set.
+func (nursery *Nursery) pollPendingStatuses(onlyUnsubscribed bool) {
+	pendingSwaps, err := nursery.pendingSwaps()
+	if err != nil {
+		logger.Errorf("Could not query pending swaps: %v", err)
+		return
+	}
+
+	wsEndpoint := nursery.boltzWs.Endpoint()
+	for _, pending := range pendingSwaps {
+		if nursery.stopped.Load() {
+			return
+		}
+		if onlyUnsubscribed && (pending.boltzUrl == "" || pending.boltzUrl == wsEndpoint) {
+			continue
+		}
+		status, err := nursery.boltz.SwapStatus(pending.id)
+		if err != nil {
+			logger.Warnf("Could not poll status of Swap %s: %v", pending.id, err)
+			continue
+		}
+		nursery.handleStatusUpdate(boltz.SwapUpdate{SwapStatusResponse: *status, Id: pending.id})
+	}
+}
+
+// swapEndpoint returns the endpoint of the Boltz API a swap was created with
+func (nursery *Nursery) swapEndpoint(id string) string {
+	swap, reverseSwap, chainSwap, err := nursery.database.QueryAnySwap(id)
+	if err != nil {
+		return ""
+	}
+	if swap != nil {
+		return swap.BoltzUrl
+	} else if reverseSwap != nil {
+		return reverseSwap.BoltzUrl
+	} else if chainSwap != nil {
+		return chainSwap.BoltzUrl
+	}
+	return ""
+}
+
+type pendingSwap struct {
+	id       string
+	boltzUrl string
+}
+
+func (nursery *Nursery) pendingSwaps() ([]pendingSwap, error) {
+	swaps, err := nursery.database.QueryPendingSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	reverseSwaps, err := nursery.database.QueryPendingReverseSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	chainSwaps, err := nursery.database.QueryPendingChainSwaps()
+	if err != nil {
+		return nil, err
+	}
+
+	var pendingSwaps []pendingSwap
+	for _, swap := range swaps {
+		pendingSwaps = append(pendingSwaps, pendingSwap{id: swap.Id, boltzUrl: swap.BoltzUrl})
+	}
+	for _, reverseSwap := range reverseSwaps {
+		if reverseSwap.Status.IsFailedStatus() {
+			continue
+		}
+		pendingSwaps = append(pendingSwaps, pendingSwap{id: reverseSwap.Id, boltzUrl: reverseSwap.BoltzUrl})
+	}
+	for _, chainSwap := range chainSwaps {
+		pendingSwaps = append(pendingSwaps, pendingSwap{id: chainSwap.Id, boltzUrl: chainSwap.BoltzUrl})
+	}
+	return pendingSwaps, nil
+}
+
 func (nursery *Nursery) removeSwapListener(id string) {
 	nursery.eventListenersLock.Lock()
 	defer nursery.eventListenersLock.Unlock()
@@ -209,10 +428,10 @@ func (nursery *Nursery) registerBlockListener(currency boltz.Currency) chan *onc
 		defer func() {
 			close(blockNotifier)
 			nursery.stop.Remove(stop)
-			nursery.waitGroup.Done()
 			logger.Debugf("Closed block listener for %s", currency)
+			nursery.waitGroup.Done()
 		}()
-		for !nursery.stopped {
+		for !nursery.stopped.Load() {
 			listener := nursery.onchain.GetBlockListener(currency)
 			if listener == nil {
 				logger.Warnf("no block listener for %s", currency)
@@ -229,7 +448,7 @@ func (nursery *Nursery) registerBlockListener(currency boltz.Currency) chan *onc
 					logger.Errorf("Lost connection to %s block epoch stream: %s", currency, err.Error())
 					logger.Infof("Retrying connection in " + strconv.Itoa(retryInterval) + " seconds")
 				}
-				if nursery.stopped {
+				if nursery.stopped.Load() {
 					return
 				}
 				select {
@@ -243,21 +462,49 @@ func (nursery *Nursery) registerBlockListener(currency boltz.Currency) chan *onc
 	return blockNotifier
 }
 
-func (nursery *Nursery) getFeeEstimation(currency boltz.Currency) (float64, error) {
-	return nursery.onchain.EstimateFee(currency, 2)
+// getFeeEstimation returns the highest fee rate required by any of the fee policies
+func (nursery *Nursery) getFeeEstimation(currency boltz.Currency, policies ...*onchain.FeePolicy) (float64, error) {
+	if len(policies) == 0 {
+		policies = []*onchain.FeePolicy{nil}
+	}
+	var feeSatPerVbyte float64
+	for _, policy := range policies {
+		fee, err := nursery.onchain.EstimateFeeWithPolicy(currency, policy)
+		if err != nil {
+			return 0, err
+		}
+		feeSatPerVbyte = math.Max(feeSatPerVbyte, fee)
+	}
+	return feeSatPerVbyte, nil
 }
 
 func (nursery *Nursery) createTransaction(currency boltz.Currency, outputs []boltz.OutputDetails, feeSatPerVbyte float64) (string, uint64, error) {
+	// the fee and the target amount are checked before boltz is asked to cosign, since cooperative claims reveal the preimage
+	fee, err := boltz.CalculateTransactionFee(nursery.network, currency, outputs, feeSatPerVbyte)
+	if err != nil {
+		return "", 0, fmt.Errorf("calculate fee: %w", err)
+	}
+	share := fee / uint64(len(outputs))
+	for _, output := range outputs {
+		if err := onchain.CheckMaxFee(share, output.MaxFee); err != nil {
+			return "", 0, fmt.Errorf("swap %s: %w", output.SwapId, err)
+		}
+		if output.MinAmount != 0 && output.Value < output.MinAmount+share {
+			return "", 0, fmt.Errorf("swap %s: %w: %d sat < %d sat", output.SwapId, ErrBelowTargetAmount, int64(output.Value)-int64(share), output.MinAmount)
+		}
+	}
+
 	transaction, fee, err := boltz.ConstructTransaction(nursery.network, currency, outputs, feeSatPerVbyte, nursery.boltz)
 	if err != nil {
-		return "", 0, fmt.Errorf("construct transaction: %v", err)
+		return "", 0, fmt.Errorf("construct transaction: %w", err)
 	}
 
-	response, err := nursery.boltz.BroadcastTransaction(transaction)
+	transactionId, err := nursery.onchain.BroadcastTransaction(transaction)
 	if err != nil {
 		return "", 0, fmt.Errorf("broadcast transaction: %v", err)
 	}
-	logger.Info("Broadcast transaction with Boltz API")
 
-	return response.TransactionId, fee, nil
+	nursery.trackTransaction(transactionId, currency, outputs, fee, feeSatPerVbyte)
+
+	return transactionId, fee, nil
 }
@@ -0,0 +1,442 @@
+package nursery
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltz/mock"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/BoltzExchange/boltz-client/lightning"
+	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+var network = boltz.Regtest
+
+const startHeight = 1000
+
+// testChain is the block listener, fee provider and transaction provider of a currency. Transactions are stored by the
+// mock server, so that it can detect lockups and check the transactions it is asked to sign.
+type testChain struct {
+	onchain.TxProvider
+
+	lock        sync.Mutex
+	height      uint32
+	feeRate     float64
+	blocks      chan *onchain.BlockEpoch
+	broadcasts  []string
+	confirmed   map[string]bool
+	unavailable map[string]bool
+}
+
+func newTestChain(server *mock.Server, currency boltz.Currency) *testChain {
+	return &testChain{
+		TxProvider:  onchain.NewBoltzTxProvider(server.Client(), currency),
+		height:      startHeight,
+		feeRate:     2,
+		blocks:      make(chan *onchain.BlockEpoch),
+		confirmed:   make(map[string]bool),
+		unavailable: make(map[string]bool),
+	}
+}
+
+func (chain *testChain) RegisterBlockListener(channel chan<- *onchain.BlockEpoch, stop <-chan bool) error {
+	for {
+		select {
+		case block := <-chain.blocks:
+			select {
+			case channel <- block:
+			case <-stop:
+				return nil
+			}
+		case <-stop:
+			return nil
+		}
+	}
+}
+
+func (chain *testChain) GetBlockHeight() (uint32, error) {
+	chain.lock.Lock()
+	defer chain.lock.Unlock()
+	return chain.height, nil
+}
+
+// setHeight changes the block height without notifying the block listener of the nursery
+func (chain *testChain) setHeight(height uint32) {
+	chain.lock.Lock()
+	defer chain.lock.Unlock()
+	chain.height = height
+}
+
+// mine sends a new block to the nursery
+func (chain *testChain) mine(height uint32) {
+	chain.setHeight(height)
+	chain.blocks <- &onchain.BlockEpoch{Height: height, Timestamp: time.Now()}
+}
+
+func (chain *testChain) EstimateFee(int32) (float64, error) {
+	chain.lock.Lock()
+	defer chain.lock.Unlock()
+	return chain.feeRate, nil
+}
+
+func (chain *testChain) setFeeRate(feeRate float64) {
+	chain.lock.Lock()
+	defer chain.lock.Unlock()
+	chain.feeRate = feeRate
+}
+
+func (chain *testChain) BroadcastTransaction(txHex string) (string, error) {
+	id, err := chain.TxProvider.BroadcastTransaction(txHex)
+	if err == nil {
+		chain.lock.Lock()
+		chain.broadcasts = append(chain.broadcasts, txHex)
+		chain.lock.Unlock()
+	}
+	return id, err
+}
+
+func (chain *testChain) broadcastCount() int {
+	chain.lock.Lock()
+	defer chain.lock.Unlock()
+	return len(chain.broadcasts)
+}
+
+func (chain *testChain) lastBroadcast(t *testing.T) *wire.MsgTx {
+	chain.lock.Lock()
+	defer chain.lock.Unlock()
+	require.NotEmpty(t, chain.broadcasts)
+	tx, err := boltz.NewBtcTxFromHex(chain.broadcasts[len(chain.broadcasts)-1])
+	require.NoError(t, err)
+	return tx.MsgTx()
+}
+
+func (chain *testChain) IsTransactionConfirmed(txId string) (bool, error) {
+	chain.lock.Lock()
+	defer chain.lock.Unlock()
+	if chain.unavailable[txId] {
+		return false, onchain.ErrTransactionNotFound
+	}
+	return chain.confirmed[txId], nil
+}
+
+func (chain *testChain) confirm(txId string) {
+	chain.lock.Lock()
+	defer chain.lock.Unlock()
+	chain.confirmed[txId] = true
+}
+
+// evict makes a transaction disappear from the mempool
+func (chain *testChain) evict(txId string) {
+	chain.lock.Lock()
+	defer chain.lock.Unlock()
+	chain.unavailable[txId] = true
+}
+
+// testLightning is a lightning node which only knows the status of its payments
+type testLightning struct {
+	lightning.LightningNode
+	paymentStatus *lightning.PaymentStatus
+}
+
+func (node *testLightning) PaymentStatus([]byte) (*lightning.PaymentStatus, error) {
+	return node.paymentStatus, nil
+}
+
+type testNursery struct {
+	*Nursery
+	server   *mock.Server
+	btc      *testChain
+	liquid   *testChain
+	database *database.Database
+}
+
+func newTestDatabase(t *testing.T) *database.Database {
+	db := &database.Database{Path: filepath.Join(t.TempDir(), "boltz.db")}
+	require.NoError(t, db.Connect())
+	return db
+}
+
+// newTestNursery starts a nursery which uses a mock of the Boltz API and the database of an earlier nursery if given
+func newTestNursery(t *testing.T, config Config, db *database.Database) *testNursery {
+	server, err := mock.NewServer(network)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	server.SetBlockHeight(boltz.CurrencyBtc, startHeight)
+	server.SetBlockHeight(boltz.CurrencyLiquid, startHeight)
+
+	return startTestNursery(t, server, config, db)
+}
+
+func startTestNursery(t *testing.T, server *mock.Server, config Config, db *database.Database) *testNursery {
+	if db == nil {
+		db = newTestDatabase(t)
+	}
+	test := &testNursery{
+		Nursery:  &Nursery{},
+		server:   server,
+		btc:      newTestChain(server, boltz.CurrencyBtc),
+		liquid:   newTestChain(server, boltz.CurrencyLiquid),
+		database: db,
+	}
+	chain := &onchain.Onchain{
+		Btc:     &onchain.Currency{Listener: test.btc, Fees: test.btc, Tx: test.btc},
+		Liquid:  &onchain.Currency{Listener: test.liquid, Fees: test.liquid, Tx: test.liquid},
+		Network: network,
+	}
+	chain.Init()
+
+	require.NoError(t, test.Init(network, nil, chain, server.Client(), db, config))
+	t.Cleanup(func() {
+		if !test.stopped.Load() {
+			test.Stop()
+		}
+	})
+	return test
+}
+
+func newKey(t *testing.T) *btcec.PrivateKey {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	return key
+}
+
+func newPreimage(t *testing.T) ([]byte, []byte) {
+	preimage := make([]byte, 32)
+	_, err := rand.Read(preimage)
+	require.NoError(t, err)
+	hash := sha256.Sum256(preimage)
+	return preimage, hash[:]
+}
+
+func newAddress(t *testing.T) string {
+	address, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(newKey(t).PubKey().SerializeCompressed()), network.Btc)
+	require.NoError(t, err)
+	return address.EncodeAddress()
+}
+
+// payTo creates a transaction from a made up input to address
+func payTo(t *testing.T, address string, amount uint64) string {
+	decoded, err := btcutil.DecodeAddress(address, network.Btc)
+	require.NoError(t, err)
+	script, err := txscript.PayToAddrScript(decoded)
+	require.NoError(t, err)
+
+	var funding chainhash.Hash
+	_, err = rand.Read(funding[:])
+	require.NoError(t, err)
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&funding, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(int64(amount), script))
+
+	var serialized bytes.Buffer
+	require.NoError(t, tx.Serialize(&serialized))
+	return hex.EncodeToString(serialized.Bytes())
+}
+
+// lockup pays to the lockup address of a swap and returns the id of the transaction
+func (test *testNursery) lockup(t *testing.T, address string, amount uint64) string {
+	id, err := test.server.Client().BroadcastTransaction(payTo(t, address, amount), boltz.CurrencyBtc)
+	require.NoError(t, err)
+	return id
+}
+
+// spends returns whether the transaction spends an output of the given transaction
+func spends(tx *wire.MsgTx, transactionId string) bool {
+	for _, input := range tx.TxIn {
+		if input.PreviousOutPoint.Hash.String() == transactionId {
+			return true
+		}
+	}
+	return false
+}
+
+// isKeyPath returns whether the input was signed cooperatively
+func isKeyPath(input *wire.TxIn) bool {
+	return len(input.Witness) == 1
+}
+
+func requireEventually(t *testing.T, condition func() bool) {
+	require.Eventually(t, condition, 10*time.Second, 10*time.Millisecond)
+}
+
+func (test *testNursery) requireChainSwapState(t *testing.T, id string, state boltzrpc.SwapState) *database.ChainSwap {
+	var chainSwap *database.ChainSwap
+	requireEventually(t, func() bool {
+		var err error
+		chainSwap, err = test.database.QueryChainSwap(id)
+		require.NoError(t, err)
+		return chainSwap.State == state
+	})
+	return chainSwap
+}
+
+// createChainSwap creates a chain swap with the mock server like the rpc server does
+func (test *testNursery) createChainSwap(t *testing.T, pair boltz.Pair, amount uint64) *database.ChainSwap {
+	preimage, preimageHash := newPreimage(t)
+	refundKey, claimKey := newKey(t), newKey(t)
+
+	response, err := test.server.Client().CreateChainSwap(boltz.ChainRequest{
+		From:            pair.From,
+		To:              pair.To,
+		PreimageHash:    preimageHash,
+		ClaimPublicKey:  claimKey.PubKey().SerializeCompressed(),
+		RefundPublicKey: refundKey.PubKey().SerializeCompressed(),
+		UserLockAmount:  amount,
+	})
+	require.NoError(t, err)
+
+	parseData := func(details *boltz.ChainSwapData, currency boltz.Currency, key *btcec.PrivateKey) *database.ChainSwapData {
+		theirPublicKey, err := btcec.ParsePubKey(details.ServerPublicKey)
+		require.NoError(t, err)
+		data := &database.ChainSwapData{
+			Id:                 response.Id,
+			Currency:           currency,
+			PrivateKey:         key,
+			TheirPublicKey:     theirPublicKey,
+			Tree:               details.SwapTree.Deserialize(),
+			Amount:             details.Amount,
+			TimeoutBlockHeight: details.TimeoutBlockHeight,
+			LockupAddress:      details.LockupAddress,
+		}
+		if currency == boltz.CurrencyBtc {
+			data.Address = newAddress(t)
+		}
+		if details.BlindingKey != nil {
+			data.BlindingKey, _ = btcec.PrivKeyFromBytes(details.BlindingKey)
+		}
+		require.NoError(t, data.InitTree())
+		return data
+	}
+
+	chainSwap := database.ChainSwap{
+		Id:          response.Id,
+		Pair:        pair,
+		State:       boltzrpc.SwapState_PENDING,
+		Status:      boltz.SwapCreated,
+		Preimage:    preimage,
+		PaymentHash: preimageHash,
+		FromData:    parseData(response.LockupDetails, pair.From, refundKey),
+		ToData:      parseData(response.ClaimDetails, pair.To, claimKey),
+		CreatedAt:   time.Now(),
+	}
+	require.NoError(t, test.database.CreateChainSwap(chainSwap))
+	require.NoError(t, test.RegisterChainSwap(chainSwap))
+	return &chainSwap
+}
+
+// createLockedSwap creates a submarine swap with the mock server and locks it up. The nursery is not notified about
+// the swap, so that tests can drive it directly.
+func (test *testNursery) createLockedSwap(t *testing.T, amount uint64, options ...func(swap *database.Swap)) *database.Swap {
+	preimage, preimageHash := newPreimage(t)
+	refundKey := newKey(t)
+	pair := boltz.Pair{From: boltz.CurrencyBtc, To: boltz.CurrencyBtc}
+
+	response, err := test.server.Client().CreateSwap(boltz.CreateSwapRequest{
+		From:            pair.From,
+		To:              pair.To,
+		PreimageHash:    preimageHash,
+		RefundPublicKey: refundKey.PubKey().SerializeCompressed(),
+	})
+	require.NoError(t, err)
+
+	swap := database.Swap{
+		Id:                response.Id,
+		Pair:              pair,
+		State:             boltzrpc.SwapState_PENDING,
+		Status:            boltz.SwapCreated,
+		PrivateKey:        refundKey,
+		Preimage:          preimage,
+		PaymentHash:       preimageHash,
+		Address:           response.Address,
+		ExpectedAmount:    amount,
+		TimoutBlockHeight: response.TimeoutBlockHeight,
+		SwapTree:          response.SwapTree.Deserialize(),
+		RefundAddress:     newAddress(t),
+		CreatedAt:         time.Now(),
+	}
+	swap.ClaimPubKey, err = btcec.ParsePubKey(response.ClaimPublicKey)
+	require.NoError(t, err)
+	require.NoError(t, swap.InitTree())
+	for _, option := range options {
+		option(&swap)
+	}
+	require.NoError(t, test.database.CreateSwap(swap))
+	require.NoError(t, test.database.SetSwapLockupTransactionId(&swap, test.lockup(t, swap.Address, amount)))
+	return &swap
+}
+
+// createReverseSwap creates a reverse swap to the bitcoin chain with the mock server without notifying the nursery
+func (test *testNursery) createReverseSwap(t *testing.T, invoiceAmount uint64, options ...func(reverseSwap *database.ReverseSwap)) *database.ReverseSwap {
+	preimage, preimageHash := newPreimage(t)
+	claimKey := newKey(t)
+	pair := boltz.Pair{From: boltz.CurrencyBtc, To: boltz.CurrencyBtc}
+
+	response, err := test.server.Client().CreateReverseSwap(boltz.CreateReverseSwapRequest{
+		From:           pair.From,
+		To:             pair.To,
+		PreimageHash:   preimageHash,
+		ClaimPublicKey: claimKey.PubKey().SerializeCompressed(),
+		InvoiceAmount:  invoiceAmount,
+	})
+	require.NoError(t, err)
+
+	reverseSwap := database.ReverseSwap{
+		Id:                 response.Id,
+		Pair:               pair,
+		State:              boltzrpc.SwapState_PENDING,
+		Status:             boltz.SwapCreated,
+		PrivateKey:         claimKey,
+		SwapTree:           response.SwapTree.Deserialize(),
+		Preimage:           preimage,
+		PaymentHash:        preimageHash,
+		Invoice:            response.Invoice,
+		ClaimAddress:       newAddress(t),
+		OnchainAmount:      response.OnchainAmount,
+		TimeoutBlockHeight: response.TimeoutBlockHeight,
+		CreatedAt:          time.Now(),
+	}
+	reverseSwap.RefundPubKey, err = btcec.ParsePubKey(response.RefundPublicKey)
+	require.NoError(t, err)
+	require.NoError(t, reverseSwap.InitTree())
+	for _, option := range options {
+		option(&reverseSwap)
+	}
+	require.NoError(t, test.database.CreateReverseSwap(reverseSwap))
+	return &reverseSwap
+}
+
+func TestPollPendingStatuses(t *testing.T) {
+	test := newTestNursery(t, Config{}, nil)
+	reverseSwap := test.createReverseSwap(t, reverseSwapAmount)
+	require.NoError(t, test.RegisterReverseSwap(*reverseSwap))
+	require.Contains(t, test.boltzWs.SwapIds(), reverseSwap.Id)
+
+	// updates which happen while the websocket is not connected are missed
+	test.server.DisconnectWebsockets()
+	requireEventually(t, func() bool {
+		return !test.boltzWs.Connected()
+	})
+	lockupId, err := test.server.Lockup(reverseSwap.Id)
+	require.NoError(t, err)
+
+	test.requestStatusPoll()
+	requireEventually(t, func() bool {
+		polled, err := test.database.QueryReverseSwap(reverseSwap.Id)
+		require.NoError(t, err)
+		return polled.LockupTransactionId == lockupId
+	})
+}
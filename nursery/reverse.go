@@ -1,6 +1,7 @@
 package nursery
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
@@ -43,6 +44,11 @@ func (nursery *Nursery) PayReverseSwap(reverseSwap *database.ReverseSwap) error
 	go func() {
 		payment, err := nursery.lightning.PayInvoice(reverseSwap.Invoice, feeLimit, 30, reverseSwap.ChanIds)
 		if err != nil {
+			// the invoice of a reverse swap is a hold invoice, so the payment might still be in flight
+			if status, statusErr := nursery.getReverseSwapPaymentStatus(reverseSwap); statusErr == nil && status.State == lightning.PaymentPending {
+				logger.Warnf("Payment of Reverse Swap %s is still pending: %v", reverseSwap.Id, err)
+				return
+			}
 			if dbErr := nursery.database.UpdateReverseSwapState(reverseSwap, boltzrpc.SwapState_ERROR, err.Error()); dbErr != nil {
 				logger.Error("Could not update Reverse Swap state: " + dbErr.Error())
 			}
@@ -55,11 +61,58 @@ func (nursery *Nursery) PayReverseSwap(reverseSwap *database.ReverseSwap) error
 	return nil
 }
 
-// TODO: fail swap after "transaction.failed" event
+func (nursery *Nursery) getReverseSwapPaymentStatus(reverseSwap *database.ReverseSwap) (*lightning.PaymentStatus, error) {
+	if nursery.lightning == nil {
+		return nil, fmt.Errorf("no lightning node available")
+	}
+	decodedInvoice, err := zpay32.Decode(reverseSwap.Invoice, nursery.network.Btc)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode invoice: %w", err)
+	}
+	return nursery.lightning.PaymentStatus(decodedInvoice.PaymentHash[:])
+}
+
+// failReverseSwap moves a reverse swap which was failed by boltz into a terminal state,
+// taking the status of our lightning payment into account
+func (nursery *Nursery) failReverseSwap(reverseSwap *database.ReverseSwap, status boltz.SwapUpdateEvent) error {
+	state := boltzrpc.SwapState_SERVER_ERROR
+	message := "boltz failed the swap: " + status.String()
+
+	paymentStatus, err := nursery.getReverseSwapPaymentStatus(reverseSwap)
+	if err != nil {
+		logger.Warnf("Could not get payment status of Reverse Swap %s: %v", reverseSwap.Id, err)
+	} else {
+		switch paymentStatus.State {
+		case lightning.PaymentFailed:
+			// the swap most likely failed because we could not pay the invoice
+			state = boltzrpc.SwapState_ERROR
+			if paymentStatus.FailureReason != "" {
+				message = "could not pay invoice: " + paymentStatus.FailureReason
+			}
+		case lightning.PaymentPending:
+			logger.Infof("Payment of Reverse Swap %s is still pending and will be cancelled by boltz", reverseSwap.Id)
+		case lightning.PaymentSucceeded:
+			logger.Warnf("Payment of Reverse Swap %s succeeded even though the swap failed", reverseSwap.Id)
+			if err := nursery.database.SetReverseSwapRoutingFee(reverseSwap, paymentStatus.FeeMsat); err != nil {
+				return fmt.Errorf("could not set routing fee: %w", err)
+			}
+			message += " after the invoice was paid"
+		}
+	}
+
+	logger.Infof("Reverse Swap %s failed: %s", reverseSwap.Id, message)
+	return nursery.database.UpdateReverseSwapState(reverseSwap, state, message)
+}
+
 func (nursery *Nursery) handleReverseSwapStatus(reverseSwap *database.ReverseSwap, event boltz.SwapStatusResponse) {
 	parsedStatus := boltz.ParseEvent(event.Status)
 
-	if parsedStatus == reverseSwap.Status {
+	// a failed status might have been saved without the swap being moved into a terminal state
+	isStale := reverseSwap.State == boltzrpc.SwapState_PENDING && parsedStatus.IsFailedStatus()
+	// a batched claim might not have been broadcast before shutting down
+	isUnclaimed := reverseSwap.State == boltzrpc.SwapState_PENDING && reverseSwap.ClaimTransactionId == "" &&
+		(parsedStatus == boltz.TransactionConfirmed || parsedStatus == boltz.TransactionMempool && reverseSwap.AcceptZeroConf)
+	if parsedStatus == reverseSwap.Status && !isStale && !isUnclaimed {
 		logger.Info("Status of Reverse Swap " + reverseSwap.Id + " is " + parsedStatus.String() + " already")
 		return
 	}
@@ -88,64 +141,50 @@ func (nursery *Nursery) handleReverseSwapStatus(reverseSwap *database.ReverseSwa
 			break
 		}
 
-		feeSatPerVbyte, err := nursery.getFeeEstimation(reverseSwap.Pair.To)
-
-		if err != nil {
-			handleError("Could not get fee estimation: " + err.Error())
-			return
+		if reverseSwap.Pair.To == boltz.CurrencyRootstock {
+			claimTransactionId, claimFee, err := nursery.claimRootstockReverseSwap(reverseSwap)
+			if err != nil {
+				handleError("Could not claim Reverse Swap: " + err.Error())
+				return
+			}
+			if err := nursery.database.SetReverseSwapClaimTransactionId(reverseSwap, claimTransactionId, claimFee); err != nil {
+				handleError("Could not set claim transaction id in database: " + err.Error())
+				return
+			}
+			break
 		}
 
-		logger.Info(fmt.Sprintf("Using fee of %v sat/vbyte for claim transaction", feeSatPerVbyte))
-
 		lockupTx, err := boltz.NewTxFromHex(reverseSwap.Pair.To, event.Transaction.Hex, reverseSwap.BlindingKey)
 		if err != nil {
 			handleError("Could not decode lockup transaction: " + err.Error())
 			return
 		}
 
-		var blindingKey *btcec.PublicKey
-		if reverseSwap.BlindingKey != nil {
-			blindingKey = reverseSwap.BlindingKey.PubKey()
-		}
-		lockupAddress, err := reverseSwap.SwapTree.Address(nursery.network, blindingKey)
-
-		logger.Info("Derived lockup address: " + lockupAddress)
-
+		output, err := nursery.getReverseSwapClaimOutput(reverseSwap, lockupTx)
 		if err != nil {
-			handleError("Could not derive lockup address: " + err.Error())
+			handleError(err.Error())
 			return
 		}
 
-		lockupVout, lockupValue, err := lockupTx.FindVout(nursery.network, lockupAddress)
-		if err != nil {
-			handleError("Could not find lockup vout of Reverse Swap " + reverseSwap.Id)
-			return
+		if nursery.claimBatchWindow > 0 {
+			nursery.queueReverseSwapClaim(reverseSwap, *output)
+			break
 		}
 
-		if lockupValue < reverseSwap.OnchainAmount {
-			handleError("Boltz locked up less onchain coins than expected. Abandoning Reverse Swap")
+		feeSatPerVbyte, err := nursery.getFeeEstimation(reverseSwap.Pair.To, reverseSwap.FeePolicy)
+
+		if err != nil {
+			handleError("Could not get fee estimation: " + err.Error())
 			return
 		}
 
-		logger.Info("Constructing claim transaction for Reverse Swap " + reverseSwap.Id + " with output: " + lockupTx.Hash() + ":" + strconv.Itoa(int(lockupVout)))
-
-		output := boltz.OutputDetails{
-			SwapId:            reverseSwap.Id,
-			SwapType:          boltz.ReverseSwap,
-			LockupTransaction: lockupTx,
-			Vout:              lockupVout,
-			Address:           reverseSwap.ClaimAddress,
-			PrivateKey:        reverseSwap.PrivateKey,
-			Preimage:          reverseSwap.Preimage,
-			SwapTree:          reverseSwap.SwapTree,
-			Cooperative:       true,
-		}
+		logger.Info(fmt.Sprintf("Using fee of %v sat/vbyte for claim transaction", feeSatPerVbyte))
 
-		claimTransactionId, claimFee, err := nursery.claimReverseSwap(reverseSwap, output, feeSatPerVbyte)
+		claimTransactionId, claimFee, err := nursery.claimReverseSwap(reverseSwap, *output, feeSatPerVbyte)
 		if err != nil {
 			logger.Warnf("Could not construct cooperative claim transaction: %v", err)
 			output.Cooperative = false
-			claimTransactionId, claimFee, err = nursery.claimReverseSwap(reverseSwap, output, feeSatPerVbyte)
+			claimTransactionId, claimFee, err = nursery.claimReverseSwap(reverseSwap, *output, feeSatPerVbyte)
 			if err != nil {
 				handleError("Could not construct claim transaction: " + err.Error())
 				return
@@ -203,7 +242,7 @@ func (nursery *Nursery) handleReverseSwapStatus(reverseSwap *database.ReverseSwa
 		}
 	} else if parsedStatus.IsFailedStatus() {
 		if reverseSwap.State == boltzrpc.SwapState_PENDING {
-			if err := nursery.database.UpdateReverseSwapState(reverseSwap, boltzrpc.SwapState_SERVER_ERROR, ""); err != nil {
+			if err := nursery.failReverseSwap(reverseSwap, parsedStatus); err != nil {
 				handleError("Could not update state of Reverse Swap " + reverseSwap.Id + ": " + err.Error())
 				return
 			}
@@ -213,6 +252,45 @@ func (nursery *Nursery) handleReverseSwapStatus(reverseSwap *database.ReverseSwa
 	nursery.sendReverseSwapUpdate(*reverseSwap)
 }
 
+func (nursery *Nursery) getReverseSwapClaimOutput(reverseSwap *database.ReverseSwap, lockupTx boltz.Transaction) (*boltz.OutputDetails, error) {
+	var blindingKey *btcec.PublicKey
+	if reverseSwap.BlindingKey != nil {
+		blindingKey = reverseSwap.BlindingKey.PubKey()
+	}
+	lockupAddress, err := reverseSwap.SwapTree.Address(nursery.network, blindingKey)
+	if err != nil {
+		return nil, errors.New("Could not derive lockup address: " + err.Error())
+	}
+
+	logger.Info("Derived lockup address: " + lockupAddress)
+
+	lockupVout, lockupValue, err := lockupTx.FindVout(nursery.network, lockupAddress)
+	if err != nil {
+		return nil, errors.New("Could not find lockup vout of Reverse Swap " + reverseSwap.Id)
+	}
+
+	if lockupValue < reverseSwap.OnchainAmount {
+		return nil, errors.New("Boltz locked up less onchain coins than expected. Abandoning Reverse Swap")
+	}
+
+	logger.Info("Constructing claim transaction for Reverse Swap " + reverseSwap.Id + " with output: " + lockupTx.Hash() + ":" + strconv.Itoa(int(lockupVout)))
+
+	return &boltz.OutputDetails{
+		SwapId:            reverseSwap.Id,
+		SwapType:          boltz.ReverseSwap,
+		LockupTransaction: lockupTx,
+		Vout:              lockupVout,
+		Address:           reverseSwap.ClaimAddress,
+		PrivateKey:        reverseSwap.PrivateKey,
+		Preimage:          reverseSwap.Preimage,
+		SwapTree:          reverseSwap.SwapTree,
+		Cooperative:       true,
+		MaxFee:            reverseSwap.FeePolicy.MaxFeeFor(reverseSwap.OnchainAmount),
+		MinAmount:         reverseSwap.TargetAmount,
+		Value:             lockupValue,
+	}, nil
+}
+
 func (nursery *Nursery) claimReverseSwap(reverseSwap *database.ReverseSwap, output boltz.OutputDetails, feeSatPerVbyte float64) (string, uint64, error) {
 	return nursery.createTransaction(reverseSwap.Pair.To, []boltz.OutputDetails{output}, feeSatPerVbyte)
 }
@@ -0,0 +1,85 @@
+package nursery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/BoltzExchange/boltz-client/lightning"
+	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/stretchr/testify/require"
+)
+
+const reverseSwapAmount = 100000
+
+func TestRecoverFailedReverseSwap(t *testing.T) {
+	test := newTestNursery(t, Config{}, nil)
+	test.lightning = &testLightning{paymentStatus: &lightning.PaymentStatus{
+		State:         lightning.PaymentFailed,
+		FailureReason: "no route",
+	}}
+
+	// the failed status was saved, but the swap was not moved into a terminal state before shutting down
+	reverseSwap := test.createReverseSwap(t, reverseSwapAmount)
+	require.NoError(t, test.database.UpdateReverseSwapStatus(reverseSwap, boltz.TransactionFailed))
+
+	updates, stop := test.GlobalSwapUpdates()
+	defer stop()
+
+	recovered := make(chan error, 1)
+	go func() {
+		recovered <- test.recoverPending()
+	}()
+
+	select {
+	case update := <-updates:
+		require.NotNil(t, update.ReverseSwap)
+		require.Equal(t, reverseSwap.Id, update.ReverseSwap.Id)
+		require.Equal(t, boltzrpc.SwapState_ERROR, update.ReverseSwap.State)
+		require.Equal(t, "could not pay invoice: no route", update.ReverseSwap.Error)
+		require.True(t, update.IsFinal)
+	case <-time.After(10 * time.Second):
+		require.Fail(t, "no update for failed reverse swap")
+	}
+	require.NoError(t, <-recovered)
+
+	failed, err := test.database.QueryReverseSwap(reverseSwap.Id)
+	require.NoError(t, err)
+	require.Equal(t, boltzrpc.SwapState_ERROR, failed.State)
+}
+
+func TestClaimAboveMaxFee(t *testing.T) {
+	test := newTestNursery(t, Config{}, nil)
+	maxFee := uint64(1)
+	ids, _ := test.lockupReverseSwaps(t, func(reverseSwap *database.ReverseSwap) {
+		reverseSwap.FeePolicy = &onchain.FeePolicy{MaxFee: &maxFee}
+	})
+
+	failed := test.requireReverseSwapState(t, ids[0], boltzrpc.SwapState_ERROR)
+	require.Contains(t, failed.Error, onchain.ErrFeeTooHigh.Error())
+	require.Zero(t, test.btc.broadcastCount())
+
+	// boltz must not have been asked to cosign, since that reveals the preimage
+	status, err := test.server.Status(ids[0])
+	require.NoError(t, err)
+	require.Equal(t, boltz.TransactionConfirmed.String(), status)
+}
+
+func TestClaimBelowTargetAmount(t *testing.T) {
+	test := newTestNursery(t, Config{}, nil)
+	ids, _ := test.lockupReverseSwaps(t, func(reverseSwap *database.ReverseSwap) {
+		// no fee can be paid from the lockup anymore
+		reverseSwap.TargetAmount = reverseSwap.OnchainAmount
+	})
+
+	failed := test.requireReverseSwapState(t, ids[0], boltzrpc.SwapState_ERROR)
+	require.Contains(t, failed.Error, ErrBelowTargetAmount.Error())
+	require.Zero(t, test.btc.broadcastCount())
+
+	// boltz must not have been asked to cosign, since that reveals the preimage
+	status, err := test.server.Status(ids[0])
+	require.NoError(t, err)
+	require.Equal(t, boltz.TransactionConfirmed.String(), status)
+}
@@ -0,0 +1,150 @@
+package nursery
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/BoltzExchange/boltz-client/logger"
+	"github.com/BoltzExchange/boltz-client/rootstock"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// lockups are searched for in this many blocks before the timeout of a swap, which is well beyond the timeouts boltz uses
+const rootstockLockupLookback = 20000
+
+// RootstockWallet returns the wallet which is used for swaps of RBTC
+func (nursery *Nursery) RootstockWallet() (*rootstock.Wallet, error) {
+	wallet, err := nursery.onchain.GetAnyWallet(boltz.CurrencyRootstock, false)
+	if err != nil {
+		return nil, err
+	}
+	rootstockWallet, ok := wallet.(*rootstock.Wallet)
+	if !ok {
+		return nil, errors.New("no rootstock wallet")
+	}
+	return rootstockWallet, nil
+}
+
+func (nursery *Nursery) findRootstockLockup(preimageHash []byte, timeoutBlockHeight uint32) (*rootstock.Wallet, *rootstock.Lockup, error) {
+	wallet, err := nursery.RootstockWallet()
+	if err != nil {
+		return nil, nil, err
+	}
+	var fromBlock uint32
+	if timeoutBlockHeight > rootstockLockupLookback {
+		fromBlock = timeoutBlockHeight - rootstockLockupLookback
+	}
+	lockup, err := wallet.EtherSwap.FindLockup(preimageHash, fromBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+	if lockup.Timelock != timeoutBlockHeight {
+		return nil, nil, fmt.Errorf("lockup has timelock %d instead of %d", lockup.Timelock, timeoutBlockHeight)
+	}
+	return wallet, lockup, nil
+}
+
+func (nursery *Nursery) swapPreimageHash(swap *database.Swap) ([]byte, error) {
+	if swap.Preimage != nil {
+		hash := sha256.Sum256(swap.Preimage)
+		return hash[:], nil
+	}
+	invoice, err := zpay32.Decode(swap.Invoice, nursery.network.Btc)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode invoice: %w", err)
+	}
+	return invoice.PaymentHash[:], nil
+}
+
+// setRootstockSwapLockup looks for the lockup of a submarine swap in the EtherSwap contract
+func (nursery *Nursery) setRootstockSwapLockup(swap *database.Swap) error {
+	preimageHash, err := nursery.swapPreimageHash(swap)
+	if err != nil {
+		return err
+	}
+	wallet, lockup, err := nursery.findRootstockLockup(preimageHash, swap.TimoutBlockHeight)
+	if err != nil {
+		return err
+	}
+	if lockup.RefundAddress != wallet.Address() {
+		logger.Warnf("Swap %s was locked by %s and can not be refunded by our wallet", swap.Id, lockup.RefundAddress)
+	}
+
+	logger.Infof("Found lockup of Swap %s of %d satoshis in transaction %s", swap.Id, lockup.Satoshis(), lockup.TransactionId)
+
+	if err := nursery.database.SetSwapLockupTransactionId(swap, lockup.TransactionId); err != nil {
+		return fmt.Errorf("could not set lockup transaction in database: %w", err)
+	}
+	if err := nursery.database.SetSwapExpectedAmount(swap, lockup.Satoshis()); err != nil {
+		return fmt.Errorf("could not set expected amount in database: %w", err)
+	}
+	return nil
+}
+
+func (nursery *Nursery) refundRootstockSwap(swap *database.Swap) (string, uint64, error) {
+	preimageHash, err := nursery.swapPreimageHash(swap)
+	if err != nil {
+		return "", 0, err
+	}
+	wallet, lockup, err := nursery.findRootstockLockup(preimageHash, swap.TimoutBlockHeight)
+	if err != nil {
+		return "", 0, fmt.Errorf("could not find lockup: %w", err)
+	}
+	return wallet.Refund(lockup)
+}
+
+// refundRootstockSwaps refunds the lockups of submarine swaps from the EtherSwap contract, one transaction per swap
+func (nursery *Nursery) refundRootstockSwaps(swaps []database.Swap) error {
+	blockHeight, err := nursery.onchain.GetBlockHeight(boltz.CurrencyRootstock)
+	if err != nil {
+		return fmt.Errorf("could not get block height: %w", err)
+	}
+
+	var refundErr error
+	for _, swap := range swaps {
+		// there are no cooperative refunds for EVM swaps
+		if swap.TimoutBlockHeight > blockHeight {
+			logger.Infof("Swap %s will be refunded at block %d", swap.Id, swap.TimoutBlockHeight)
+			continue
+		}
+
+		refundTransactionId, refundFee, err := nursery.refundRootstockSwap(&swap)
+		if err != nil {
+			refundErr = fmt.Errorf("could not refund Swap %s: %w", swap.Id, err)
+			logger.Error(refundErr.Error())
+			continue
+		}
+
+		if err := nursery.database.SetSwapRefundTransactionId(&swap, refundTransactionId, refundFee, false); err != nil {
+			logger.Error("Could not set refund transaction id in database: " + err.Error())
+			continue
+		}
+
+		nursery.sendSwapUpdate(swap)
+
+		logger.Infof("Refunded Swap %s with refund transaction %s", swap.Id, refundTransactionId)
+	}
+	return refundErr
+}
+
+// claimRootstockReverseSwap claims the lockup of a reverse swap from the EtherSwap contract
+func (nursery *Nursery) claimRootstockReverseSwap(reverseSwap *database.ReverseSwap) (string, uint64, error) {
+	preimageHash := sha256.Sum256(reverseSwap.Preimage)
+	wallet, lockup, err := nursery.findRootstockLockup(preimageHash[:], reverseSwap.TimeoutBlockHeight)
+	if err != nil {
+		return "", 0, fmt.Errorf("could not find lockup: %w", err)
+	}
+	if lockup.ClaimAddress != wallet.Address() {
+		return "", 0, fmt.Errorf("lockup can only be claimed by %s", lockup.ClaimAddress)
+	}
+	if lockup.Satoshis() < reverseSwap.OnchainAmount {
+		return "", 0, errors.New("Boltz locked up less onchain coins than expected. Abandoning Reverse Swap")
+	}
+
+	logger.Infof("Claiming lockup of Reverse Swap %s in transaction %s", reverseSwap.Id, lockup.TransactionId)
+
+	return wallet.Claim(lockup, reverseSwap.Preimage)
+}
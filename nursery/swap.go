@@ -11,6 +11,8 @@ import (
 	"github.com/BoltzExchange/boltz-client/boltzrpc"
 	"github.com/BoltzExchange/boltz-client/database"
 	"github.com/BoltzExchange/boltz-client/logger"
+	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/BoltzExchange/boltz-client/rootstock"
 	"github.com/BoltzExchange/boltz-client/utils"
 	"github.com/lightningnetwork/lnd/zpay32"
 )
@@ -27,16 +29,16 @@ func (nursery *Nursery) sendSwapUpdate(swap database.Swap) {
 	})
 }
 
-// TODO: abstract interactions with chain (querying and broadcasting transactions) into interface to be able to switch between Boltz API and bitcoin core
-
 func (nursery *Nursery) startBlockListener(currency boltz.Currency) {
 	blockNotifier := nursery.registerBlockListener(currency)
 
 	go func() {
 		for newBlock := range blockNotifier {
-			if nursery.stopped {
+			if nursery.stopped.Load() {
 				return
 			}
+			nursery.recordBlock(currency, newBlock)
+
 			swapsToRefund, err := nursery.database.QueryRefundableSwaps(newBlock.Height, currency)
 			if err != nil {
 				logger.Error("Could not query refundable Swaps: " + err.Error())
@@ -50,15 +52,36 @@ func (nursery *Nursery) startBlockListener(currency boltz.Currency) {
 					logger.Error("Could not refund Swaps: " + err.Error())
 				}
 			}
+
+			chainSwapsToRefund, err := nursery.database.QueryRefundableChainSwaps(newBlock.Height, currency)
+			if err != nil {
+				logger.Error("Could not query refundable Chain Swaps: " + err.Error())
+				continue
+			}
+
+			if len(chainSwapsToRefund) > 0 {
+				logger.Info("Found " + strconv.Itoa(len(chainSwapsToRefund)) + " Chain Swaps to refund at height " + strconv.FormatUint(uint64(newBlock.Height), 10))
+
+				if err := nursery.RefundChainSwaps(chainSwapsToRefund, false); err != nil {
+					logger.Error("Could not refund Chain Swaps: " + err.Error())
+				}
+			}
+
+			nursery.checkPendingTransactions(currency, newBlock.Height)
+			nursery.checkTimeouts(currency, newBlock.Height)
 		}
 	}()
 }
 
 func (nursery *Nursery) RefundSwaps(swapsToRefund []database.Swap, cooperative bool) error {
 	currency := swapsToRefund[0].Pair.From
+	if currency == boltz.CurrencyRootstock {
+		return nursery.refundRootstockSwaps(swapsToRefund)
+	}
 
 	var refundedSwaps []database.Swap
 	var refundOutputs []boltz.OutputDetails
+	var feePolicies []*onchain.FeePolicy
 
 	for _, swapToRefund := range swapsToRefund {
 		refundOutput, err := nursery.getRefundOutput(&swapToRefund)
@@ -66,9 +89,10 @@ func (nursery *Nursery) RefundSwaps(swapsToRefund []database.Swap, cooperative b
 			return fmt.Errorf("Could not get refund output of swap %s: %v", swapToRefund.Id, err)
 		}
 
-		refundOutput.Cooperative = cooperative
+		refundOutput.Cooperative = refundOutput.Cooperative && cooperative
 		refundedSwaps = append(refundedSwaps, swapToRefund)
 		refundOutputs = append(refundOutputs, *refundOutput)
+		feePolicies = append(feePolicies, swapToRefund.FeePolicy)
 	}
 
 	if len(refundOutputs) == 0 {
@@ -76,7 +100,7 @@ func (nursery *Nursery) RefundSwaps(swapsToRefund []database.Swap, cooperative b
 		return nil
 	}
 
-	feeSatPerVbyte, err := nursery.getFeeEstimation(currency)
+	feeSatPerVbyte, err := nursery.getFeeEstimation(currency, feePolicies...)
 	if err != nil {
 		return errors.New("Could not get fee estimation: " + err.Error())
 	}
@@ -84,6 +108,17 @@ func (nursery *Nursery) RefundSwaps(swapsToRefund []database.Swap, cooperative b
 	logger.Info(fmt.Sprintf("Using fee of %v sat/vbyte for refund transaction", feeSatPerVbyte))
 
 	refundTransactionId, totalRefundFee, err := nursery.createTransaction(currency, refundOutputs, feeSatPerVbyte)
+	var cooperativeErr *boltz.CooperativeError
+	if errors.As(err, &cooperativeErr) {
+		refundedSwaps, refundOutputs, err = nursery.handleCooperativeRefundError(currency, refundedSwaps, refundOutputs, cooperativeErr)
+		if err != nil {
+			return err
+		}
+		if len(refundOutputs) == 0 {
+			return cooperativeErr
+		}
+		refundTransactionId, totalRefundFee, err = nursery.createTransaction(currency, refundOutputs, feeSatPerVbyte)
+	}
 	if err != nil {
 		return err
 	}
@@ -97,7 +132,7 @@ func (nursery *Nursery) RefundSwaps(swapsToRefund []database.Swap, cooperative b
 		if i == int(count)-1 {
 			refundFee += totalRefundFee % count
 		}
-		err = nursery.database.SetSwapRefundTransactionId(&refundedSwap, refundTransactionId, refundFee)
+		err = nursery.database.SetSwapRefundTransactionId(&refundedSwap, refundTransactionId, refundFee, refundOutputs[i].Cooperative)
 
 		if err != nil {
 			logger.Error("Could not set refund transaction id in database: " + err.Error())
@@ -111,6 +146,44 @@ func (nursery *Nursery) RefundSwaps(swapsToRefund []database.Swap, cooperative b
 	return nil
 }
 
+// handleCooperativeRefundError remembers the cooperative failures of the affected swaps and switches their outputs to the
+// script path. Outputs whose timeout has not been reached yet can not be refunded via the script path and are removed from
+// the batch; they will be refunded by the block listener once they time out.
+func (nursery *Nursery) handleCooperativeRefundError(
+	currency boltz.Currency,
+	swaps []database.Swap,
+	outputs []boltz.OutputDetails,
+	cooperativeErr *boltz.CooperativeError,
+) ([]database.Swap, []boltz.OutputDetails, error) {
+	blockHeight, err := nursery.onchain.GetBlockHeight(currency)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get block height: %w", err)
+	}
+
+	var remainingSwaps []database.Swap
+	var remainingOutputs []boltz.OutputDetails
+	for i, swap := range swaps {
+		output := outputs[i]
+		if swapErr, failed := cooperativeErr.Errors[swap.Id]; failed {
+			logger.Warnf("Could not refund Swap %s cooperatively: %s", swap.Id, swapErr)
+
+			if err := nursery.database.SetSwapCooperativeError(&swap, swapErr.Error()); err != nil {
+				logger.Error("Could not set cooperative error in database: " + err.Error())
+			}
+
+			if swap.TimoutBlockHeight > blockHeight {
+				logger.Infof("Swap %s will be refunded via the script path at block %d", swap.Id, swap.TimoutBlockHeight)
+				continue
+			}
+			output.Cooperative = false
+		}
+		remainingSwaps = append(remainingSwaps, swap)
+		remainingOutputs = append(remainingOutputs, output)
+	}
+
+	return remainingSwaps, remainingOutputs, nil
+}
+
 func (nursery *Nursery) getRefundOutput(swap *database.Swap) (*boltz.OutputDetails, error) {
 	lockupTransaction, err := nursery.onchain.GetTransaction(swap.Pair.From, swap.LockupTransactionId, swap.BlindingKey)
 	if err != nil {
@@ -136,11 +209,15 @@ func (nursery *Nursery) getRefundOutput(swap *database.Swap) (*boltz.OutputDetai
 				return nil, fmt.Errorf(message, swap.Id)
 			}
 		}
-		swap.RefundAddress, err = wallet.NewAddress()
+		refundAddress, err := wallet.NewAddress()
 		if err != nil {
 			message := "Swap %s can not be refunded because it has no refund address and the associated wallet failed to generate address: %v"
 			return nil, fmt.Errorf(message, swap.Id, err)
 		}
+		// remember the address so replacements of the refund transaction pay to it as well
+		if err := nursery.database.SetSwapRefundRefundAddress(swap, refundAddress); err != nil {
+			return nil, fmt.Errorf("could not set refund address of Swap %s: %w", swap.Id, err)
+		}
 	}
 
 	return &boltz.OutputDetails{
@@ -153,8 +230,9 @@ func (nursery *Nursery) getRefundOutput(swap *database.Swap) (*boltz.OutputDetai
 		Preimage:           []byte{},
 		TimeoutBlockHeight: swap.TimoutBlockHeight,
 		SwapTree:           swap.SwapTree,
-		// TODO: remember if cooperative fails and set this to false
-		Cooperative: true,
+		// boltz already refused to cooperate on this swap, so only the timeout leaf is left
+		Cooperative: swap.CooperativeError == "",
+		MaxFee:      swap.FeePolicy.MaxFeeFor(swap.ExpectedAmount),
 	}, nil
 }
 
@@ -217,7 +295,12 @@ func (nursery *Nursery) handleSwapStatus(swap *database.Swap, status boltz.SwapS
 		nursery.sendSwapUpdate(*swap)
 	}
 
-	if parsedStatus != boltz.InvoiceSet && swap.LockupTransactionId == "" {
+	if parsedStatus != boltz.InvoiceSet && swap.LockupTransactionId == "" && swap.Pair.From == boltz.CurrencyRootstock {
+		if err := nursery.setRootstockSwapLockup(swap); err != nil && !errors.Is(err, rootstock.ErrLockupNotFound) {
+			handleError("Could not find lockup of Swap " + swap.Id + ": " + err.Error())
+			return
+		}
+	} else if parsedStatus != boltz.InvoiceSet && swap.LockupTransactionId == "" {
 		swapTransactionResponse, err := nursery.boltz.GetSwapTransaction(swap.Id)
 		if err != nil {
 			var boltzErr boltz.Error
@@ -295,11 +378,14 @@ func (nursery *Nursery) handleSwapStatus(swap *database.Swap, status boltz.SwapS
 			return
 		}
 
+		expiry := nursery.invoiceExpiry(swap.Pair.From, swap.TimoutBlockHeight-blockHeight)
+
 		invoice, err := nursery.lightning.CreateInvoice(
 			int64(swapRates.InvoiceAmount),
 			swap.Preimage,
-			boltz.CalculateInvoiceExpiry(swap.TimoutBlockHeight-blockHeight, swap.Pair.From),
+			int64(expiry.Seconds()),
 			utils.GetSwapMemo(string(swap.Pair.From)),
+			swap.ChanIds,
 		)
 
 		if err != nil {
@@ -348,7 +434,8 @@ func (nursery *Nursery) handleSwapStatus(swap *database.Swap, status boltz.SwapS
 
 		logger.Infof("Swap %s succeeded", swap.Id)
 
-		if parsedStatus == boltz.TransactionClaimPending {
+		// boltz claims lockups of EVM swaps on its own
+		if parsedStatus == boltz.TransactionClaimPending && swap.SwapTree != nil {
 			if err := nursery.cooperativeSwapClaim(swap, status); err != nil {
 				logger.Warnf("Could not claim swap %s cooperatively: %s", swap.Id, err)
 			}
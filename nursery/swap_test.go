@@ -0,0 +1,85 @@
+package nursery
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/stretchr/testify/require"
+)
+
+const swapAmount = 100000
+
+func TestRefundSwaps(t *testing.T) {
+	t.Run("Cooperative", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		swap := test.createLockedSwap(t, swapAmount)
+		require.NoError(t, test.server.FailLockup(swap.Id))
+
+		require.NoError(t, test.RefundSwaps([]database.Swap{*swap}, true))
+
+		refunded, err := test.database.QuerySwap(swap.Id)
+		require.NoError(t, err)
+		require.Equal(t, boltzrpc.SwapState_REFUNDED, refunded.State)
+		require.Empty(t, refunded.CooperativeError)
+		require.True(t, *refunded.RefundCooperative)
+		require.True(t, isKeyPath(test.btc.lastBroadcast(t).TxIn[0]))
+	})
+
+	t.Run("Refused", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		test.server.RefuseCosigning(true)
+		timedOut := test.createLockedSwap(t, swapAmount)
+		test.server.SetBlockHeight(boltz.CurrencyBtc, startHeight+10)
+		pending := test.createLockedSwap(t, swapAmount)
+		test.btc.setHeight(timedOut.TimoutBlockHeight)
+
+		require.NoError(t, test.RefundSwaps([]database.Swap{*timedOut, *pending}, true))
+
+		// boltz refused to cooperate, so the swap which timed out already is refunded via the script path
+		refunded, err := test.database.QuerySwap(timedOut.Id)
+		require.NoError(t, err)
+		require.Equal(t, boltzrpc.SwapState_REFUNDED, refunded.State)
+		require.NotEmpty(t, refunded.CooperativeError)
+		require.False(t, *refunded.RefundCooperative)
+
+		refund := test.btc.lastBroadcast(t)
+		require.Len(t, refund.TxIn, 1)
+		require.True(t, spends(refund, refunded.LockupTransactionId))
+		require.False(t, isKeyPath(refund.TxIn[0]))
+
+		// the other one has to wait for its timeout
+		notRefunded, err := test.database.QuerySwap(pending.Id)
+		require.NoError(t, err)
+		require.Equal(t, boltzrpc.SwapState_PENDING, notRefunded.State)
+		require.Empty(t, notRefunded.RefundTransactionId)
+		require.NotEmpty(t, notRefunded.CooperativeError)
+
+		// and is not tried cooperatively again
+		output, err := test.getRefundOutput(notRefunded)
+		require.NoError(t, err)
+		require.False(t, output.Cooperative)
+	})
+
+	t.Run("RefusedBeforeTimeout", func(t *testing.T) {
+		test := newTestNursery(t, Config{}, nil)
+		test.server.RefuseCosigning(true)
+		swap := test.createLockedSwap(t, swapAmount)
+
+		err := test.RefundSwaps([]database.Swap{*swap}, true)
+		var cooperativeErr *boltz.CooperativeError
+		require.ErrorAs(t, err, &cooperativeErr)
+		require.Contains(t, cooperativeErr.Errors, swap.Id)
+		require.Zero(t, test.btc.broadcastCount())
+
+		// the block listener refunds via the script path once the timeout is reached
+		test.btc.mine(swap.TimoutBlockHeight)
+		requireEventually(t, func() bool {
+			refunded, err := test.database.QuerySwap(swap.Id)
+			require.NoError(t, err)
+			return refunded.State == boltzrpc.SwapState_REFUNDED
+		})
+		require.False(t, isKeyPath(test.btc.lastBroadcast(t).TxIn[0]))
+	})
+}
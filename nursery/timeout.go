@@ -0,0 +1,293 @@
+package nursery
+
+import (
+	"slices"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/logger"
+	"github.com/BoltzExchange/boltz-client/onchain"
+)
+
+// amount of recent blocks used to estimate the block time of a chain
+const maxBlockSamples = 12
+
+type blockSample struct {
+	height uint32
+	time   time.Time
+}
+
+// TimeoutWarning is sent along with a SwapUpdate when a pending swap gets close to its timeout block height
+type TimeoutWarning struct {
+	BlocksLeft uint32
+	Eta        time.Time
+	Message    string
+}
+
+func (nursery *Nursery) recordBlock(currency boltz.Currency, block *onchain.BlockEpoch) {
+	nursery.blockSamplesLock.Lock()
+	defer nursery.blockSamplesLock.Unlock()
+
+	sample := blockSample{height: block.Height, time: block.Timestamp}
+	if sample.time.IsZero() {
+		// not every block listener knows the header time, the arrival of the block is a good enough approximation
+		sample.time = time.Now()
+	}
+
+	samples := nursery.blockSamples[currency]
+	// drop blocks which got reorged out or are sent twice
+	samples = slices.DeleteFunc(samples, func(existing blockSample) bool {
+		return existing.height >= sample.height
+	})
+	samples = append(samples, sample)
+	if len(samples) > maxBlockSamples {
+		samples = samples[len(samples)-maxBlockSamples:]
+	}
+	nursery.blockSamples[currency] = samples
+}
+
+// BlockTime returns the average time between the recently seen blocks of a chain.
+// The expected block time of the chain is used until enough blocks have been seen.
+func (nursery *Nursery) BlockTime(currency boltz.Currency) time.Duration {
+	nursery.blockSamplesLock.Lock()
+	defer nursery.blockSamplesLock.Unlock()
+
+	fallback := time.Duration(boltz.GetBlockTime(currency) * float64(time.Minute))
+
+	samples := nursery.blockSamples[currency]
+	if len(samples) < 2 {
+		return fallback
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	elapsed := last.time.Sub(first.time)
+	if elapsed <= 0 {
+		return fallback
+	}
+	return elapsed / time.Duration(last.height-first.height)
+}
+
+// BlocksToDuration estimates how long it takes to mine the given amount of blocks
+func (nursery *Nursery) BlocksToDuration(currency boltz.Currency, blocks uint32) time.Duration {
+	return time.Duration(blocks) * nursery.BlockTime(currency)
+}
+
+// invoiceExpiry returns how long the invoice of a swap which times out in the given amount of blocks should be valid.
+// A burst of fast blocks must not shorten it below what the nominal block time allows.
+func (nursery *Nursery) invoiceExpiry(currency boltz.Currency, blocksLeft uint32) time.Duration {
+	nominal := time.Duration(boltz.CalculateInvoiceExpiry(blocksLeft, currency)) * time.Second
+	// add one block to make sure that the invoice expiry is long enough
+	return max(nominal, nursery.BlocksToDuration(currency, blocksLeft+1))
+}
+
+// cachedBlockHeight returns the height of the last block the listener of the currency saw or 0 if it did not see one yet
+func (nursery *Nursery) cachedBlockHeight(currency boltz.Currency) uint32 {
+	nursery.blockSamplesLock.Lock()
+	defer nursery.blockSamplesLock.Unlock()
+
+	samples := nursery.blockSamples[currency]
+	if len(samples) > 0 {
+		return samples[len(samples)-1].height
+	}
+	return 0
+}
+
+func (nursery *Nursery) getBlockHeight(currency boltz.Currency) (uint32, error) {
+	if height := nursery.cachedBlockHeight(currency); height != 0 {
+		return height, nil
+	}
+	return nursery.onchain.GetBlockHeight(currency)
+}
+
+// TimeoutEta estimates when the timeout block height will be reached
+func (nursery *Nursery) TimeoutEta(currency boltz.Currency, timeoutBlockHeight uint32) (time.Time, error) {
+	blockHeight, err := nursery.getBlockHeight(currency)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return nursery.timeoutEta(currency, blockHeight, timeoutBlockHeight), nil
+}
+
+func (nursery *Nursery) timeoutEta(currency boltz.Currency, blockHeight uint32, timeoutBlockHeight uint32) time.Time {
+	if timeoutBlockHeight <= blockHeight {
+		return time.Now()
+	}
+	return time.Now().Add(nursery.BlocksToDuration(currency, timeoutBlockHeight-blockHeight))
+}
+
+// timeoutThreshold returns the lowest configured threshold which has been reached by the given amount of blocks left
+func (nursery *Nursery) timeoutThreshold(blocksLeft uint32) (uint32, bool) {
+	var threshold uint32
+	reached := false
+	for _, warning := range nursery.timeoutWarnings {
+		if blocksLeft <= warning && (!reached || warning < threshold) {
+			threshold = warning
+			reached = true
+		}
+	}
+	return threshold, reached
+}
+
+// checkTimeout returns a warning if the swap crossed a threshold it was not warned about yet.
+// isSettled is only called once a threshold is reached, since it might have to query the chain.
+func (nursery *Nursery) checkTimeout(
+	id string,
+	currency boltz.Currency,
+	blockHeight uint32,
+	timeoutBlockHeight uint32,
+	isSettled func() bool,
+) *TimeoutWarning {
+	if timeoutBlockHeight <= blockHeight {
+		// refunds of expired swaps are handled by the block listener already
+		return nil
+	}
+	blocksLeft := timeoutBlockHeight - blockHeight
+	threshold, reached := nursery.timeoutThreshold(blocksLeft)
+	if !reached || isSettled() {
+		return nil
+	}
+
+	nursery.warnedTimeoutsLock.Lock()
+	defer nursery.warnedTimeoutsLock.Unlock()
+	if warned, ok := nursery.warnedTimeouts[id]; ok && warned <= threshold {
+		return nil
+	}
+	nursery.warnedTimeouts[id] = threshold
+
+	return &TimeoutWarning{
+		BlocksLeft: blocksLeft,
+		Eta:        nursery.timeoutEta(currency, blockHeight, timeoutBlockHeight),
+	}
+}
+
+func (nursery *Nursery) isConfirmed(currency boltz.Currency, transactionId string) bool {
+	if transactionId == "" {
+		return false
+	}
+	confirmed, err := nursery.onchain.IsTransactionConfirmed(currency, transactionId)
+	if err != nil {
+		logger.Warnf("Could not check confirmation status of transaction %s: %v", transactionId, err)
+		return false
+	}
+	return confirmed
+}
+
+func logTimeoutWarning(id string, warning *TimeoutWarning) {
+	logger.Warnf(
+		"Swap %s times out in %d blocks (around %s): %s",
+		id, warning.BlocksLeft, warning.Eta.Format(time.RFC3339), warning.Message,
+	)
+}
+
+// checkTimeouts warns about pending swaps of the currency which are getting close to their timeout without having settled
+func (nursery *Nursery) checkTimeouts(currency boltz.Currency, blockHeight uint32) {
+	if len(nursery.timeoutWarnings) == 0 {
+		return
+	}
+
+	swaps, err := nursery.database.QueryPendingSwaps()
+	if err != nil {
+		logger.Error("Could not query pending Swaps: " + err.Error())
+		return
+	}
+	reverseSwaps, err := nursery.database.QueryPendingReverseSwaps()
+	if err != nil {
+		logger.Error("Could not query pending Reverse Swaps: " + err.Error())
+		return
+	}
+	chainSwaps, err := nursery.database.QueryPendingChainSwaps()
+	if err != nil {
+		logger.Error("Could not query pending Chain Swaps: " + err.Error())
+		return
+	}
+
+	// swaps can leave the pending state without a final update, like when they are abandoned
+	pending := make(map[string]bool)
+	for _, swap := range swaps {
+		pending[swap.Id] = true
+	}
+	for _, reverseSwap := range reverseSwaps {
+		pending[reverseSwap.Id] = true
+	}
+	for _, chainSwap := range chainSwaps {
+		pending[chainSwap.Id] = true
+	}
+	nursery.pruneWarnedTimeouts(pending)
+
+	for i := range swaps {
+		// the update is sent asynchronously, so it needs a pointer which is not reused by the next iteration
+		swap := &swaps[i]
+		if swap.Pair.From != currency || swap.State != boltzrpc.SwapState_PENDING {
+			continue
+		}
+		warning := nursery.checkTimeout(swap.Id, currency, blockHeight, swap.TimoutBlockHeight, func() bool {
+			return nursery.isConfirmed(currency, swap.LockupTransactionId)
+		})
+		if warning == nil {
+			continue
+		}
+		if swap.LockupTransactionId == "" {
+			warning.Message = "lockup transaction was not found yet"
+		} else {
+			warning.Message = "lockup transaction is not confirmed yet"
+		}
+		logTimeoutWarning(swap.Id, warning)
+		nursery.sendUpdate(swap.Id, SwapUpdate{Swap: swap, TimeoutWarning: warning})
+	}
+
+	for i := range reverseSwaps {
+		reverseSwap := &reverseSwaps[i]
+		if reverseSwap.Pair.To != currency || reverseSwap.Status.IsFailedStatus() {
+			continue
+		}
+		warning := nursery.checkTimeout(reverseSwap.Id, currency, blockHeight, reverseSwap.TimeoutBlockHeight, func() bool {
+			return nursery.isConfirmed(currency, reverseSwap.ClaimTransactionId)
+		})
+		if warning == nil {
+			continue
+		}
+		if reverseSwap.ClaimTransactionId == "" {
+			warning.Message = "lockup transaction was not claimed yet"
+		} else {
+			warning.Message = "claim transaction is not confirmed yet"
+		}
+		logTimeoutWarning(reverseSwap.Id, warning)
+		nursery.sendUpdate(reverseSwap.Id, SwapUpdate{ReverseSwap: reverseSwap, TimeoutWarning: warning})
+	}
+
+	for i := range chainSwaps {
+		chainSwap := &chainSwaps[i]
+		// the lockup of boltz has to be claimed before it can be refunded
+		data := chainSwap.ToData
+		if data == nil || data.Currency != currency {
+			continue
+		}
+		warning := nursery.checkTimeout(chainSwap.Id, currency, blockHeight, data.TimeoutBlockHeight, func() bool {
+			return nursery.isConfirmed(currency, data.TransactionId)
+		})
+		if warning == nil {
+			continue
+		}
+		if data.LockupTransactionId == "" {
+			warning.Message = "lockup transaction of boltz was not found yet"
+		} else if data.TransactionId == "" {
+			warning.Message = "lockup transaction was not claimed yet"
+		} else {
+			warning.Message = "claim transaction is not confirmed yet"
+		}
+		logTimeoutWarning(chainSwap.Id, warning)
+		nursery.sendUpdate(chainSwap.Id, SwapUpdate{ChainSwap: chainSwap, TimeoutWarning: warning})
+	}
+}
+
+// pruneWarnedTimeouts forgets the warnings of swaps which are not pending anymore
+func (nursery *Nursery) pruneWarnedTimeouts(pending map[string]bool) {
+	nursery.warnedTimeoutsLock.Lock()
+	defer nursery.warnedTimeoutsLock.Unlock()
+
+	for id := range nursery.warnedTimeouts {
+		if !pending[id] {
+			delete(nursery.warnedTimeouts, id)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package nursery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/stretchr/testify/require"
+)
+
+func newTimeoutNursery(warnings ...uint32) *Nursery {
+	return &Nursery{
+		blockSamples:    make(map[boltz.Currency][]blockSample),
+		timeoutWarnings: warnings,
+		warnedTimeouts:  make(map[string]uint32),
+	}
+}
+
+func TestBlockTime(t *testing.T) {
+	nursery := newTimeoutNursery()
+
+	require.Equal(t, 10*time.Minute, nursery.BlockTime(boltz.CurrencyBtc))
+	require.Equal(t, time.Minute, nursery.BlockTime(boltz.CurrencyLiquid))
+
+	start := time.Unix(1700000000, 0)
+	for i := uint32(0); i < maxBlockSamples+5; i++ {
+		nursery.recordBlock(boltz.CurrencyBtc, &onchain.BlockEpoch{
+			Height:    100 + i,
+			Timestamp: start.Add(time.Duration(i) * 5 * time.Minute),
+		})
+	}
+	require.Len(t, nursery.blockSamples[boltz.CurrencyBtc], maxBlockSamples)
+	require.Equal(t, 5*time.Minute, nursery.BlockTime(boltz.CurrencyBtc))
+	require.Equal(t, uint32(100+maxBlockSamples+4), nursery.cachedBlockHeight(boltz.CurrencyBtc))
+	// the nursery has no chain backend, which the cached height must not ask
+	require.Zero(t, nursery.cachedBlockHeight(boltz.CurrencyLiquid))
+	require.Equal(t, time.Hour, nursery.BlocksToDuration(boltz.CurrencyBtc, 12))
+
+	// a reorg replaces the blocks at and above its height
+	nursery.recordBlock(boltz.CurrencyBtc, &onchain.BlockEpoch{Height: 110, Timestamp: start.Add(time.Hour)})
+	samples := nursery.blockSamples[boltz.CurrencyBtc]
+	require.Equal(t, uint32(110), samples[len(samples)-1].height)
+
+	height, err := nursery.getBlockHeight(boltz.CurrencyBtc)
+	require.NoError(t, err)
+	require.Equal(t, uint32(110), height)
+}
+
+func TestCheckTimeout(t *testing.T) {
+	settled := func() bool { return false }
+
+	nursery := newTimeoutNursery(36, 6)
+
+	require.Nil(t, nursery.checkTimeout("swap", boltz.CurrencyBtc, 100, 200, settled))
+
+	warning := nursery.checkTimeout("swap", boltz.CurrencyBtc, 170, 200, settled)
+	require.NotNil(t, warning)
+	require.Equal(t, uint32(30), warning.BlocksLeft)
+	require.WithinDuration(t, time.Now().Add(300*time.Minute), warning.Eta, time.Minute)
+
+	// every threshold is only warned about once
+	require.Nil(t, nursery.checkTimeout("swap", boltz.CurrencyBtc, 171, 200, settled))
+	require.NotNil(t, nursery.checkTimeout("swap", boltz.CurrencyBtc, 195, 200, settled))
+	require.Nil(t, nursery.checkTimeout("swap", boltz.CurrencyBtc, 196, 200, settled))
+
+	// expired swaps are refunded instead
+	require.Nil(t, nursery.checkTimeout("expired", boltz.CurrencyBtc, 200, 200, settled))
+
+	require.Nil(t, nursery.checkTimeout("settled", boltz.CurrencyBtc, 195, 200, func() bool { return true }))
+
+	require.Nil(t, newTimeoutNursery().checkTimeout("disabled", boltz.CurrencyBtc, 195, 200, settled))
+}
+
+func TestInvoiceExpiry(t *testing.T) {
+	nursery := newTimeoutNursery()
+	require.Equal(t, 11*10*time.Minute, nursery.invoiceExpiry(boltz.CurrencyBtc, 10))
+
+	// blocks which were all mined at almost the same time must not collapse the expiry
+	start := time.Unix(1700000000, 0)
+	for i := uint32(0); i < maxBlockSamples; i++ {
+		nursery.recordBlock(boltz.CurrencyBtc, &onchain.BlockEpoch{
+			Height:    100 + i,
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+		})
+	}
+	require.Equal(t, 11*10*time.Minute, nursery.invoiceExpiry(boltz.CurrencyBtc, 10))
+
+	// slow blocks extend it
+	for i := uint32(0); i < maxBlockSamples; i++ {
+		nursery.recordBlock(boltz.CurrencyBtc, &onchain.BlockEpoch{
+			Height:    200 + i,
+			Timestamp: start.Add(time.Duration(i) * 20 * time.Minute),
+		})
+	}
+	require.Equal(t, 11*20*time.Minute, nursery.invoiceExpiry(boltz.CurrencyBtc, 10))
+}
+
+func TestPruneWarnedTimeouts(t *testing.T) {
+	nursery := newTimeoutNursery(36)
+	settled := func() bool { return false }
+
+	require.NotNil(t, nursery.checkTimeout("pending", boltz.CurrencyBtc, 170, 200, settled))
+	require.NotNil(t, nursery.checkTimeout("abandoned", boltz.CurrencyBtc, 170, 200, settled))
+
+	nursery.pruneWarnedTimeouts(map[string]bool{"pending": true})
+	require.Equal(t, map[string]uint32{"pending": 36}, nursery.warnedTimeouts)
+}
+
+func TestCheckSwapTimeouts(t *testing.T) {
+	test := newTestNursery(t, Config{TimeoutWarnings: []uint32{36}}, nil)
+
+	pending := test.createLockedSwap(t, 100000)
+	settled := test.createLockedSwap(t, 100000)
+	test.btc.confirm(settled.LockupTransactionId)
+
+	updates, stop := test.GlobalSwapUpdates()
+	defer stop()
+
+	test.checkTimeouts(boltz.CurrencyBtc, pending.TimoutBlockHeight-10)
+
+	update := <-updates
+	require.Equal(t, pending.Id, update.Swap.Id)
+	require.Equal(t, uint32(10), update.TimeoutWarning.BlocksLeft)
+	require.Equal(t, "lockup transaction is not confirmed yet", update.TimeoutWarning.Message)
+
+	// a confirmed lockup is settled and can be claimed by boltz
+	test.warnedTimeoutsLock.Lock()
+	defer test.warnedTimeoutsLock.Unlock()
+	require.NotContains(t, test.warnedTimeouts, settled.Id)
+}
@@ -1,6 +1,8 @@
 package onchain
 
 import (
+	"errors"
+
 	"github.com/BoltzExchange/boltz-client/boltz"
 )
 
@@ -16,3 +18,11 @@ func NewBoltzTxProvider(boltz *boltz.Boltz, currency boltz.Currency) TxProvider
 func (boltz boltzTxProvider) GetTxHex(txId string) (string, error) {
 	return boltz.GetTransaction(txId, boltz.currency)
 }
+
+func (boltz boltzTxProvider) BroadcastTransaction(txHex string) (string, error) {
+	return boltz.Boltz.BroadcastTransaction(txHex, boltz.currency)
+}
+
+func (boltz boltzTxProvider) IsTransactionConfirmed(txId string) (bool, error) {
+	return false, errors.ErrUnsupported
+}
@@ -0,0 +1,69 @@
+package onchain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/BoltzExchange/boltz-client/logger"
+)
+
+type NamedTxProvider struct {
+	TxProvider
+	Name string
+}
+
+// FallbackTxProvider queries its providers in order of priority and only returns an error if all of them failed
+type FallbackTxProvider []NamedTxProvider
+
+var ErrNoTxProviders = errors.New("no transaction providers")
+
+func (providers FallbackTxProvider) GetTxHex(txId string) (string, error) {
+	var errs []error
+	for _, provider := range providers {
+		hex, err := provider.GetTxHex(txId)
+		if err == nil {
+			return hex, nil
+		}
+		logger.Warnf("Could not get transaction %s from %s: %v", txId, provider.Name, err)
+		errs = append(errs, fmt.Errorf("%s: %w", provider.Name, err))
+	}
+	if len(errs) == 0 {
+		return "", ErrNoTxProviders
+	}
+	return "", errors.Join(errs...)
+}
+
+func (providers FallbackTxProvider) BroadcastTransaction(txHex string) (string, error) {
+	var errs []error
+	for _, provider := range providers {
+		txId, err := provider.BroadcastTransaction(txHex)
+		if err == nil {
+			logger.Infof("Broadcast transaction %s with %s", txId, provider.Name)
+			return txId, nil
+		}
+		logger.Warnf("Could not broadcast transaction with %s: %v", provider.Name, err)
+		errs = append(errs, fmt.Errorf("%s: %w", provider.Name, err))
+	}
+	if len(errs) == 0 {
+		return "", ErrNoTxProviders
+	}
+	return "", errors.Join(errs...)
+}
+
+func (providers FallbackTxProvider) IsTransactionConfirmed(txId string) (bool, error) {
+	var errs []error
+	for _, provider := range providers {
+		confirmed, err := provider.IsTransactionConfirmed(txId)
+		if err == nil {
+			return confirmed, nil
+		}
+		if !errors.Is(err, errors.ErrUnsupported) {
+			logger.Debugf("Could not get confirmation status of transaction %s from %s: %v", txId, provider.Name, err)
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", provider.Name, err))
+	}
+	if len(errs) == 0 {
+		return false, ErrNoTxProviders
+	}
+	return false, errors.Join(errs...)
+}
@@ -0,0 +1,71 @@
+package onchain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testTxProvider struct {
+	hex       string
+	txId      string
+	confirmed bool
+	err       error
+
+	calls int
+}
+
+func (provider *testTxProvider) GetTxHex(txId string) (string, error) {
+	provider.calls++
+	return provider.hex, provider.err
+}
+
+func (provider *testTxProvider) BroadcastTransaction(txHex string) (string, error) {
+	provider.calls++
+	return provider.txId, provider.err
+}
+
+func (provider *testTxProvider) IsTransactionConfirmed(txId string) (bool, error) {
+	provider.calls++
+	return provider.confirmed, provider.err
+}
+
+func TestFallbackTxProvider(t *testing.T) {
+	failing := &testTxProvider{err: errors.New("unavailable")}
+	working := &testTxProvider{hex: "hex", txId: "txId"}
+	unused := &testTxProvider{hex: "other", txId: "other"}
+
+	providers := FallbackTxProvider{
+		{TxProvider: failing, Name: "failing"},
+		{TxProvider: working, Name: "working"},
+		{TxProvider: unused, Name: "unused"},
+	}
+
+	hex, err := providers.GetTxHex("txId")
+	require.NoError(t, err)
+	require.Equal(t, "hex", hex)
+
+	txId, err := providers.BroadcastTransaction("hex")
+	require.NoError(t, err)
+	require.Equal(t, "txId", txId)
+
+	working.confirmed = true
+	confirmed, err := providers.IsTransactionConfirmed("txId")
+	require.NoError(t, err)
+	require.True(t, confirmed)
+
+	require.Equal(t, 3, failing.calls)
+	require.Equal(t, 3, working.calls)
+	require.Zero(t, unused.calls)
+
+	notFound := &testTxProvider{err: ErrTransactionNotFound}
+	_, err = FallbackTxProvider{{TxProvider: failing, Name: "failing"}, {TxProvider: notFound, Name: "notFound"}}.IsTransactionConfirmed("txId")
+	require.ErrorIs(t, err, ErrTransactionNotFound)
+
+	_, err = FallbackTxProvider{{TxProvider: failing, Name: "failing"}}.BroadcastTransaction("hex")
+	require.ErrorContains(t, err, "failing: unavailable")
+
+	_, err = FallbackTxProvider{}.GetTxHex("txId")
+	require.ErrorIs(t, err, ErrNoTxProviders)
+}
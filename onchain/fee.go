@@ -0,0 +1,65 @@
+package onchain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/utils"
+)
+
+// DefaultConfTarget is the confirmation target used for fee estimations if none is specified
+const DefaultConfTarget int32 = 2
+
+var ErrFeeTooHigh = errors.New("fee exceeds the maximum of the fee policy")
+
+// FeePolicy determines the fees paid by the onchain transactions of a swap
+type FeePolicy struct {
+	ConfTarget *int32 `json:",omitempty"`
+	// used instead of a fee estimation if set
+	SatPerVbyte   *float64          `json:",omitempty"`
+	MaxFee        *uint64           `json:",omitempty"`
+	MaxFeePercent *utils.Percentage `json:",omitempty"`
+}
+
+// MaxFeeFor returns the highest fee a transaction moving the given amount may pay, or nil if there is no limit.
+// A percentage which rounds down to 0 sat allows no fee at all rather than lifting the limit.
+func (policy *FeePolicy) MaxFeeFor(amount uint64) *uint64 {
+	if policy == nil {
+		return nil
+	}
+	maxFee := policy.MaxFee
+	if policy.MaxFeePercent != nil {
+		relative := uint64(policy.MaxFeePercent.Calculate(float64(amount)))
+		if maxFee == nil || relative < *maxFee {
+			maxFee = &relative
+		}
+	}
+	return maxFee
+}
+
+func (policy *FeePolicy) CheckFee(fee uint64, amount uint64) error {
+	return CheckMaxFee(fee, policy.MaxFeeFor(amount))
+}
+
+// CheckMaxFee returns ErrFeeTooHigh if the fee exceeds maxFee; nil means there is no limit
+func CheckMaxFee(fee uint64, maxFee *uint64) error {
+	if maxFee != nil && fee > *maxFee {
+		return fmt.Errorf("%w: %d sat > %d sat", ErrFeeTooHigh, fee, *maxFee)
+	}
+	return nil
+}
+
+// EstimateFeeWithPolicy returns the fixed fee rate of the policy or estimates one with its confirmation target
+func (onchain *Onchain) EstimateFeeWithPolicy(currency boltz.Currency, policy *FeePolicy) (float64, error) {
+	confTarget := DefaultConfTarget
+	if policy != nil {
+		if policy.SatPerVbyte != nil {
+			return *policy.SatPerVbyte, nil
+		}
+		if policy.ConfTarget != nil {
+			confTarget = *policy.ConfTarget
+		}
+	}
+	return onchain.EstimateFee(currency, confTarget)
+}
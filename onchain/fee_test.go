@@ -0,0 +1,43 @@
+package onchain
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeePolicy(t *testing.T) {
+	maxFee := uint64(1000)
+	maxFeePercent := utils.Percentage(1)
+	fee := func(fee uint64) *uint64 { return &fee }
+
+	tests := []struct {
+		desc   string
+		policy *FeePolicy
+		amount uint64
+		maxFee *uint64
+	}{
+		{"NoPolicy", nil, 100000, nil},
+		{"NoLimit", &FeePolicy{}, 100000, nil},
+		{"Absolute", &FeePolicy{MaxFee: &maxFee}, 100000, fee(1000)},
+		{"Percentage", &FeePolicy{MaxFeePercent: &maxFeePercent}, 50000, fee(500)},
+		{"LowerAbsolute", &FeePolicy{MaxFee: &maxFee, MaxFeePercent: &maxFeePercent}, 200000, fee(1000)},
+		{"LowerPercentage", &FeePolicy{MaxFee: &maxFee, MaxFeePercent: &maxFeePercent}, 50000, fee(500)},
+		// a percentage which rounds down to nothing must not lift the absolute limit
+		{"SmallAmount", &FeePolicy{MaxFee: &maxFee, MaxFeePercent: &maxFeePercent}, 50, fee(0)},
+		{"SmallAmountPercentage", &FeePolicy{MaxFeePercent: &maxFeePercent}, 50, fee(0)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.Equal(t, tc.maxFee, tc.policy.MaxFeeFor(tc.amount))
+			if tc.maxFee == nil {
+				require.NoError(t, tc.policy.CheckFee(1000000, tc.amount))
+				return
+			}
+			require.NoError(t, tc.policy.CheckFee(*tc.maxFee, tc.amount))
+			require.ErrorIs(t, tc.policy.CheckFee(*tc.maxFee+1, tc.amount), ErrFeeTooHigh)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"slices"
+	"time"
 
 	"github.com/BoltzExchange/boltz-client/boltz"
 	"github.com/BoltzExchange/boltz-client/logger"
@@ -15,6 +16,8 @@ import (
 
 type BlockEpoch struct {
 	Height uint32
+	// time from the block header; zero if the block listener does not know it
+	Timestamp time.Time
 }
 
 type Balance struct {
@@ -34,6 +37,15 @@ type FeeProvider interface {
 
 type TxProvider interface {
 	GetTxHex(txId string) (string, error)
+	BroadcastTransaction(txHex string) (string, error)
+	// IsTransactionConfirmed returns ErrTransactionNotFound if the transaction is neither in the mempool nor in the chain
+	IsTransactionConfirmed(txId string) (bool, error)
+}
+
+// FeeBumper is implemented by wallets which can spend an unconfirmed output paying to them
+// with a higher fee to speed up the confirmation of the parent transaction (CPFP)
+type FeeBumper interface {
+	BumpFee(txId string, vout uint32, satPerVbyte float64) error
 }
 
 type AddressProvider interface {
@@ -52,6 +64,8 @@ type Wallet interface {
 	GetBalance() (*Balance, error)
 }
 
+var ErrTransactionNotFound = errors.New("transaction not found")
+
 type Currency struct {
 	Listener BlockListener
 	Fees     FeeProvider
@@ -61,6 +75,7 @@ type Currency struct {
 type Onchain struct {
 	Btc            *Currency
 	Liquid         *Currency
+	Rootstock      *Currency
 	Network        *boltz.Network
 	Wallets        []Wallet
 	OnWalletChange *utils.ChannelForwarder[[]Wallet]
@@ -87,6 +102,8 @@ func (onchain *Onchain) GetCurrency(currency boltz.Currency) (*Currency, error)
 		return onchain.Btc, nil
 	} else if currency == boltz.CurrencyLiquid && onchain.Liquid != nil {
 		return onchain.Liquid, nil
+	} else if currency == boltz.CurrencyRootstock && onchain.Rootstock != nil {
+		return onchain.Rootstock, nil
 	}
 	return nil, errors.New("invalid currency")
 }
@@ -187,6 +204,30 @@ func (onchain *Onchain) GetTransaction(currency boltz.Currency, txId string, our
 	return boltz.NewTxFromHex(currency, hex, ourOutputBlindingKey)
 }
 
+func (onchain *Onchain) BroadcastTransaction(transaction boltz.Transaction) (string, error) {
+	currency := boltz.CurrencyBtc
+	if _, ok := transaction.(*boltz.LiquidTransaction); ok {
+		currency = boltz.CurrencyLiquid
+	}
+	chain, err := onchain.GetCurrency(currency)
+	if err != nil {
+		return "", err
+	}
+	serialized, err := transaction.Serialize()
+	if err != nil {
+		return "", fmt.Errorf("could not serialize transaction: %w", err)
+	}
+	return chain.Tx.BroadcastTransaction(serialized)
+}
+
+func (onchain *Onchain) IsTransactionConfirmed(currency boltz.Currency, txId string) (bool, error) {
+	chain, err := onchain.GetCurrency(currency)
+	if err != nil {
+		return false, err
+	}
+	return chain.Tx.IsTransactionConfirmed(txId)
+}
+
 func (onchain *Onchain) GetTransactionFee(currency boltz.Currency, txId string) (uint64, error) {
 	transaction, err := onchain.GetTransaction(currency, txId, nil)
 	if err != nil {
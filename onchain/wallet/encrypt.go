@@ -6,12 +6,13 @@ import (
 	"crypto/rand"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"io"
 
 	"golang.org/x/crypto/pbkdf2"
 )
 
-func generateSalt() (string, error) {
+func GenerateSalt() (string, error) {
 	bytes := make([]byte, 32) //generate a random 32 byte key for AES-256
 	if _, err := rand.Read(bytes); err != nil {
 		return "", err
@@ -29,72 +30,94 @@ func key(password string, salt string) ([]byte, error) {
 }
 
 func encrypt(stringToEncrypt string, password string, salt string) (string, error) {
-	key, err := key(password, salt)
+	ciphertext, err := EncryptBytes([]byte(stringToEncrypt), password, salt)
 	if err != nil {
 		return "", err
 	}
+	return hex.EncodeToString(ciphertext), nil
+}
 
-	plaintext := []byte(stringToEncrypt)
-
-	//Create a new Cipher Block from the key
-	block, err := aes.NewCipher(key)
+func decrypt(encryptedString string, password string, salt string) (string, error) {
+	enc, err := hex.DecodeString(encryptedString)
 	if err != nil {
 		return "", err
 	}
-
-	//Create a new GCM - https://en.wikipedia.org/wiki/Galois/Counter_Mode
-	//https://golang.org/pkg/crypto/cipher/#NewGCM
-	aesGCM, err := cipher.NewGCM(block)
+	plaintext, err := DecryptBytes(enc, password, salt)
 	if err != nil {
 		return "", err
 	}
+	return string(plaintext), nil
+}
 
-	//Create a nonce. Nonce should be from GCM
-	nonce := make([]byte, aesGCM.NonceSize())
-	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
-		return "", err
+// EncryptBytes encrypts with AES-256-GCM using a key derived from the password and hex encoded salt.
+// The nonce is prepended to the returned ciphertext.
+func EncryptBytes(plaintext []byte, password string, salt string) ([]byte, error) {
+	cipher, err := NewCipher(password, salt)
+	if err != nil {
+		return nil, err
 	}
-
-	//Encrypt the data using aesGCM.Seal
-	//Since we don't want to save the nonce somewhere else in this case, we add it as a prefix to the encrypted data. The first nonce argument in Seal is the prefix.
-	ciphertext := aesGCM.Seal(nonce, nonce, plaintext, nil)
-	return hex.EncodeToString(ciphertext), nil
+	return cipher.Encrypt(plaintext)
 }
 
-func decrypt(encryptedString string, password string, salt string) (string, error) {
-	key, err := key(password, salt)
+// DecryptBytes reverses EncryptBytes
+func DecryptBytes(enc []byte, password string, salt string) ([]byte, error) {
+	cipher, err := NewCipher(password, salt)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	return cipher.Decrypt(enc)
+}
 
-	enc, err := hex.DecodeString(encryptedString)
+// Cipher uses the same scheme as EncryptBytes and DecryptBytes, but only derives the key once.
+// This makes it suitable for encrypting many small values with the same password and salt.
+type Cipher struct {
+	aesGCM cipher.AEAD
+}
+
+func NewCipher(password string, salt string) (*Cipher, error) {
+	key, err := key(password, salt)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	//Create a new Cipher Block from the key
 	block, err := aes.NewCipher(key)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	//Create a new GCM
+	//Create a new GCM - https://en.wikipedia.org/wiki/Galois/Counter_Mode
+	//https://golang.org/pkg/crypto/cipher/#NewGCM
 	aesGCM, err := cipher.NewGCM(block)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
+	return &Cipher{aesGCM: aesGCM}, nil
+}
+
+func (c *Cipher) Encrypt(plaintext []byte) ([]byte, error) {
+	//Create a nonce. Nonce should be from GCM
+	nonce := make([]byte, c.aesGCM.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	//Encrypt the data using aesGCM.Seal
+	//Since we don't want to save the nonce somewhere else in this case, we add it as a prefix to the encrypted data. The first nonce argument in Seal is the prefix.
+	return c.aesGCM.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func (c *Cipher) Decrypt(enc []byte) ([]byte, error) {
 	//Get the nonce size
-	nonceSize := aesGCM.NonceSize()
+	nonceSize := c.aesGCM.NonceSize()
+	if len(enc) < nonceSize {
+		return nil, errors.New("ciphertext too short")
+	}
 
 	//Extract the nonce from the encrypted data
 	nonce, ciphertext := enc[:nonceSize], enc[nonceSize:]
 
 	//Decrypt the data
-	plaintext, err := aesGCM.Open(nil, nonce, ciphertext, nil)
-	if err != nil {
-		return "", err
-	}
-
-	return string(plaintext), nil
+	return c.aesGCM.Open(nil, nonce, ciphertext, nil)
 }
@@ -10,7 +10,7 @@ const data = "aösldfjaösldkfösldkfjaösldkfj"
 const password = "hallo123"
 
 func TestEncryptDecrypt(t *testing.T) {
-	salt, err := generateSalt()
+	salt, err := GenerateSalt()
 	require.NoError(t, err)
 	require.NotEmpty(t, salt)
 
@@ -24,9 +24,36 @@ func TestEncryptDecrypt(t *testing.T) {
 	_, err = decrypt(cipher, "wrong", salt)
 	require.Error(t, err)
 
-	salt, err = generateSalt()
+	salt, err = GenerateSalt()
 	require.NoError(t, err)
 	require.NotEmpty(t, salt)
 	_, err = decrypt(cipher, password, salt)
 	require.Error(t, err)
 }
+
+func TestCipher(t *testing.T) {
+	salt, err := GenerateSalt()
+	require.NoError(t, err)
+
+	cipher, err := NewCipher(password, salt)
+	require.NoError(t, err)
+
+	encrypted, err := cipher.Encrypt([]byte(data))
+	require.NoError(t, err)
+
+	// compatible with the one shot functions
+	plain, err := DecryptBytes(encrypted, password, salt)
+	require.NoError(t, err)
+	require.Equal(t, data, string(plain))
+
+	encrypted, err = EncryptBytes([]byte(data), password, salt)
+	require.NoError(t, err)
+	plain, err = cipher.Decrypt(encrypted)
+	require.NoError(t, err)
+	require.Equal(t, data, string(plain))
+
+	wrong, err := NewCipher("wrong", salt)
+	require.NoError(t, err)
+	_, err = wrong.Decrypt(encrypted)
+	require.Error(t, err)
+}
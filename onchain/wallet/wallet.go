@@ -23,6 +23,7 @@ import (
 
 	"github.com/BoltzExchange/boltz-client/logger"
 	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/BoltzExchange/boltz-client/proxy"
 
 	"github.com/BoltzExchange/boltz-client/boltz"
 )
@@ -72,7 +73,7 @@ func (c *Credentials) Encrypt(password string) (*Credentials, error) {
 	var err error
 
 	encrypted := *c
-	encrypted.Salt, err = generateSalt()
+	encrypted.Salt, err = GenerateSalt()
 	if err != nil {
 		return nil, fmt.Errorf("could not generate new salt: %w", err)
 	}
@@ -109,6 +110,8 @@ type Config struct {
 	DataDir string
 	Network *boltz.Network
 	Debug   bool
+	// connections of gdk are made through the proxy if it is set
+	Proxy *proxy.Proxy
 }
 
 var config *Config
@@ -303,6 +306,10 @@ func (wallet *Wallet) Connect() error {
 	} else {
 		return errors.New("unknown currency")
 	}
+	if config.Proxy != nil {
+		params["proxy"] = config.Proxy.URL("gdk-" + string(wallet.currency))
+		params["use_tor"] = true
+	}
 	paramsJson, free := toJson(params)
 	defer free()
 
@@ -586,7 +593,7 @@ func (wallet *Wallet) SendToAddress(address string, amount uint64, satPerVbyte f
 		asset = config.Network.Liquid.AssetID
 	}
 
-	transactionDetails, free := toJson(map[string]any{
+	return wallet.sendTransaction(map[string]any{
 		"fee_rate": satPerVbyte * 1000,
 		"addressees": []map[string]any{
 			{
@@ -597,6 +604,65 @@ func (wallet *Wallet) SendToAddress(address string, amount uint64, satPerVbyte f
 		},
 		"utxos": outputs.Unspent,
 	})
+}
+
+// BumpFee spends the given unconfirmed output of the wallet back to itself with the given fee rate (CPFP)
+func (wallet *Wallet) BumpFee(txId string, vout uint32, satPerVbyte float64) error {
+	if wallet.readonly {
+		return errors.New("wallet is readonly")
+	}
+	if wallet.subaccount == nil {
+		return ErrSubAccountNotSet
+	}
+	params, free := toJson(map[string]any{
+		"subaccount": *wallet.subaccount,
+		"num_confs":  0,
+	})
+	defer free()
+	var handler AuthHandler
+
+	var outputs struct {
+		Unspent map[string][]map[string]any `json:"unspent_outputs"`
+	}
+	if err := withAuthHandler(C.GA_get_unspent_outputs(wallet.session, params, &handler), handler, &outputs); err != nil {
+		return err
+	}
+
+	for asset, utxos := range outputs.Unspent {
+		for _, utxo := range utxos {
+			ptIdx, _ := utxo["pt_idx"].(float64)
+			if utxo["txhash"] != txId || uint32(ptIdx) != vout {
+				continue
+			}
+			address, err := wallet.NewAddress()
+			if err != nil {
+				return err
+			}
+			assetId := ""
+			if wallet.currency == boltz.CurrencyLiquid {
+				assetId = asset
+			}
+			_, err = wallet.sendTransaction(map[string]any{
+				"fee_rate": satPerVbyte * 1000,
+				"addressees": []map[string]any{
+					{
+						"address":   address,
+						"asset_id":  assetId,
+						"is_greedy": true,
+					},
+				},
+				"utxos":         map[string]any{asset: []any{utxo}},
+				"utxo_strategy": "manual",
+			})
+			return err
+		}
+	}
+	return fmt.Errorf("output %s:%d does not belong to wallet", txId, vout)
+}
+
+func (wallet *Wallet) sendTransaction(details map[string]any) (string, error) {
+	var handler AuthHandler
+	transactionDetails, free := toJson(details)
 	defer free()
 
 	var result any
@@ -604,7 +670,7 @@ func (wallet *Wallet) SendToAddress(address string, amount uint64, satPerVbyte f
 		return "", err
 	}
 
-	params, free = toJson(result)
+	params, free := toJson(result)
 	if err := withAuthHandler(C.GA_blind_transaction(wallet.session, params, &handler), handler, &result); err != nil {
 		return "", err
 	}
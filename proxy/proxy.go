@@ -0,0 +1,152 @@
+package proxy
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/BoltzExchange/boltz-client/logger"
+	"golang.org/x/net/proxy"
+)
+
+// Proxy routes outbound connections through a SOCKS5 proxy like the one of the Tor daemon.
+// A nil *Proxy connects directly, so callers don't have to check whether a proxy is configured.
+type Proxy struct {
+	address string
+	// random for every run so that the credentials of a service can not be linked across restarts
+	secret string
+}
+
+// New creates a proxy for the SOCKS5 server at address (host:port); nil is returned if address is empty
+func New(address string) (*Proxy, error) {
+	if address == "" {
+		return nil, nil
+	}
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		return nil, fmt.Errorf("invalid proxy address %s: %w", address, err)
+	}
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	return &Proxy{address: address, secret: hex.EncodeToString(secret)}, nil
+}
+
+func (p *Proxy) Address() string {
+	if p == nil {
+		return ""
+	}
+	return p.address
+}
+
+// URL returns the socks5:// url of the proxy with the credentials of the given service, for libraries which
+// connect by themselves. An empty string is returned for a nil proxy.
+func (p *Proxy) URL(service string) string {
+	if p == nil {
+		return ""
+	}
+	proxyUrl := url.URL{Scheme: "socks5", User: url.UserPassword(service, p.secret), Host: p.address}
+	return proxyUrl.String()
+}
+
+// Dialer returns a dialer for the given service. Every service authenticates with different credentials,
+// which makes Tor use a separate circuit for it (IsolateSOCKSAuth is enabled by default).
+func (p *Proxy) Dialer(service string) proxy.ContextDialer {
+	if p == nil {
+		return &net.Dialer{}
+	}
+	auth := &proxy.Auth{User: service, Password: p.secret}
+	dialer, err := proxy.SOCKS5("tcp", p.address, auth, &net.Dialer{})
+	if err != nil {
+		// only happens for unsupported networks
+		return failingDialer{err: err}
+	}
+	contextDialer, ok := dialer.(proxy.ContextDialer)
+	if !ok {
+		return failingDialer{err: errors.New("socks5 dialer does not support contexts")}
+	}
+	return contextDialer
+}
+
+// Dial opens a connection to address for the given service
+func (p *Proxy) Dial(service string, network string, address string) (net.Conn, error) {
+	return p.Dialer(service).DialContext(context.Background(), network, address)
+}
+
+// HTTPClient returns a client whose connections are made through the proxy
+func (p *Proxy) HTTPClient(service string) *http.Client {
+	if p == nil {
+		return http.DefaultClient
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:       p.Dialer(service).DialContext,
+			ForceAttemptHTTP2: true,
+		},
+	}
+}
+
+// Forward listens on a local port and forwards every connection to target through the proxy.
+// This is needed for libraries which do not allow setting a custom dialer.
+// The returned address is the one to connect to instead of target.
+func (p *Proxy) Forward(service string, target string) (string, error) {
+	if p == nil {
+		return target, nil
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	logger.Debugf("Forwarding connections to %s from %s through proxy %s", target, listener.Addr(), p.address)
+
+	dialer := p.Dialer(service)
+	go func() {
+		for {
+			local, err := listener.Accept()
+			if err != nil {
+				logger.Errorf("Could not accept connection to forward to %s: %v", target, err)
+				return
+			}
+			go func() {
+				remote, err := dialer.DialContext(context.Background(), "tcp", target)
+				if err != nil {
+					logger.Warnf("Could not connect to %s through proxy: %v", target, err)
+					_ = local.Close()
+					return
+				}
+				pipe(local, remote)
+			}()
+		}
+	}()
+
+	return listener.Addr().String(), nil
+}
+
+func pipe(a net.Conn, b net.Conn) {
+	var once sync.Once
+	closeBoth := func() {
+		_ = a.Close()
+		_ = b.Close()
+	}
+	copyConn := func(dst net.Conn, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		once.Do(closeBoth)
+	}
+	go copyConn(a, b)
+	copyConn(b, a)
+}
+
+type failingDialer struct {
+	err error
+}
+
+func (dialer failingDialer) DialContext(context.Context, string, string) (net.Conn, error) {
+	return nil, dialer.err
+}
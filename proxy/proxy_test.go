@@ -0,0 +1,218 @@
+package proxy
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// socksServer is a minimal SOCKS5 server which only supports CONNECT with username and password authentication
+type socksServer struct {
+	listener net.Listener
+
+	lock  sync.Mutex
+	users []string
+}
+
+func newSocksServer(t *testing.T) *socksServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	server := &socksServer{listener: listener}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.handle(conn)
+		}
+	}()
+	return server
+}
+
+func (server *socksServer) seenUsers() []string {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	return append([]string{}, server.users...)
+}
+
+func readString(reader *bufio.Reader) (string, error) {
+	length, err := reader.ReadByte()
+	if err != nil {
+		return "", err
+	}
+	value := make([]byte, length)
+	_, err = io.ReadFull(reader, value)
+	return string(value), err
+}
+
+func (server *socksServer) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	// greeting: version, number of methods, methods
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return
+	}
+	if _, err := io.ReadFull(reader, make([]byte, header[1])); err != nil {
+		return
+	}
+	// username and password authentication
+	if _, err := conn.Write([]byte{0x05, 0x02}); err != nil {
+		return
+	}
+
+	if _, err := reader.ReadByte(); err != nil {
+		return
+	}
+	user, err := readString(reader)
+	if err != nil {
+		return
+	}
+	if _, err := readString(reader); err != nil {
+		return
+	}
+	server.lock.Lock()
+	server.users = append(server.users, user)
+	server.lock.Unlock()
+	if _, err := conn.Write([]byte{0x01, 0x00}); err != nil {
+		return
+	}
+
+	// request: version, command, reserved, address type
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(reader, request); err != nil {
+		return
+	}
+	var host string
+	switch request[3] {
+	case 0x01:
+		ip := make([]byte, net.IPv4len)
+		if _, err := io.ReadFull(reader, ip); err != nil {
+			return
+		}
+		host = net.IP(ip).String()
+	case 0x03:
+		if host, err = readString(reader); err != nil {
+			return
+		}
+	default:
+		return
+	}
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(reader, port); err != nil {
+		return
+	}
+
+	target, err := net.Dial("tcp", net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))))
+	if err != nil {
+		_, _ = conn.Write([]byte{0x05, 0x05, 0x00, 0x01, 0, 0, 0, 0, 0, 0})
+		return
+	}
+	defer target.Close()
+	if _, err := conn.Write([]byte{0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0}); err != nil {
+		return
+	}
+
+	go func() {
+		_, _ = io.Copy(target, reader)
+		_ = target.Close()
+	}()
+	_, _ = io.Copy(conn, target)
+}
+
+func TestNilProxy(t *testing.T) {
+	p, err := New("")
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	require.Equal(t, http.DefaultClient, p.HTTPClient("test"))
+	address, err := p.Forward("test", "127.0.0.1:1234")
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1:1234", address)
+	require.Empty(t, p.URL("test"))
+
+	_, err = New("invalid")
+	require.Error(t, err)
+}
+
+func TestURL(t *testing.T) {
+	p, err := New("127.0.0.1:9050")
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(p.URL("gdk"))
+	require.NoError(t, err)
+	require.Equal(t, "socks5", parsed.Scheme)
+	require.Equal(t, "127.0.0.1:9050", parsed.Host)
+	require.Equal(t, "gdk", parsed.User.Username())
+	password, _ := parsed.User.Password()
+	require.Equal(t, p.secret, password)
+}
+
+func TestHTTPClient(t *testing.T) {
+	server := newSocksServer(t)
+	p, err := New(server.listener.Addr().String())
+	require.NoError(t, err)
+
+	backend := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer backend.Close()
+
+	for _, service := range []string{"boltz", "mempool"} {
+		res, err := p.HTTPClient(service).Get(backend.URL)
+		require.NoError(t, err)
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Equal(t, "ok", string(body))
+		_ = res.Body.Close()
+	}
+
+	// every service authenticates with its own credentials to get a separate circuit
+	require.Equal(t, []string{"boltz", "mempool"}, server.seenUsers())
+}
+
+func TestForward(t *testing.T) {
+	server := newSocksServer(t)
+	p, err := New(server.listener.Addr().String())
+	require.NoError(t, err)
+
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer echo.Close()
+	go func() {
+		conn, err := echo.Accept()
+		if err != nil {
+			return
+		}
+		_, _ = io.Copy(conn, conn)
+	}()
+
+	address, err := p.Forward("electrum", echo.Addr().String())
+	require.NoError(t, err)
+	require.NotEqual(t, echo.Addr().String(), address)
+
+	conn, err := net.Dial("tcp", address)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("ping\n"))
+	require.NoError(t, err)
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "ping\n", line)
+
+	require.Equal(t, []string{"electrum"}, server.seenUsers())
+}
@@ -0,0 +1,67 @@
+package rootstock
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"golang.org/x/crypto/sha3"
+)
+
+// Address is the 20 byte address of an EVM account or contract
+type Address [20]byte
+
+func keccak256(data ...[]byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	for _, chunk := range data {
+		hash.Write(chunk)
+	}
+	return hash.Sum(nil)
+}
+
+// ParseAddress parses a hex encoded address with or without checksum
+func ParseAddress(address string) (Address, error) {
+	var result Address
+	raw, err := hex.DecodeString(strings.TrimPrefix(address, "0x"))
+	if err != nil {
+		return result, fmt.Errorf("invalid address %s: %w", address, err)
+	}
+	if len(raw) != len(result) {
+		return result, fmt.Errorf("invalid address %s: length is %d bytes", address, len(raw))
+	}
+	copy(result[:], raw)
+	if hasMixedCase(address) && result.Hex() != address {
+		return result, errors.New("invalid address checksum: " + address)
+	}
+	return result, nil
+}
+
+func hasMixedCase(address string) bool {
+	address = strings.TrimPrefix(address, "0x")
+	return strings.ToLower(address) != address && strings.ToUpper(address) != address
+}
+
+// AddressFromKey derives the address controlled by a public key
+func AddressFromKey(key *btcec.PublicKey) Address {
+	var result Address
+	copy(result[:], keccak256(key.SerializeUncompressed()[1:])[12:])
+	return result
+}
+
+// Hex encodes the address with the mixed case checksum of EIP-55
+func (address Address) Hex() string {
+	encoded := []byte(hex.EncodeToString(address[:]))
+	hash := keccak256(encoded)
+	for i, char := range encoded {
+		if char >= 'a' && hash[i/2]>>(4*(1-uint(i)%2))&0xf >= 8 {
+			encoded[i] = char - 32
+		}
+	}
+	return "0x" + string(encoded)
+}
+
+func (address Address) String() string {
+	return address.Hex()
+}
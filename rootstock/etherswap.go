@@ -0,0 +1,162 @@
+package rootstock
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// weiPerSatoshi converts between the 18 decimals of RBTC and the 8 decimals boltz uses for amounts
+var weiPerSatoshi = big.NewInt(1e10)
+
+var (
+	lockSelector   = selector("lock(bytes32,address,uint256)")
+	claimSelector  = selector("claim(bytes32,uint256,address,uint256)")
+	refundSelector = selector("refund(bytes32,uint256,address,uint256)")
+
+	lockupEventTopic = keccak256([]byte("Lockup(bytes32,uint256,address,address,uint256)"))
+)
+
+var ErrLockupNotFound = errors.New("lockup not found")
+
+func selector(signature string) []byte {
+	return keccak256([]byte(signature))[:4]
+}
+
+func SatoshisToWei(satoshis uint64) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(satoshis), weiPerSatoshi)
+}
+
+func WeiToSatoshis(wei *big.Int) uint64 {
+	return new(big.Int).Quo(wei, weiPerSatoshi).Uint64()
+}
+
+func abiWord(value []byte) []byte {
+	word := make([]byte, 32)
+	copy(word[32-len(value):], value)
+	return word
+}
+
+func abiUint(value *big.Int) []byte {
+	return abiWord(value.Bytes())
+}
+
+func abiAddress(address Address) []byte {
+	return abiWord(address[:])
+}
+
+func abiBytes32(value []byte) ([]byte, error) {
+	if len(value) != 32 {
+		return nil, fmt.Errorf("expected 32 bytes, got %d", len(value))
+	}
+	return value, nil
+}
+
+func encodeCall(selector []byte, words ...[]byte) []byte {
+	data := append([]byte{}, selector...)
+	for _, word := range words {
+		data = append(data, word...)
+	}
+	return data
+}
+
+// EncodeLock encodes a call locking the value of the transaction for the claim address until the timelock
+func EncodeLock(preimageHash []byte, claimAddress Address, timelock uint32) ([]byte, error) {
+	hash, err := abiBytes32(preimageHash)
+	if err != nil {
+		return nil, err
+	}
+	return encodeCall(lockSelector, hash, abiAddress(claimAddress), abiUint(big.NewInt(int64(timelock)))), nil
+}
+
+// EncodeClaim encodes a call claiming a lockup with its preimage
+func EncodeClaim(preimage []byte, amount *big.Int, refundAddress Address, timelock uint32) ([]byte, error) {
+	value, err := abiBytes32(preimage)
+	if err != nil {
+		return nil, err
+	}
+	return encodeCall(claimSelector, value, abiUint(amount), abiAddress(refundAddress), abiUint(big.NewInt(int64(timelock)))), nil
+}
+
+// EncodeRefund encodes a call refunding a lockup after its timelock expired
+func EncodeRefund(preimageHash []byte, amount *big.Int, claimAddress Address, timelock uint32) ([]byte, error) {
+	hash, err := abiBytes32(preimageHash)
+	if err != nil {
+		return nil, err
+	}
+	return encodeCall(refundSelector, hash, abiUint(amount), abiAddress(claimAddress), abiUint(big.NewInt(int64(timelock)))), nil
+}
+
+// Lockup is a Lockup event emitted by the EtherSwap contract
+type Lockup struct {
+	TransactionId string
+	PreimageHash  []byte
+	Amount        *big.Int
+	ClaimAddress  Address
+	RefundAddress Address
+	Timelock      uint32
+}
+
+func (lockup *Lockup) Satoshis() uint64 {
+	return WeiToSatoshis(lockup.Amount)
+}
+
+// ParseLockup decodes a log of the event
+// Lockup(bytes32 indexed preimageHash, uint256 amount, address claimAddress, address indexed refundAddress, uint256 timelock)
+func ParseLockup(log Log) (*Lockup, error) {
+	if len(log.Topics) != 3 || !strings.EqualFold(log.Topics[0], encodeData(lockupEventTopic)) {
+		return nil, errors.New("log is no lockup event")
+	}
+	preimageHash, err := decodeData(log.Topics[1])
+	if err != nil {
+		return nil, err
+	}
+	refundTopic, err := decodeData(log.Topics[2])
+	if err != nil {
+		return nil, err
+	}
+	data, err := decodeData(log.Data)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) != 3*32 || len(refundTopic) != 32 {
+		return nil, fmt.Errorf("invalid lockup event data: %s", hex.EncodeToString(data))
+	}
+
+	lockup := &Lockup{
+		TransactionId: log.TransactionHash,
+		PreimageHash:  preimageHash,
+		Amount:        new(big.Int).SetBytes(data[:32]),
+		Timelock:      uint32(new(big.Int).SetBytes(data[64:96]).Uint64()),
+	}
+	copy(lockup.ClaimAddress[:], data[44:64])
+	copy(lockup.RefundAddress[:], refundTopic[12:])
+	return lockup, nil
+}
+
+// EtherSwap is the contract boltz uses for swaps of the native currency of EVM chains
+type EtherSwap struct {
+	client  *Client
+	Address Address
+}
+
+func NewEtherSwap(client *Client, address Address) *EtherSwap {
+	return &EtherSwap{client: client, Address: address}
+}
+
+// FindLockup returns the latest lockup with the preimage hash which was not removed by a reorg
+func (etherSwap *EtherSwap) FindLockup(preimageHash []byte, fromBlock uint32) (*Lockup, error) {
+	logs, err := etherSwap.client.GetLogs(etherSwap.Address, fromBlock, lockupEventTopic, preimageHash)
+	if err != nil {
+		return nil, err
+	}
+	for i := len(logs) - 1; i >= 0; i-- {
+		if logs[i].Removed {
+			continue
+		}
+		return ParseLockup(logs[i])
+	}
+	return nil, ErrLockupNotFound
+}
@@ -0,0 +1,42 @@
+package rootstock
+
+import (
+	"math/big"
+)
+
+// rlpBytes encodes a byte string according to the recursive length prefix encoding
+func rlpBytes(value []byte) []byte {
+	if len(value) == 1 && value[0] < 0x80 {
+		return value
+	}
+	return append(rlpLength(len(value), 0x80), value...)
+}
+
+// rlpUint encodes an integer as big endian byte string without leading zeros
+func rlpUint(value uint64) []byte {
+	return rlpBigInt(new(big.Int).SetUint64(value))
+}
+
+func rlpBigInt(value *big.Int) []byte {
+	if value == nil {
+		return rlpBytes(nil)
+	}
+	return rlpBytes(value.Bytes())
+}
+
+// rlpList encodes a list of already encoded items
+func rlpList(items ...[]byte) []byte {
+	var payload []byte
+	for _, item := range items {
+		payload = append(payload, item...)
+	}
+	return append(rlpLength(len(payload), 0xc0), payload...)
+}
+
+func rlpLength(length int, offset byte) []byte {
+	if length < 56 {
+		return []byte{offset + byte(length)}
+	}
+	encoded := new(big.Int).SetUint64(uint64(length)).Bytes()
+	return append([]byte{offset + 55 + byte(len(encoded))}, encoded...)
+}
@@ -0,0 +1,209 @@
+package rootstock
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func parseKey(t *testing.T, raw string) *btcec.PrivateKey {
+	decoded, err := hex.DecodeString(raw)
+	require.NoError(t, err)
+	key, _ := btcec.PrivKeyFromBytes(decoded)
+	return key
+}
+
+func TestRlp(t *testing.T) {
+	require.Equal(t, []byte{0x80}, rlpUint(0))
+	require.Equal(t, []byte{0x0f}, rlpUint(15))
+	require.Equal(t, []byte{0x82, 0x04, 0x00}, rlpUint(1024))
+	require.Equal(t, []byte{0x83, 'd', 'o', 'g'}, rlpBytes([]byte("dog")))
+	require.Equal(t, []byte{0xc8, 0x83, 'c', 'a', 't', 0x83, 'd', 'o', 'g'}, rlpList(rlpBytes([]byte("cat")), rlpBytes([]byte("dog"))))
+
+	require.Equal(t, []byte{0x80}, rlpBytes(nil))
+	require.Equal(t, []byte{0x80}, rlpBigInt(nil))
+	require.Equal(t, []byte{0x80}, rlpBigInt(big.NewInt(0)))
+	require.Equal(t, []byte{0x7f}, rlpBytes([]byte{0x7f}))
+	require.Equal(t, []byte{0x81, 0x80}, rlpBytes([]byte{0x80}))
+	require.Equal(t, []byte{0x81, 0xff}, rlpUint(255))
+	require.Equal(t, []byte{0xc0}, rlpList())
+
+	short := []byte(strings.Repeat("a", 55))
+	require.Equal(t, append([]byte{0xb7}, short...), rlpBytes(short))
+	long := []byte(strings.Repeat("a", 56))
+	require.Equal(t, append([]byte{0xb8, 56}, long...), rlpBytes(long))
+	longer := []byte(strings.Repeat("a", 1024))
+	require.Equal(t, append([]byte{0xb9, 0x04, 0x00}, longer...), rlpBytes(longer))
+
+	item := rlpBytes([]byte(strings.Repeat("b", 20)))
+	list := rlpList(item, item, item)
+	require.Equal(t, []byte{0xf8, 63}, list[:2])
+	require.Equal(t, bytes.Repeat(item, 3), list[2:])
+
+	list = rlpList(rlpBytes(longer))
+	require.Equal(t, []byte{0xf9, 0x04, 0x03, 0xb9, 0x04, 0x00}, list[:6])
+	require.Len(t, list, 3+3+1024)
+}
+
+func TestAddress(t *testing.T) {
+	for _, address := range []string{
+		"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+		"0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+		"0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+		"0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+	} {
+		parsed, err := ParseAddress(address)
+		require.NoError(t, err)
+		require.Equal(t, address, parsed.Hex())
+
+		_, err = ParseAddress(strings.ToLower(address))
+		require.NoError(t, err)
+	}
+
+	_, err := ParseAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD")
+	require.Error(t, err)
+	_, err = ParseAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeA")
+	require.Error(t, err)
+
+	// first development account of anvil and hardhat
+	key := parseKey(t, "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	require.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", AddressFromKey(key.PubKey()).Hex())
+}
+
+func TestSignTransaction(t *testing.T) {
+	// example of EIP-155
+	to, err := ParseAddress("0x3535353535353535353535353535353535353535")
+	require.NoError(t, err)
+	value, _ := new(big.Int).SetString("1000000000000000000", 10)
+	tx := &Transaction{
+		Nonce:    9,
+		GasPrice: big.NewInt(20000000000),
+		Gas:      21000,
+		To:       &to,
+		Value:    value,
+	}
+
+	require.Equal(t, "daf5a779ae972f972197303d7b574746c7ef83eadac0f2791ad23db92e4c8e53", hex.EncodeToString(tx.SigningHash(1)))
+
+	raw, txId, err := tx.Sign(parseKey(t, strings.Repeat("46", 32)), 1)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83",
+		hex.EncodeToString(raw),
+	)
+	require.Equal(t, "0x"+hex.EncodeToString(keccak256(raw)), txId)
+}
+
+func TestEtherSwapEncoding(t *testing.T) {
+	require.Equal(t, "a9059cbb", hex.EncodeToString(selector("transfer(address,uint256)")))
+
+	preimage := make([]byte, 32)
+	preimage[31] = 1
+	address, err := ParseAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	require.NoError(t, err)
+
+	data, err := EncodeClaim(preimage, SatoshisToWei(1000), address, 100)
+	require.NoError(t, err)
+	require.Len(t, data, 4+4*32)
+	require.Equal(t, claimSelector, data[:4])
+	require.Equal(t, preimage, data[4:36])
+	require.Equal(t, SatoshisToWei(1000), new(big.Int).SetBytes(data[36:68]))
+	require.Equal(t, address[:], data[80:100])
+	require.Equal(t, int64(100), new(big.Int).SetBytes(data[100:132]).Int64())
+
+	_, err = EncodeLock(preimage[:31], address, 100)
+	require.Error(t, err)
+	_, err = EncodeClaim(preimage[:31], SatoshisToWei(1000), address, 100)
+	require.Error(t, err)
+	_, err = EncodeRefund(append(preimage, 0), SatoshisToWei(1000), address, 100)
+	require.Error(t, err)
+}
+
+func TestEtherSwapSelectors(t *testing.T) {
+	require.Equal(t, "0899146b", hex.EncodeToString(lockSelector))
+	require.Equal(t, "c3c37fbc", hex.EncodeToString(claimSelector))
+	require.Equal(t, "35cd4ccb", hex.EncodeToString(refundSelector))
+	require.Equal(t, "15b4b8206809535e547317cd5cedc86cff6e7d203551f93701786ddaf14fd9f9", hex.EncodeToString(lockupEventTopic))
+}
+
+func TestEtherSwapLayout(t *testing.T) {
+	word := func(value string) string {
+		return strings.Repeat("0", 64-len(value)) + value
+	}
+	preimageHash := bytes.Repeat([]byte{0xab}, 32)
+	address, err := ParseAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	require.NoError(t, err)
+	encodedAddress := word("f39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+
+	data, err := EncodeLock(preimageHash, address, 100)
+	require.NoError(t, err)
+	require.Equal(t, "0899146b"+strings.Repeat("ab", 32)+encodedAddress+word("64"), hex.EncodeToString(data))
+
+	data, err = EncodeRefund(preimageHash, SatoshisToWei(1000), address, 100)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		"35cd4ccb"+strings.Repeat("ab", 32)+word("9184e72a000")+encodedAddress+word("64"),
+		hex.EncodeToString(data),
+	)
+}
+
+func TestAbiUint(t *testing.T) {
+	require.Equal(t, make([]byte, 32), abiUint(big.NewInt(0)))
+	require.Equal(t, append(make([]byte, 31), 0x01), abiUint(big.NewInt(1)))
+
+	maxUint := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	require.Equal(t, bytes.Repeat([]byte{0xff}, 32), abiUint(maxUint))
+
+	encoded := abiUint(new(big.Int).Lsh(big.NewInt(1), 255))
+	require.Len(t, encoded, 32)
+	require.Equal(t, byte(0x80), encoded[0])
+}
+
+func TestSatoshiConversion(t *testing.T) {
+	require.Equal(t, "10000000000", SatoshisToWei(1).String())
+	require.Equal(t, "21000000000000000000000000", SatoshisToWei(21e14).String())
+	require.Equal(t, uint64(21e14), WeiToSatoshis(SatoshisToWei(21e14)))
+
+	// fractions of a satoshi are rounded down
+	require.Equal(t, uint64(0), WeiToSatoshis(big.NewInt(1e10-1)))
+	require.Equal(t, uint64(1), WeiToSatoshis(big.NewInt(2e10-1)))
+	require.Equal(t, uint64(0), WeiToSatoshis(big.NewInt(0)))
+}
+
+func TestParseLockup(t *testing.T) {
+	preimageHash := strings.Repeat("ab", 32)
+	claimAddress := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+	refundAddress := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	word := func(value string) string {
+		return strings.Repeat("0", 64-len(value)) + value
+	}
+	amount := SatoshisToWei(12345)
+
+	lockup, err := ParseLockup(Log{
+		Topics: []string{
+			encodeData(lockupEventTopic),
+			"0x" + preimageHash,
+			"0x" + word(strings.ToLower(refundAddress[2:])),
+		},
+		Data:            "0x" + word(amount.Text(16)) + word(strings.ToLower(claimAddress[2:])) + word("7b"),
+		TransactionHash: "0x01",
+	})
+	require.NoError(t, err)
+	require.Equal(t, preimageHash, hex.EncodeToString(lockup.PreimageHash))
+	require.Equal(t, uint64(12345), lockup.Satoshis())
+	require.Equal(t, claimAddress, lockup.ClaimAddress.Hex())
+	require.Equal(t, refundAddress, lockup.RefundAddress.Hex())
+	require.Equal(t, uint32(123), lockup.Timelock)
+	require.Equal(t, "0x01", lockup.TransactionId)
+
+	_, err = ParseLockup(Log{Topics: []string{"0x00"}})
+	require.Error(t, err)
+}
@@ -0,0 +1,346 @@
+package rootstock
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/logger"
+	"github.com/BoltzExchange/boltz-client/onchain"
+)
+
+// blockPollInterval is how often the node is asked for new blocks; rootstock mines a block about every 30 seconds
+const blockPollInterval = 5 * time.Second
+
+// Client talks to the JSON-RPC interface of a rootstock node
+type Client struct {
+	url    string
+	http   *http.Client
+	nextId atomic.Uint64
+}
+
+type rpcRequest struct {
+	JsonRpc string `json:"jsonrpc"`
+	Id      uint64 `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+type Log struct {
+	Address         string   `json:"address"`
+	Topics          []string `json:"topics"`
+	Data            string   `json:"data"`
+	BlockNumber     string   `json:"blockNumber"`
+	TransactionHash string   `json:"transactionHash"`
+	Removed         bool     `json:"removed"`
+}
+
+type Receipt struct {
+	TransactionHash string `json:"transactionHash"`
+	BlockNumber     string `json:"blockNumber"`
+	GasUsed         string `json:"gasUsed"`
+	Status          string `json:"status"`
+	Logs            []Log  `json:"logs"`
+}
+
+type block struct {
+	Number    string `json:"number"`
+	Timestamp string `json:"timestamp"`
+}
+
+type callRequest struct {
+	From  string `json:"from,omitempty"`
+	To    string `json:"to"`
+	Value string `json:"value,omitempty"`
+	Data  string `json:"data,omitempty"`
+}
+
+type logFilter struct {
+	FromBlock string    `json:"fromBlock"`
+	ToBlock   string    `json:"toBlock"`
+	Address   string    `json:"address"`
+	Topics    []*string `json:"topics"`
+}
+
+func NewClient(url string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{url: url, http: httpClient}
+}
+
+func (client *Client) call(method string, result any, params ...any) error {
+	if params == nil {
+		params = []any{}
+	}
+	body, err := json.Marshal(rpcRequest{
+		JsonRpc: "2.0",
+		Id:      client.nextId.Add(1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := client.http.Post(client.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	var response rpcResponse
+	if err := json.NewDecoder(res.Body).Decode(&response); err != nil {
+		return fmt.Errorf("could not parse response of %s with status %d: %w", method, res.StatusCode, err)
+	}
+	if response.Error != nil {
+		return fmt.Errorf("%s failed: %s", method, response.Error.Message)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(response.Result, result)
+}
+
+func encodeUint(value uint64) string {
+	return "0x" + strconv.FormatUint(value, 16)
+}
+
+func encodeBigInt(value *big.Int) string {
+	return "0x" + value.Text(16)
+}
+
+func encodeData(data []byte) string {
+	return "0x" + hex.EncodeToString(data)
+}
+
+func decodeUint(value string) (uint64, error) {
+	return strconv.ParseUint(strings.TrimPrefix(value, "0x"), 16, 64)
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	result, ok := new(big.Int).SetString(strings.TrimPrefix(value, "0x"), 16)
+	if !ok {
+		return nil, fmt.Errorf("invalid quantity: %s", value)
+	}
+	return result, nil
+}
+
+func decodeData(value string) ([]byte, error) {
+	return hex.DecodeString(strings.TrimPrefix(value, "0x"))
+}
+
+func (client *Client) callUint(method string, params ...any) (uint64, error) {
+	var result string
+	if err := client.call(method, &result, params...); err != nil {
+		return 0, err
+	}
+	return decodeUint(result)
+}
+
+func (client *Client) callBigInt(method string, params ...any) (*big.Int, error) {
+	var result string
+	if err := client.call(method, &result, params...); err != nil {
+		return nil, err
+	}
+	return decodeBigInt(result)
+}
+
+func (client *Client) ChainId() (uint64, error) {
+	return client.callUint("eth_chainId")
+}
+
+func (client *Client) GetBlockHeight() (uint32, error) {
+	height, err := client.callUint("eth_blockNumber")
+	return uint32(height), err
+}
+
+func (client *Client) getBlock(height uint32) (*block, error) {
+	var result *block
+	if err := client.call("eth_getBlockByNumber", &result, encodeUint(uint64(height)), false); err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, fmt.Errorf("block %d not found", height)
+	}
+	return result, nil
+}
+
+// GetBalance returns the balance of the address in wei at the given block tag ("latest" or "pending")
+func (client *Client) GetBalance(address Address, tag string) (*big.Int, error) {
+	return client.callBigInt("eth_getBalance", address.Hex(), tag)
+}
+
+func (client *Client) GetNonce(address Address) (uint64, error) {
+	return client.callUint("eth_getTransactionCount", address.Hex(), "pending")
+}
+
+// GasPrice returns the current gas price in wei
+func (client *Client) GasPrice() (*big.Int, error) {
+	return client.callBigInt("eth_gasPrice")
+}
+
+func (client *Client) EstimateGas(from Address, to Address, value *big.Int, data []byte) (uint64, error) {
+	return client.callUint("eth_estimateGas", callRequest{
+		From:  from.Hex(),
+		To:    to.Hex(),
+		Value: encodeBigInt(value),
+		Data:  encodeData(data),
+	})
+}
+
+func (client *Client) Call(to Address, data []byte) ([]byte, error) {
+	var result string
+	if err := client.call("eth_call", &result, callRequest{To: to.Hex(), Data: encodeData(data)}, "latest"); err != nil {
+		return nil, err
+	}
+	return decodeData(result)
+}
+
+func (client *Client) GetLogs(contract Address, fromBlock uint32, topics ...[]byte) ([]Log, error) {
+	filter := logFilter{
+		FromBlock: encodeUint(uint64(fromBlock)),
+		ToBlock:   "latest",
+		Address:   contract.Hex(),
+	}
+	for _, topic := range topics {
+		if topic == nil {
+			filter.Topics = append(filter.Topics, nil)
+			continue
+		}
+		encoded := encodeData(topic)
+		filter.Topics = append(filter.Topics, &encoded)
+	}
+	var logs []Log
+	if err := client.call("eth_getLogs", &logs, filter); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+// GetReceipt returns nil if the transaction is not included in a block yet
+func (client *Client) GetReceipt(txId string) (*Receipt, error) {
+	var receipt *Receipt
+	if err := client.call("eth_getTransactionReceipt", &receipt, txId); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+func (client *Client) GetTxHex(txId string) (string, error) {
+	var result *string
+	if err := client.call("eth_getRawTransactionByHash", &result, txId); err != nil {
+		return "", err
+	}
+	if result == nil {
+		return "", onchain.ErrTransactionNotFound
+	}
+	return strings.TrimPrefix(*result, "0x"), nil
+}
+
+func (client *Client) BroadcastTransaction(txHex string) (string, error) {
+	var txId string
+	if err := client.call("eth_sendRawTransaction", &txId, "0x"+strings.TrimPrefix(txHex, "0x")); err != nil {
+		return "", err
+	}
+	return txId, nil
+}
+
+func (client *Client) IsTransactionConfirmed(txId string) (bool, error) {
+	receipt, err := client.GetReceipt(txId)
+	if err != nil {
+		return false, err
+	}
+	if receipt != nil {
+		return true, nil
+	}
+	var transaction *struct {
+		Hash string `json:"hash"`
+	}
+	if err := client.call("eth_getTransactionByHash", &transaction, txId); err != nil {
+		return false, err
+	}
+	if transaction == nil {
+		return false, onchain.ErrTransactionNotFound
+	}
+	return false, nil
+}
+
+// EstimateFee returns the gas price in gwei, since rootstock transactions are not priced per vbyte
+func (client *Client) EstimateFee(int32) (float64, error) {
+	gasPrice, err := client.GasPrice()
+	if err != nil {
+		return 0, err
+	}
+	gwei, _ := new(big.Float).Quo(new(big.Float).SetInt(gasPrice), big.NewFloat(1e9)).Float64()
+	return gwei, nil
+}
+
+func (client *Client) RegisterBlockListener(channel chan<- *onchain.BlockEpoch, stop <-chan bool) error {
+	ticker := time.NewTicker(blockPollInterval)
+	defer ticker.Stop()
+
+	var lastHeight uint32
+	for {
+		height, err := client.GetBlockHeight()
+		if err != nil {
+			logger.Warnf("Could not get rootstock block height: %v", err)
+		} else if height != lastHeight {
+			lastHeight = height
+			epoch := &onchain.BlockEpoch{Height: height}
+			if block, err := client.getBlock(height); err == nil {
+				if timestamp, err := decodeUint(block.Timestamp); err == nil {
+					epoch.Timestamp = time.Unix(int64(timestamp), 0)
+				}
+			}
+			select {
+			case channel <- epoch:
+			case <-stop:
+				return nil
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return nil
+		}
+	}
+}
+
+// WaitForReceipt polls the node until the transaction is included in a block
+func (client *Client) WaitForReceipt(txId string, timeout time.Duration) (*Receipt, error) {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		receipt, err := client.GetReceipt(txId)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			if receipt.Status != "0x1" {
+				return receipt, errors.New("transaction reverted: " + txId)
+			}
+			return receipt, nil
+		}
+		time.Sleep(time.Second)
+	}
+	return nil, fmt.Errorf("transaction %s was not included in a block after %s", txId, timeout)
+}
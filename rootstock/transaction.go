@@ -0,0 +1,57 @@
+package rootstock
+
+import (
+	"encoding/hex"
+	"math/big"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+)
+
+// Rootstock only supports legacy transactions, which are replay protected with the chain id as specified in EIP-155
+type Transaction struct {
+	Nonce    uint64
+	GasPrice *big.Int
+	Gas      uint64
+	// nil for contract creations
+	To    *Address
+	Value *big.Int
+	Data  []byte
+}
+
+func (tx *Transaction) fields() [][]byte {
+	var to []byte
+	if tx.To != nil {
+		to = tx.To[:]
+	}
+	return [][]byte{
+		rlpUint(tx.Nonce),
+		rlpBigInt(tx.GasPrice),
+		rlpUint(tx.Gas),
+		rlpBytes(to),
+		rlpBigInt(tx.Value),
+		rlpBytes(tx.Data),
+	}
+}
+
+// SigningHash is the hash which is signed to authorize the transaction on the chain with the given id
+func (tx *Transaction) SigningHash(chainId uint64) []byte {
+	fields := append(tx.fields(), rlpUint(chainId), rlpUint(0), rlpUint(0))
+	return keccak256(rlpList(fields...))
+}
+
+// Sign returns the serialized signed transaction and its hash
+func (tx *Transaction) Sign(key *btcec.PrivateKey, chainId uint64) ([]byte, string, error) {
+	// the first byte of a compact signature is 27 plus the recovery id
+	signature, err := ecdsa.SignCompact(key, tx.SigningHash(chainId), false)
+	if err != nil {
+		return nil, "", err
+	}
+	v := chainId*2 + 35 + uint64(signature[0]-27)
+	r := new(big.Int).SetBytes(signature[1:33])
+	s := new(big.Int).SetBytes(signature[33:65])
+
+	fields := append(tx.fields(), rlpUint(v), rlpBigInt(r), rlpBigInt(s))
+	raw := rlpList(fields...)
+	return raw, "0x" + hex.EncodeToString(keccak256(raw)), nil
+}
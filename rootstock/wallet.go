@@ -0,0 +1,211 @@
+package rootstock
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+const WalletName = "rootstock"
+
+// gas of a plain transfer of the native currency
+const transferGas = 21000
+
+type account struct {
+	key     *btcec.PrivateKey
+	address Address
+}
+
+// Wallet is an EVM account which holds RBTC and interacts with the EtherSwap contract
+type Wallet struct {
+	*Client
+
+	// nil until the wallet is unlocked
+	account   atomic.Pointer[account]
+	chainId   uint64
+	EtherSwap *EtherSwap
+
+	// nonces have to be assigned sequentially
+	sendLock sync.Mutex
+}
+
+// NewWallet creates a wallet on the chain of the client which uses the EtherSwap contract at etherSwap.
+// expectedChainId is the chain id boltz uses; a mismatch means the node is on a different network.
+// The wallet is not ready until its key is set with Unlock.
+func NewWallet(client *Client, etherSwap Address, expectedChainId uint64) (*Wallet, error) {
+	chainId, err := client.ChainId()
+	if err != nil {
+		return nil, fmt.Errorf("could not get chain id: %w", err)
+	}
+	if expectedChainId != 0 && chainId != expectedChainId {
+		return nil, fmt.Errorf("rootstock node is on chain %d but boltz uses chain %d", chainId, expectedChainId)
+	}
+	return &Wallet{
+		Client:    client,
+		chainId:   chainId,
+		EtherSwap: NewEtherSwap(client, etherSwap),
+	}, nil
+}
+
+// Unlock sets the private key of the wallet, which is stored in the database with the swap secrets
+func (wallet *Wallet) Unlock(key *btcec.PrivateKey) {
+	wallet.account.Store(&account{key: key, address: AddressFromKey(key.PubKey())})
+}
+
+func (wallet *Wallet) getAccount() (*account, error) {
+	if account := wallet.account.Load(); account != nil {
+		return account, nil
+	}
+	return nil, errors.New("rootstock wallet is locked")
+}
+
+// Address returns the address of the wallet or the zero address if it is locked
+func (wallet *Wallet) Address() Address {
+	if account := wallet.account.Load(); account != nil {
+		return account.address
+	}
+	return Address{}
+}
+
+func (wallet *Wallet) NewAddress() (string, error) {
+	account, err := wallet.getAccount()
+	if err != nil {
+		return "", err
+	}
+	return account.address.Hex(), nil
+}
+
+func (wallet *Wallet) Ready() bool {
+	return wallet.account.Load() != nil
+}
+
+func (wallet *Wallet) Readonly() bool {
+	return false
+}
+
+func (wallet *Wallet) Name() string {
+	return WalletName
+}
+
+func (wallet *Wallet) Currency() boltz.Currency {
+	return boltz.CurrencyRootstock
+}
+
+func (wallet *Wallet) GetBalance() (*onchain.Balance, error) {
+	account, err := wallet.getAccount()
+	if err != nil {
+		return nil, err
+	}
+	confirmed, err := wallet.Client.GetBalance(account.address, "latest")
+	if err != nil {
+		return nil, err
+	}
+	total, err := wallet.Client.GetBalance(account.address, "pending")
+	if err != nil {
+		return nil, err
+	}
+	balance := &onchain.Balance{
+		Total:     WeiToSatoshis(total),
+		Confirmed: WeiToSatoshis(confirmed),
+	}
+	if balance.Total > balance.Confirmed {
+		balance.Unconfirmed = balance.Total - balance.Confirmed
+	}
+	return balance, nil
+}
+
+// send signs and broadcasts a transaction to the given address and returns its id and the maximal fee in satoshis
+func (wallet *Wallet) send(to Address, value *big.Int, data []byte) (string, uint64, error) {
+	account, err := wallet.getAccount()
+	if err != nil {
+		return "", 0, err
+	}
+
+	wallet.sendLock.Lock()
+	defer wallet.sendLock.Unlock()
+
+	gasPrice, err := wallet.GasPrice()
+	if err != nil {
+		return "", 0, fmt.Errorf("could not get gas price: %w", err)
+	}
+	gas := uint64(transferGas)
+	if len(data) > 0 {
+		gas, err = wallet.EstimateGas(account.address, to, value, data)
+		if err != nil {
+			return "", 0, fmt.Errorf("could not estimate gas: %w", err)
+		}
+	}
+	nonce, err := wallet.GetNonce(account.address)
+	if err != nil {
+		return "", 0, fmt.Errorf("could not get nonce: %w", err)
+	}
+
+	tx := &Transaction{
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+		Gas:      gas,
+		To:       &to,
+		Value:    value,
+		Data:     data,
+	}
+	raw, txId, err := tx.Sign(account.key, wallet.chainId)
+	if err != nil {
+		return "", 0, fmt.Errorf("could not sign transaction: %w", err)
+	}
+	if _, err := wallet.BroadcastTransaction(hex.EncodeToString(raw)); err != nil {
+		return "", 0, err
+	}
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gas))
+	return txId, WeiToSatoshis(fee), nil
+}
+
+// SendToAddress sends the amount of satoshis to address; the fee is determined by the gas price of the node
+func (wallet *Wallet) SendToAddress(address string, amount uint64, _ float64) (string, error) {
+	to, err := ParseAddress(address)
+	if err != nil {
+		return "", err
+	}
+	txId, _, err := wallet.send(to, SatoshisToWei(amount), nil)
+	return txId, err
+}
+
+// Lock locks amount satoshis in the EtherSwap contract, which can be claimed by claimAddress with the preimage
+// or refunded after the timelock
+func (wallet *Wallet) Lock(preimageHash []byte, amount uint64, claimAddress Address, timelock uint32) (string, uint64, error) {
+	data, err := EncodeLock(preimageHash, claimAddress, timelock)
+	if err != nil {
+		return "", 0, err
+	}
+	return wallet.send(wallet.EtherSwap.Address, SatoshisToWei(amount), data)
+}
+
+// Claim claims a lockup of which the wallet is the claim address
+func (wallet *Wallet) Claim(lockup *Lockup, preimage []byte) (string, uint64, error) {
+	if lockup.ClaimAddress != wallet.Address() {
+		return "", 0, fmt.Errorf("lockup can only be claimed by %s", lockup.ClaimAddress)
+	}
+	data, err := EncodeClaim(preimage, lockup.Amount, lockup.RefundAddress, lockup.Timelock)
+	if err != nil {
+		return "", 0, err
+	}
+	return wallet.send(wallet.EtherSwap.Address, big.NewInt(0), data)
+}
+
+// Refund refunds a lockup of which the wallet is the refund address
+func (wallet *Wallet) Refund(lockup *Lockup) (string, uint64, error) {
+	if lockup.RefundAddress != wallet.Address() {
+		return "", 0, fmt.Errorf("lockup can only be refunded by %s", lockup.RefundAddress)
+	}
+	data, err := EncodeRefund(lockup.PreimageHash, lockup.Amount, lockup.ClaimAddress, lockup.Timelock)
+	if err != nil {
+		return "", 0, err
+	}
+	return wallet.send(wallet.EtherSwap.Address, big.NewInt(0), data)
+}
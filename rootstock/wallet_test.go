@@ -0,0 +1,107 @@
+//go:build !unit
+
+package rootstock
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
+
+const anvilUrl = "http://localhost:8545"
+const boltzUrl = "http://localhost:9001"
+
+// third development account of anvil, which is funded on startup and not used by boltz
+const anvilKey = "5de4111afa1a4b94908f83103eb1f1706367c2e68ca870fc3fb9a804cdab365a"
+
+const receiptTimeout = 30 * time.Second
+
+func testWallet(t *testing.T) *Wallet {
+	contracts, err := (&boltz.Boltz{URL: boltzUrl}).GetContracts(boltz.CurrencyRootstock)
+	require.NoError(t, err)
+	etherSwap, err := ParseAddress(contracts.SwapContracts.EtherSwap)
+	require.NoError(t, err)
+
+	wallet, err := NewWallet(NewClient(anvilUrl, nil), etherSwap, contracts.Network.ChainId)
+	require.NoError(t, err)
+	require.False(t, wallet.Ready())
+	wallet.Unlock(parseKey(t, anvilKey))
+	return wallet
+}
+
+func randomAddress(t *testing.T) Address {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	return AddressFromKey(key.PubKey())
+}
+
+func TestBlockListener(t *testing.T) {
+	wallet := testWallet(t)
+
+	blocks := make(chan *onchain.BlockEpoch)
+	stop := make(chan bool)
+	go func() {
+		require.NoError(t, wallet.RegisterBlockListener(blocks, stop))
+	}()
+	block := <-blocks
+	close(stop)
+
+	height, err := wallet.GetBlockHeight()
+	require.NoError(t, err)
+	require.LessOrEqual(t, block.Height, height)
+}
+
+func TestSendToAddress(t *testing.T) {
+	wallet := testWallet(t)
+	receiver := randomAddress(t)
+
+	txId, err := wallet.SendToAddress(receiver.Hex(), 1000, 0)
+	require.NoError(t, err)
+	_, err = wallet.WaitForReceipt(txId, receiptTimeout)
+	require.NoError(t, err)
+
+	confirmed, err := wallet.IsTransactionConfirmed(txId)
+	require.NoError(t, err)
+	require.True(t, confirmed)
+
+	balance, err := wallet.Client.GetBalance(receiver, "latest")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), WeiToSatoshis(balance))
+}
+
+func TestLockAndRefund(t *testing.T) {
+	wallet := testWallet(t)
+
+	preimageHash := make([]byte, 32)
+	_, err := rand.Read(preimageHash)
+	require.NoError(t, err)
+
+	// a timelock in the past makes the lockup refundable right away
+	height, err := wallet.GetBlockHeight()
+	require.NoError(t, err)
+
+	lockupTxId, _, err := wallet.Lock(preimageHash, 10000, randomAddress(t), height)
+	require.NoError(t, err)
+	_, err = wallet.WaitForReceipt(lockupTxId, receiptTimeout)
+	require.NoError(t, err)
+
+	lockup, err := wallet.EtherSwap.FindLockup(preimageHash, height)
+	require.NoError(t, err)
+	require.Equal(t, lockupTxId, lockup.TransactionId)
+	require.Equal(t, uint64(10000), lockup.Satoshis())
+	require.Equal(t, wallet.Address(), lockup.RefundAddress)
+
+	refundTxId, fee, err := wallet.Refund(lockup)
+	require.NoError(t, err)
+	require.NotZero(t, fee)
+	_, err = wallet.WaitForReceipt(refundTxId, receiptTimeout)
+	require.NoError(t, err)
+
+	_, _, err = wallet.Claim(lockup, preimageHash)
+	require.Error(t, err)
+}
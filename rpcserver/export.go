@@ -0,0 +1,338 @@
+package rpcserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// exportedSwap is the flat representation of a swap of any type which is written into all export formats.
+// The amount is the one of the invoice for submarine and reverse swaps and the one we lock up for chain swaps.
+type exportedSwap struct {
+	Id                  string    `json:"id"`
+	Type                string    `json:"type"`
+	State               string    `json:"state"`
+	From                string    `json:"from"`
+	To                  string    `json:"to"`
+	CreatedAt           time.Time `json:"created_at"`
+	IsAuto              bool      `json:"is_auto"`
+	Amount              uint64    `json:"amount"`
+	ServiceFee          uint64    `json:"service_fee"`
+	OnchainFee          uint64    `json:"onchain_fee"`
+	RoutingFeeMsat      uint64    `json:"routing_fee_msat"`
+	LockupTransactionId string    `json:"lockup_transaction_id"`
+	ClaimTransactionId  string    `json:"claim_transaction_id"`
+	RefundTransactionId string    `json:"refund_transaction_id"`
+}
+
+var exportTypes = map[boltzrpc.SwapType]string{
+	boltzrpc.SwapType_SUBMARINE: "submarine",
+	boltzrpc.SwapType_REVERSE:   "reverse",
+	boltzrpc.SwapType_CHAIN:     "chain",
+}
+
+var exportCsvHeader = []string{
+	"created_at", "id", "type", "state", "from", "to", "is_auto", "amount", "service_fee", "onchain_fee", "routing_fee_msat",
+	"lockup_transaction_id", "claim_transaction_id", "refund_transaction_id",
+}
+
+const (
+	lightningAccount  = "Assets:Boltz:Lightning"
+	serviceFeeAccount = "Expenses:Boltz:ServiceFee"
+	onchainFeeAccount = "Expenses:Boltz:OnchainFee"
+	routingFeeAccount = "Expenses:Boltz:RoutingFee"
+	// all currencies are pegged to bitcoin, so they are booked in the same commodity to keep the transactions balanced
+	exportCommodity = "BTC"
+)
+
+func onchainAccount(currency string) string {
+	return "Assets:Boltz:" + currency
+}
+
+type posting struct {
+	account string
+	// millisatoshis, to be able to book routing fees
+	amount int64
+}
+
+// postings returns the balanced legs of a swap; only swaps which moved funds are booked
+func (swap *exportedSwap) postings() []posting {
+	serviceFee := int64(swap.ServiceFee) * 1000
+	onchainFee := int64(swap.OnchainFee) * 1000
+	routingFee := int64(swap.RoutingFeeMsat)
+	amount := int64(swap.Amount) * 1000
+
+	fees := []posting{{serviceFeeAccount, serviceFee}, {onchainFeeAccount, onchainFee}}
+
+	var result []posting
+	switch swap.State {
+	case boltzrpc.SwapState_SUCCESSFUL.String():
+		switch swap.Type {
+		case exportTypes[boltzrpc.SwapType_SUBMARINE]:
+			result = append([]posting{{lightningAccount, amount}}, fees...)
+			result = append(result, posting{onchainAccount(swap.From), -(amount + serviceFee + onchainFee)})
+		case exportTypes[boltzrpc.SwapType_REVERSE]:
+			result = append([]posting{{lightningAccount, -(amount + routingFee)}, {routingFeeAccount, routingFee}}, fees...)
+			result = append(result, posting{onchainAccount(swap.To), amount - serviceFee - onchainFee})
+		case exportTypes[boltzrpc.SwapType_CHAIN]:
+			result = append([]posting{{onchainAccount(swap.From), -amount}}, fees...)
+			result = append(result, posting{onchainAccount(swap.To), amount - serviceFee - onchainFee})
+		}
+	case boltzrpc.SwapState_REFUNDED.String():
+		// the locked funds came back, only the fees of the lockup and refund transactions are lost
+		result = []posting{{onchainFeeAccount, onchainFee}, {onchainAccount(swap.From), -onchainFee}}
+	}
+
+	var nonZero []posting
+	for _, posting := range result {
+		if posting.amount != 0 {
+			nonZero = append(nonZero, posting)
+		}
+	}
+	return nonZero
+}
+
+// formatMsat formats millisatoshis as bitcoin with at least 8 decimals
+func formatMsat(msat int64) string {
+	sign := ""
+	if msat < 0 {
+		sign = "-"
+		msat = -msat
+	}
+	fraction := strings.TrimRight(fmt.Sprintf("%011d", msat%1e11), "0")
+	for len(fraction) < 8 {
+		fraction += "0"
+	}
+	return fmt.Sprintf("%s%d.%s", sign, msat/1e11, fraction)
+}
+
+func exportCsv(swaps []exportedSwap) (string, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if err := writer.Write(exportCsvHeader); err != nil {
+		return "", err
+	}
+	for _, swap := range swaps {
+		record := []string{
+			swap.CreatedAt.UTC().Format(time.RFC3339),
+			swap.Id,
+			swap.Type,
+			swap.State,
+			swap.From,
+			swap.To,
+			strconv.FormatBool(swap.IsAuto),
+			strconv.FormatUint(swap.Amount, 10),
+			strconv.FormatUint(swap.ServiceFee, 10),
+			strconv.FormatUint(swap.OnchainFee, 10),
+			strconv.FormatUint(swap.RoutingFeeMsat, 10),
+			swap.LockupTransactionId,
+			swap.ClaimTransactionId,
+			swap.RefundTransactionId,
+		}
+		if err := writer.Write(record); err != nil {
+			return "", err
+		}
+	}
+	writer.Flush()
+	return buffer.String(), writer.Error()
+}
+
+func exportJsonLines(swaps []exportedSwap) (string, error) {
+	var builder strings.Builder
+	for _, swap := range swaps {
+		encoded, err := json.Marshal(swap)
+		if err != nil {
+			return "", err
+		}
+		builder.Write(encoded)
+		builder.WriteString("\n")
+	}
+	return builder.String(), nil
+}
+
+func exportBeancount(swaps []exportedSwap) string {
+	var builder strings.Builder
+	opened := make(map[string]bool)
+	var transactions strings.Builder
+	for _, swap := range swaps {
+		postings := swap.postings()
+		if len(postings) == 0 {
+			continue
+		}
+		date := swap.CreatedAt.UTC().Format(time.DateOnly)
+		for _, posting := range postings {
+			if !opened[posting.account] {
+				opened[posting.account] = true
+				builder.WriteString(fmt.Sprintf("%s open %s %s\n", date, posting.account, exportCommodity))
+			}
+		}
+		transactions.WriteString(fmt.Sprintf("\n%s * \"Boltz\" \"%s swap %s\"\n", date, swap.Type, swap.Id))
+		transactions.WriteString(fmt.Sprintf("  swap_id: \"%s\"\n", swap.Id))
+		for _, posting := range postings {
+			transactions.WriteString(fmt.Sprintf("  %-30s %s %s\n", posting.account, formatMsat(posting.amount), exportCommodity))
+		}
+	}
+	builder.WriteString(transactions.String())
+	return builder.String()
+}
+
+func exportLedger(swaps []exportedSwap) string {
+	var builder strings.Builder
+	for _, swap := range swaps {
+		postings := swap.postings()
+		if len(postings) == 0 {
+			continue
+		}
+		builder.WriteString(fmt.Sprintf("%s * Boltz %s swap %s\n", swap.CreatedAt.UTC().Format("2006/01/02"), swap.Type, swap.Id))
+		builder.WriteString(fmt.Sprintf("    ; swap_id: %s\n", swap.Id))
+		for _, posting := range postings {
+			builder.WriteString(fmt.Sprintf("    %-30s  %s %s\n", posting.account, formatMsat(posting.amount), exportCommodity))
+		}
+		builder.WriteString("\n")
+	}
+	return builder.String()
+}
+
+// invoiceAmount returns the amount of an invoice in satoshis, or 0 if it can't be decoded
+func (server *routedBoltzServer) invoiceAmount(invoice string) uint64 {
+	if invoice == "" {
+		return 0
+	}
+	decoded, err := zpay32.Decode(invoice, server.network.Btc)
+	if err != nil || decoded.MilliSat == nil {
+		return 0
+	}
+	return uint64(decoded.MilliSat.ToSatoshis())
+}
+
+func optionalFee(fee *uint64) uint64 {
+	if fee == nil {
+		return 0
+	}
+	return *fee
+}
+
+func (server *routedBoltzServer) exportedSwaps(args database.SwapQuery) ([]exportedSwap, error) {
+	var result []exportedSwap
+
+	swaps, err := server.database.QuerySwaps(args)
+	if err != nil {
+		return nil, err
+	}
+	for _, swap := range swaps {
+		result = append(result, exportedSwap{
+			Id:                  swap.Id,
+			Type:                exportTypes[boltzrpc.SwapType_SUBMARINE],
+			State:               swap.State.String(),
+			From:                string(swap.Pair.From),
+			To:                  string(swap.Pair.To),
+			CreatedAt:           swap.CreatedAt,
+			IsAuto:              swap.IsAuto,
+			Amount:              server.invoiceAmount(swap.Invoice),
+			ServiceFee:          optionalFee(swap.ServiceFee),
+			OnchainFee:          optionalFee(swap.OnchainFee),
+			LockupTransactionId: swap.LockupTransactionId,
+			RefundTransactionId: swap.RefundTransactionId,
+		})
+	}
+
+	reverseSwaps, err := server.database.QueryReverseSwaps(args)
+	if err != nil {
+		return nil, err
+	}
+	for _, reverseSwap := range reverseSwaps {
+		result = append(result, exportedSwap{
+			Id:                  reverseSwap.Id,
+			Type:                exportTypes[boltzrpc.SwapType_REVERSE],
+			State:               reverseSwap.State.String(),
+			From:                string(reverseSwap.Pair.From),
+			To:                  string(reverseSwap.Pair.To),
+			CreatedAt:           reverseSwap.CreatedAt,
+			IsAuto:              reverseSwap.IsAuto,
+			Amount:              server.invoiceAmount(reverseSwap.Invoice),
+			ServiceFee:          optionalFee(reverseSwap.ServiceFee),
+			OnchainFee:          optionalFee(reverseSwap.OnchainFee),
+			RoutingFeeMsat:      optionalFee(reverseSwap.RoutingFeeMsat),
+			LockupTransactionId: reverseSwap.LockupTransactionId,
+			ClaimTransactionId:  reverseSwap.ClaimTransactionId,
+		})
+	}
+
+	chainSwaps, err := server.database.QueryChainSwaps(args)
+	if err != nil {
+		return nil, err
+	}
+	for _, chainSwap := range chainSwaps {
+		exported := exportedSwap{
+			Id:         chainSwap.Id,
+			Type:       exportTypes[boltzrpc.SwapType_CHAIN],
+			State:      chainSwap.State.String(),
+			From:       string(chainSwap.Pair.From),
+			To:         string(chainSwap.Pair.To),
+			CreatedAt:  chainSwap.CreatedAt,
+			IsAuto:     chainSwap.IsAuto,
+			ServiceFee: optionalFee(chainSwap.ServiceFee),
+			OnchainFee: optionalFee(chainSwap.OnchainFee),
+		}
+		if chainSwap.FromData != nil {
+			exported.Amount = chainSwap.FromData.Amount
+			exported.LockupTransactionId = chainSwap.FromData.LockupTransactionId
+			exported.RefundTransactionId = chainSwap.FromData.TransactionId
+		}
+		if chainSwap.ToData != nil {
+			exported.ClaimTransactionId = chainSwap.ToData.TransactionId
+		}
+		result = append(result, exported)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].Id < result[j].Id
+	})
+	return result, nil
+}
+
+func (server *routedBoltzServer) ExportSwaps(_ context.Context, request *boltzrpc.ExportSwapsRequest) (*boltzrpc.ExportSwapsResponse, error) {
+	var args database.SwapQuery
+	if request.Since != nil {
+		args.Since = time.Unix(request.GetSince(), 0)
+	}
+	if request.Until != nil {
+		args.Until = time.Unix(request.GetUntil(), 0)
+	}
+
+	swaps, err := server.exportedSwaps(args)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	response := &boltzrpc.ExportSwapsResponse{}
+	switch request.Format {
+	case boltzrpc.ExportFormat_CSV:
+		response.Content, err = exportCsv(swaps)
+	case boltzrpc.ExportFormat_JSON_LINES:
+		response.Content, err = exportJsonLines(swaps)
+	case boltzrpc.ExportFormat_BEANCOUNT:
+		response.Content = exportBeancount(swaps)
+	case boltzrpc.ExportFormat_LEDGER:
+		response.Content = exportLedger(swaps)
+	default:
+		err = fmt.Errorf("unknown export format: %v", request.Format)
+	}
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return response, nil
+}
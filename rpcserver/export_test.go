@@ -0,0 +1,121 @@
+package rpcserver
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/stretchr/testify/require"
+)
+
+var testExportedSwaps = []exportedSwap{
+	{
+		Id:                  "submarine",
+		Type:                exportTypes[boltzrpc.SwapType_SUBMARINE],
+		State:               boltzrpc.SwapState_SUCCESSFUL.String(),
+		From:                "LBTC",
+		To:                  "BTC",
+		CreatedAt:           time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		Amount:              100000,
+		ServiceFee:          100,
+		OnchainFee:          50,
+		LockupTransactionId: "lockup",
+	},
+	{
+		Id:                 "reverse",
+		Type:               exportTypes[boltzrpc.SwapType_REVERSE],
+		State:              boltzrpc.SwapState_SUCCESSFUL.String(),
+		From:               "BTC",
+		To:                 "BTC",
+		CreatedAt:          time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		Amount:             200000,
+		ServiceFee:         500,
+		OnchainFee:         300,
+		RoutingFeeMsat:     1500,
+		ClaimTransactionId: "claim",
+	},
+	{
+		Id:         "refunded",
+		Type:       exportTypes[boltzrpc.SwapType_CHAIN],
+		State:      boltzrpc.SwapState_REFUNDED.String(),
+		From:       "BTC",
+		To:         "LBTC",
+		CreatedAt:  time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC),
+		Amount:     300000,
+		OnchainFee: 400,
+	},
+	{
+		Id:        "pending",
+		Type:      exportTypes[boltzrpc.SwapType_CHAIN],
+		State:     boltzrpc.SwapState_PENDING.String(),
+		From:      "BTC",
+		To:        "LBTC",
+		CreatedAt: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
+		Amount:    300000,
+	},
+}
+
+func TestExportPostings(t *testing.T) {
+	for _, swap := range testExportedSwaps {
+		var sum int64
+		for _, posting := range swap.postings() {
+			sum += posting.amount
+		}
+		require.Zero(t, sum, swap.Id)
+	}
+
+	require.Equal(t, []posting{
+		{lightningAccount, -200001500},
+		{routingFeeAccount, 1500},
+		{serviceFeeAccount, 500000},
+		{onchainFeeAccount, 300000},
+		{onchainAccount("BTC"), 199200000},
+	}, testExportedSwaps[1].postings())
+	require.Empty(t, testExportedSwaps[3].postings())
+}
+
+func TestFormatMsat(t *testing.T) {
+	require.Equal(t, "0.00100000", formatMsat(100000000))
+	require.Equal(t, "-0.000000015", formatMsat(-1500))
+	require.Equal(t, "21.00000000", formatMsat(21e11))
+}
+
+func TestExportFormats(t *testing.T) {
+	t.Run("Csv", func(t *testing.T) {
+		content, err := exportCsv(testExportedSwaps)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(content), "\n")
+		require.Len(t, lines, len(testExportedSwaps)+1)
+		require.Equal(t, strings.Join(exportCsvHeader, ","), lines[0])
+		require.Equal(t, "2024-01-02T03:04:05Z,submarine,submarine,SUCCESSFUL,LBTC,BTC,false,100000,100,50,0,lockup,,", lines[1])
+	})
+
+	t.Run("JsonLines", func(t *testing.T) {
+		content, err := exportJsonLines(testExportedSwaps)
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(content), "\n")
+		require.Len(t, lines, len(testExportedSwaps))
+		var decoded exportedSwap
+		require.NoError(t, json.Unmarshal([]byte(lines[1]), &decoded))
+		require.Equal(t, testExportedSwaps[1], decoded)
+	})
+
+	t.Run("Beancount", func(t *testing.T) {
+		content := exportBeancount(testExportedSwaps)
+		require.Contains(t, content, "2024-01-02 open Assets:Boltz:LBTC BTC\n")
+		require.Contains(t, content, "2024-01-03 * \"Boltz\" \"reverse swap reverse\"\n")
+		require.Contains(t, content, "Expenses:Boltz:RoutingFee")
+		require.NotContains(t, content, "pending")
+		// accounts are opened before they are used
+		require.Less(t, strings.Index(content, "open Expenses:Boltz:RoutingFee"), strings.Index(content, "* \"Boltz\""))
+	})
+
+	t.Run("Ledger", func(t *testing.T) {
+		content := exportLedger(testExportedSwaps)
+		require.Contains(t, content, "2024/01/04 * Boltz chain swap refunded\n")
+		require.Contains(t, content, "-0.00000400 BTC")
+		require.NotContains(t, content, "pending")
+	})
+}
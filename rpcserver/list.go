@@ -0,0 +1,74 @@
+package rpcserver
+
+import (
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/database"
+)
+
+// encodeSwapCursor returns an opaque cursor which is URL safe, so that it can be passed as query parameter to the REST API
+func encodeSwapCursor(cursor database.SwapCursor) string {
+	raw := strconv.FormatInt(cursor.CreatedAt.Unix(), 10) + ":" + cursor.Id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeSwapCursor(encoded string) (*database.SwapCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	createdAt, id, found := strings.Cut(string(raw), ":")
+	if !found || id == "" {
+		return nil, errors.New("missing swap id")
+	}
+	timestamp, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &database.SwapCursor{CreatedAt: time.Unix(timestamp, 0), Id: id}, nil
+}
+
+type listedSwap struct {
+	cursor database.SwapCursor
+	add    func()
+}
+
+// swapPage merges the swaps of all types in the order the database returned each of them in
+type swapPage struct {
+	descending bool
+	swaps      []listedSwap
+}
+
+func (page *swapPage) add(createdAt time.Time, id string, add func()) {
+	page.swaps = append(page.swaps, listedSwap{cursor: database.SwapCursor{CreatedAt: createdAt, Id: id}, add: add})
+}
+
+// finish adds at most limit swaps to the response and returns the cursor of the next page if there are more swaps
+func (page *swapPage) finish(limit uint64) *string {
+	sort.SliceStable(page.swaps, func(i, j int) bool {
+		a, b := page.swaps[i].cursor, page.swaps[j].cursor
+		if page.descending {
+			a, b = b, a
+		}
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.Id < b.Id
+	})
+
+	var next *string
+	if limit != 0 && uint64(len(page.swaps)) > limit {
+		page.swaps = page.swaps[:limit]
+		cursor := encodeSwapCursor(page.swaps[limit-1].cursor)
+		next = &cursor
+	}
+	for _, swap := range page.swaps {
+		swap.add()
+	}
+	return next
+}
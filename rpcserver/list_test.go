@@ -0,0 +1,51 @@
+package rpcserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwapCursor(t *testing.T) {
+	cursor := database.SwapCursor{CreatedAt: time.Unix(1700000000, 0), Id: "with:colon"}
+	decoded, err := decodeSwapCursor(encodeSwapCursor(cursor))
+	require.NoError(t, err)
+	require.Equal(t, cursor, *decoded)
+
+	_, err = decodeSwapCursor("invalid")
+	require.Error(t, err)
+}
+
+func TestSwapPage(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	for _, descending := range []bool{false, true} {
+		var added []string
+		page := &swapPage{descending: descending}
+		add := func(createdAt time.Time, id string) {
+			page.add(createdAt, id, func() { added = append(added, id) })
+		}
+		// the swaps of each type are already sorted by the database
+		add(start, "a")
+		add(start.Add(time.Minute), "c")
+		add(start.Add(time.Minute), "b")
+		add(start.Add(2*time.Minute), "d")
+
+		next := page.finish(3)
+		require.NotNil(t, next)
+		cursor, err := decodeSwapCursor(*next)
+		require.NoError(t, err)
+		if descending {
+			require.Equal(t, []string{"d", "c", "b"}, added)
+			require.Equal(t, "b", cursor.Id)
+		} else {
+			require.Equal(t, []string{"a", "b", "c"}, added)
+			require.Equal(t, "c", cursor.Id)
+		}
+	}
+
+	page := &swapPage{}
+	page.add(start, "a", func() {})
+	require.Nil(t, page.finish(1))
+}
@@ -0,0 +1,148 @@
+package rpcserver
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// how long the pairs of boltz are used before they are fetched again
+const pairCacheExpiry = 30 * time.Second
+
+type pairCache[T any] struct {
+	fetch     func() (map[boltz.Currency]map[boltz.Currency]T, error)
+	hash      func(*T) string
+	serialize func(boltz.Pair, *T) proto.Message
+
+	lock      sync.Mutex
+	pairs     map[boltz.Currency]map[boltz.Currency]T
+	fetchedAt time.Time
+}
+
+type pairCaches struct {
+	submarine *pairCache[boltz.SubmarinePair]
+	reverse   *pairCache[boltz.ReversePair]
+	chain     *pairCache[boltz.ChainPair]
+}
+
+func newPairCaches(boltzApi *boltz.Boltz) pairCaches {
+	return pairCaches{
+		submarine: &pairCache[boltz.SubmarinePair]{
+			fetch: func() (map[boltz.Currency]map[boltz.Currency]boltz.SubmarinePair, error) {
+				return boltzApi.GetSubmarinePairs()
+			},
+			hash: func(pair *boltz.SubmarinePair) string { return pair.Hash },
+			serialize: func(pair boltz.Pair, submarinePair *boltz.SubmarinePair) proto.Message {
+				return serializeSubmarinePair(pair, submarinePair)
+			},
+		},
+		reverse: &pairCache[boltz.ReversePair]{
+			fetch: func() (map[boltz.Currency]map[boltz.Currency]boltz.ReversePair, error) {
+				return boltzApi.GetReversePairs()
+			},
+			hash: func(pair *boltz.ReversePair) string { return pair.Hash },
+			serialize: func(pair boltz.Pair, reversePair *boltz.ReversePair) proto.Message {
+				return serializeReversePair(pair, reversePair)
+			},
+		},
+		chain: &pairCache[boltz.ChainPair]{
+			fetch: func() (map[boltz.Currency]map[boltz.Currency]boltz.ChainPair, error) {
+				return boltzApi.GetChainPairs()
+			},
+			hash: func(pair *boltz.ChainPair) string { return pair.Hash },
+			serialize: func(pair boltz.Pair, chainPair *boltz.ChainPair) proto.Message {
+				return serializeChainPair(pair, chainPair)
+			},
+		},
+	}
+}
+
+// get returns all pairs, which are only fetched from boltz again once they expired or if refresh is set
+func (cache *pairCache[T]) get(refresh bool) (map[boltz.Currency]map[boltz.Currency]T, error) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	if refresh || cache.pairs == nil || time.Since(cache.fetchedAt) > pairCacheExpiry {
+		fetched, err := cache.fetch()
+		if err != nil {
+			return nil, err
+		}
+		cache.pairs = fetched
+		cache.fetchedAt = time.Now()
+	}
+	return cache.pairs, nil
+}
+
+// find returns a single pair. If its hash does not match the one the client expects, the pairs are fetched again
+// and a fees changed error is returned if the hash still differs.
+func (cache *pairCache[T]) find(pair boltz.Pair, refresh bool, expectedHash string) (*T, error) {
+	all, err := cache.get(refresh)
+	if err != nil {
+		return nil, err
+	}
+	result, err := findPair(pair, all)
+	if err != nil {
+		return nil, err
+	}
+	if expectedHash == "" || cache.hash(result) == expectedHash {
+		return result, nil
+	}
+	if !refresh {
+		return cache.find(pair, true, expectedHash)
+	}
+	return nil, newFeesChangedError(
+		cache.serialize(pair, result),
+		"expected pair hash %s, but the current one is %s", expectedHash, cache.hash(result),
+	)
+}
+
+// feesChangedError fetches the pairs again after boltz rejected a swap because of its pair hash
+// and returns an error with the current fees
+func (cache *pairCache[T]) feesChangedError(pair boltz.Pair) error {
+	all, err := cache.get(true)
+	if err != nil {
+		return err
+	}
+	result, err := findPair(pair, all)
+	if err != nil {
+		return err
+	}
+	return newFeesChangedError(cache.serialize(pair, result), "current pair hash is %s", cache.hash(result))
+}
+
+// newFeesChangedError returns a FailedPrecondition status with the current pair as its detail,
+// so that clients can show the new fees without fetching them again
+func newFeesChangedError(pair proto.Message, format string, args ...any) error {
+	result := status.Newf(codes.FailedPrecondition, "fees changed: "+format, args...)
+	if withDetails, err := result.WithDetails(pair); err == nil {
+		result = withDetails
+	}
+	return result.Err()
+}
+
+// create creates a swap with the given pair. When boltz rejects its hash and refetch is set, which is the case when
+// the client did not pin a hash, the swap is created once more with the current pair if it passes check.
+// Otherwise, an error with the current fees is returned.
+func (cache *pairCache[T]) create(pair boltz.Pair, current *T, refetch bool, check func(*T) error, create func(*T) error) error {
+	err := create(current)
+	if !errors.Is(err, boltz.ErrInvalidPairHash) {
+		return err
+	}
+	if refetch {
+		if current, err = cache.find(pair, true, ""); err != nil {
+			return err
+		}
+		if err := check(current); err != nil {
+			return err
+		}
+		if err := create(current); !errors.Is(err, boltz.ErrInvalidPairHash) {
+			return err
+		}
+	}
+	return cache.feesChangedError(pair)
+}
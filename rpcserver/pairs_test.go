@@ -0,0 +1,155 @@
+package rpcserver
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPairCache(t *testing.T) {
+	hash := "first"
+	fetches := 0
+	cache := &pairCache[boltz.ReversePair]{
+		fetch: func() (map[boltz.Currency]map[boltz.Currency]boltz.ReversePair, error) {
+			fetches++
+			pair := boltz.ReversePair{Hash: hash}
+			pair.Fees.Percentage = 0.5
+			return boltz.ReversePairs{boltz.CurrencyBtc: {boltz.CurrencyBtc: pair}}, nil
+		},
+		hash: func(pair *boltz.ReversePair) string { return pair.Hash },
+		serialize: func(pair boltz.Pair, reversePair *boltz.ReversePair) proto.Message {
+			return serializeReversePair(pair, reversePair)
+		},
+	}
+
+	pair, err := cache.find(boltz.PairBtc, false, "")
+	require.NoError(t, err)
+	require.Equal(t, "first", pair.Hash)
+
+	hash = "second"
+	pair, err = cache.find(boltz.PairBtc, false, "first")
+	require.NoError(t, err)
+	require.Equal(t, "first", pair.Hash)
+	require.Equal(t, 1, fetches)
+
+	t.Run("Expiry", func(t *testing.T) {
+		cache.fetchedAt = time.Now().Add(-pairCacheExpiry - time.Second)
+		pair, err := cache.find(boltz.PairBtc, false, "")
+		require.NoError(t, err)
+		require.Equal(t, "second", pair.Hash)
+		require.Equal(t, 2, fetches)
+	})
+
+	t.Run("ExpectedHashRefreshes", func(t *testing.T) {
+		hash = "third"
+		pair, err := cache.find(boltz.PairBtc, false, "third")
+		require.NoError(t, err)
+		require.Equal(t, "third", pair.Hash)
+		require.Equal(t, 3, fetches)
+	})
+
+	t.Run("FeesChanged", func(t *testing.T) {
+		_, err := cache.find(boltz.PairBtc, false, "first")
+		require.Error(t, err)
+
+		parsed, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.FailedPrecondition, parsed.Code())
+		require.Len(t, parsed.Details(), 1)
+
+		current, ok := parsed.Details()[0].(*boltzrpc.ReversePair)
+		require.True(t, ok)
+		require.Equal(t, "third", current.Hash)
+		require.Equal(t, float32(0.5), current.Fees.Percentage)
+	})
+}
+
+func TestPairCacheCreate(t *testing.T) {
+	hash := "first"
+	cache := &pairCache[boltz.ReversePair]{
+		fetch: func() (map[boltz.Currency]map[boltz.Currency]boltz.ReversePair, error) {
+			return boltz.ReversePairs{boltz.CurrencyBtc: {boltz.CurrencyBtc: {Hash: hash}}}, nil
+		},
+		hash: func(pair *boltz.ReversePair) string { return pair.Hash },
+		serialize: func(pair boltz.Pair, reversePair *boltz.ReversePair) proto.Message {
+			return serializeReversePair(pair, reversePair)
+		},
+	}
+	stale, err := cache.find(boltz.PairBtc, false, "")
+	require.NoError(t, err)
+	hash = "second"
+
+	// boltz only accepts its current hash
+	var created []string
+	create := func(pair *boltz.ReversePair) error {
+		created = append(created, pair.Hash)
+		if pair.Hash != hash {
+			return boltz.ErrInvalidPairHash
+		}
+		return nil
+	}
+	noCheck := func(*boltz.ReversePair) error { return nil }
+
+	requireFeesChanged := func(t *testing.T, err error) {
+		parsed, ok := status.FromError(err)
+		require.True(t, ok)
+		require.Equal(t, codes.FailedPrecondition, parsed.Code())
+	}
+
+	t.Run("Refetch", func(t *testing.T) {
+		created = nil
+		var checked string
+		err := cache.create(boltz.PairBtc, stale, true, func(pair *boltz.ReversePair) error {
+			checked = pair.Hash
+			return nil
+		}, create)
+		require.NoError(t, err)
+		require.Equal(t, "second", checked)
+		require.Equal(t, []string{"first", "second"}, created)
+	})
+
+	t.Run("CheckFails", func(t *testing.T) {
+		created = nil
+		checkErr := errors.New("fee too high")
+		err := cache.create(boltz.PairBtc, stale, true, func(*boltz.ReversePair) error {
+			return checkErr
+		}, create)
+		require.ErrorIs(t, err, checkErr)
+		require.Equal(t, []string{"first"}, created)
+	})
+
+	t.Run("Pinned", func(t *testing.T) {
+		created = nil
+		err := cache.create(boltz.PairBtc, stale, false, noCheck, create)
+		requireFeesChanged(t, err)
+		require.Equal(t, []string{"first"}, created)
+	})
+
+	t.Run("RetryOnce", func(t *testing.T) {
+		created = nil
+		err := cache.create(boltz.PairBtc, stale, true, noCheck, func(pair *boltz.ReversePair) error {
+			created = append(created, pair.Hash)
+			return boltz.ErrInvalidPairHash
+		})
+		requireFeesChanged(t, err)
+		require.Equal(t, []string{"first", "second"}, created)
+	})
+
+	t.Run("OtherError", func(t *testing.T) {
+		created = nil
+		otherErr := errors.New("boltz is down")
+		err := cache.create(boltz.PairBtc, stale, true, noCheck, func(pair *boltz.ReversePair) error {
+			created = append(created, pair.Hash)
+			return otherErr
+		})
+		require.ErrorIs(t, err, otherErr)
+		require.Equal(t, []string{"first"}, created)
+	})
+}
@@ -0,0 +1,232 @@
+package rpcserver
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/BoltzExchange/boltz-client/utils"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// upper bounds for the size of a claim or refund transaction of a single swap output
+var claimVsizeEstimation = map[boltz.Currency]float64{
+	boltz.CurrencyBtc:    170,
+	boltz.CurrencyLiquid: 1400,
+}
+
+type quoteFees struct {
+	percentage    float64
+	boltzMinerFee uint64
+	networkFee    uint64
+	minimal       uint64
+	maximal       uint64
+}
+
+func percentageFee(amount uint64, percentage float64) uint64 {
+	return uint64(math.Ceil(utils.Percentage(percentage).Calculate(float64(amount))))
+}
+
+// amountAfterFees deducts the percentage and fixed fees like boltz does
+func amountAfterFees(amount uint64, fees quoteFees) (uint64, error) {
+	left := leftAfterFees(amount, fees)
+	if left <= 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "amount %d does not cover the fees of boltz", amount)
+	}
+	return uint64(left), nil
+}
+
+// leftAfterFees is the amount which is left after boltz deducts its fees, which can be negative
+func leftAfterFees(amount uint64, fees quoteFees) int64 {
+	return int64(amount) - int64(percentageFee(amount, fees.percentage)+fees.boltzMinerFee)
+}
+
+// amountBeforeFees returns the smallest amount which is left with at least the given amount after fees are deducted
+func amountBeforeFees(amount uint64, fees quoteFees) uint64 {
+	result := uint64(math.Ceil(float64(amount+fees.boltzMinerFee) / (1 - utils.Percentage(fees.percentage).Ratio())))
+	// the percentage fee is rounded up, so the estimate can be off by a satoshi
+	for result > 0 && leftAfterFees(result-1, fees) >= int64(amount) {
+		result--
+	}
+	for leftAfterFees(result, fees) < int64(amount) {
+		result++
+	}
+	return result
+}
+
+func checkQuoteLimits(amount uint64, fees quoteFees) error {
+	if amount < fees.minimal {
+		return status.Errorf(codes.InvalidArgument, "amount %d is below the minimal amount of %d", amount, fees.minimal)
+	}
+	if amount > fees.maximal {
+		return status.Errorf(codes.InvalidArgument, "amount %d exceeds the maximal amount of %d", amount, fees.maximal)
+	}
+	return nil
+}
+
+// quoteSubmarine calculates a submarine swap, for which boltz adds its fees on top of the invoice amount
+func quoteSubmarine(request *boltzrpc.GetQuoteRequest, fees quoteFees) (*boltzrpc.GetQuoteResponse, error) {
+	var invoiceAmount uint64
+	switch amount := request.Amount.(type) {
+	case *boltzrpc.GetQuoteRequest_ReceiveAmount:
+		invoiceAmount = amount.ReceiveAmount
+	case *boltzrpc.GetQuoteRequest_SendAmount:
+		if amount.SendAmount <= fees.boltzMinerFee {
+			return nil, status.Errorf(codes.InvalidArgument, "amount %d does not cover the miner fee of %d", amount.SendAmount, fees.boltzMinerFee)
+		}
+		invoiceAmount = uint64(float64(amount.SendAmount-fees.boltzMinerFee) / (1 + utils.Percentage(fees.percentage).Ratio()))
+		for invoiceAmount > 0 && invoiceAmount+percentageFee(invoiceAmount, fees.percentage)+fees.boltzMinerFee > amount.SendAmount {
+			invoiceAmount--
+		}
+	}
+	if err := checkQuoteLimits(invoiceAmount, fees); err != nil {
+		return nil, err
+	}
+
+	serviceFee := percentageFee(invoiceAmount, fees.percentage)
+	sendAmount := invoiceAmount + serviceFee + fees.boltzMinerFee
+	return &boltzrpc.GetQuoteResponse{
+		SendAmount:    sendAmount,
+		ReceiveAmount: invoiceAmount,
+		InvoiceAmount: &invoiceAmount,
+		OnchainAmount: sendAmount,
+		ServiceFee:    serviceFee,
+		BoltzMinerFee: fees.boltzMinerFee,
+		NetworkFee:    fees.networkFee,
+	}, nil
+}
+
+// quoteOnchain calculates a reverse or chain swap, for which boltz deducts its fees from the amount we send
+// and our claim fee is deducted from the amount boltz locks up
+func quoteOnchain(request *boltzrpc.GetQuoteRequest, fees quoteFees) (*boltzrpc.GetQuoteResponse, error) {
+	var sendAmount uint64
+	switch amount := request.Amount.(type) {
+	case *boltzrpc.GetQuoteRequest_SendAmount:
+		sendAmount = amount.SendAmount
+	case *boltzrpc.GetQuoteRequest_ReceiveAmount:
+		sendAmount = amountBeforeFees(amount.ReceiveAmount+fees.networkFee, fees)
+	}
+	if err := checkQuoteLimits(sendAmount, fees); err != nil {
+		return nil, err
+	}
+
+	onchainAmount, err := amountAfterFees(sendAmount, fees)
+	if err != nil {
+		return nil, err
+	}
+	if onchainAmount <= fees.networkFee {
+		return nil, status.Errorf(codes.InvalidArgument, "onchain amount %d does not cover the claim fee of %d", onchainAmount, fees.networkFee)
+	}
+
+	response := &boltzrpc.GetQuoteResponse{
+		SendAmount:    sendAmount,
+		ReceiveAmount: onchainAmount - fees.networkFee,
+		OnchainAmount: onchainAmount,
+		ServiceFee:    percentageFee(sendAmount, fees.percentage),
+		BoltzMinerFee: fees.boltzMinerFee,
+		NetworkFee:    fees.networkFee,
+	}
+	if request.Type == boltzrpc.SwapType_REVERSE {
+		response.InvoiceAmount = &sendAmount
+	}
+	return response, nil
+}
+
+// estimateNetworkFee estimates the fee of a claim or refund transaction of a single swap output.
+// The fallback, like the claim fee boltz suggests, is used for chains we have no size estimation for.
+func (server *routedBoltzServer) estimateNetworkFee(currency boltz.Currency, policy *onchain.FeePolicy, fallback uint64) (uint64, error) {
+	vsize, ok := claimVsizeEstimation[currency]
+	if !ok {
+		return fallback, nil
+	}
+	feeSatPerVbyte, err := server.onchain.EstimateFeeWithPolicy(currency, policy)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(math.Ceil(feeSatPerVbyte * vsize)), nil
+}
+
+// reverseQuoteFees returns the fees of a reverse swap with a claim transaction that follows the fee policy
+func (server *routedBoltzServer) reverseQuoteFees(pair boltz.Pair, reversePair *boltz.ReversePair, policy *onchain.FeePolicy) (quoteFees, error) {
+	fees := quoteFees{
+		percentage:    reversePair.Fees.Percentage,
+		boltzMinerFee: reversePair.Fees.MinerFees.Lockup,
+		minimal:       reversePair.Limits.Minimal,
+		maximal:       reversePair.Limits.Maximal,
+	}
+	var err error
+	fees.networkFee, err = server.estimateNetworkFee(pair.To, policy, reversePair.Fees.MinerFees.Claim)
+	return fees, err
+}
+
+func (server *routedBoltzServer) GetQuote(ctx context.Context, request *boltzrpc.GetQuoteRequest) (*boltzrpc.GetQuoteResponse, error) {
+	if request.Amount == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "either send or receive amount has to be set")
+	}
+	pair := utils.ParsePair(request.Pair)
+
+	var fees quoteFees
+	var hash string
+	var quote func(*boltzrpc.GetQuoteRequest, quoteFees) (*boltzrpc.GetQuoteResponse, error)
+
+	switch request.Type {
+	case boltzrpc.SwapType_SUBMARINE:
+		submarinePair, err := server.pairs.submarine.find(pair, false, "")
+		if err != nil {
+			return nil, handleError(err)
+		}
+		hash = submarinePair.Hash
+		fees = quoteFees{
+			percentage:    submarinePair.Fees.Percentage,
+			boltzMinerFee: submarinePair.Fees.MinerFees,
+			minimal:       submarinePair.Limits.Minimal,
+			maximal:       submarinePair.Limits.Maximal,
+		}
+		fees.networkFee, err = server.estimateNetworkFee(pair.From, nil, 0)
+		if err != nil {
+			return nil, handleError(err)
+		}
+		quote = quoteSubmarine
+	case boltzrpc.SwapType_REVERSE:
+		reversePair, err := server.pairs.reverse.find(pair, false, "")
+		if err != nil {
+			return nil, handleError(err)
+		}
+		hash = reversePair.Hash
+		fees, err = server.reverseQuoteFees(pair, reversePair, nil)
+		if err != nil {
+			return nil, handleError(err)
+		}
+		quote = quoteOnchain
+	case boltzrpc.SwapType_CHAIN:
+		chainPair, err := server.pairs.chain.find(pair, false, "")
+		if err != nil {
+			return nil, handleError(err)
+		}
+		hash = chainPair.Hash
+		fees = quoteFees{
+			percentage:    chainPair.Fees.Percentage,
+			boltzMinerFee: chainPair.Fees.MinerFees.Server,
+			minimal:       chainPair.Limits.Minimal,
+			maximal:       chainPair.Limits.Maximal,
+		}
+		fees.networkFee, err = server.estimateNetworkFee(pair.To, nil, chainPair.Fees.MinerFees.User.Claim)
+		if err != nil {
+			return nil, handleError(err)
+		}
+		quote = quoteOnchain
+	default:
+		return nil, handleError(fmt.Errorf("unknown swap type: %v", request.Type))
+	}
+
+	response, err := quote(request, fees)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	response.PairHash = hash
+	return response, nil
+}
@@ -0,0 +1,82 @@
+package rpcserver
+
+import (
+	"testing"
+
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/stretchr/testify/require"
+)
+
+var testQuoteFees = quoteFees{
+	percentage:    0.25,
+	boltzMinerFee: 300,
+	networkFee:    200,
+	minimal:       10000,
+	maximal:       1000000,
+}
+
+func TestQuoteSubmarine(t *testing.T) {
+	receive, err := quoteSubmarine(&boltzrpc.GetQuoteRequest{
+		Type:   boltzrpc.SwapType_SUBMARINE,
+		Amount: &boltzrpc.GetQuoteRequest_ReceiveAmount{ReceiveAmount: 100001},
+	}, testQuoteFees)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100001), receive.GetInvoiceAmount())
+	require.Equal(t, uint64(251), receive.ServiceFee)
+	require.Equal(t, uint64(100001+251+300), receive.SendAmount)
+	require.Equal(t, receive.SendAmount, receive.OnchainAmount)
+
+	// sending one satoshi less than needed for the invoice above has to result in a smaller invoice
+	send, err := quoteSubmarine(&boltzrpc.GetQuoteRequest{
+		Type:   boltzrpc.SwapType_SUBMARINE,
+		Amount: &boltzrpc.GetQuoteRequest_SendAmount{SendAmount: receive.SendAmount - 1},
+	}, testQuoteFees)
+	require.NoError(t, err)
+	require.Equal(t, uint64(100000), send.ReceiveAmount)
+	require.LessOrEqual(t, send.SendAmount, receive.SendAmount-1)
+
+	_, err = quoteSubmarine(&boltzrpc.GetQuoteRequest{
+		Type:   boltzrpc.SwapType_SUBMARINE,
+		Amount: &boltzrpc.GetQuoteRequest_ReceiveAmount{ReceiveAmount: 1000},
+	}, testQuoteFees)
+	require.ErrorContains(t, err, "minimal")
+}
+
+func TestQuoteOnchain(t *testing.T) {
+	for _, swapType := range []boltzrpc.SwapType{boltzrpc.SwapType_REVERSE, boltzrpc.SwapType_CHAIN} {
+		t.Run(swapType.String(), func(t *testing.T) {
+			send, err := quoteOnchain(&boltzrpc.GetQuoteRequest{
+				Type:   swapType,
+				Amount: &boltzrpc.GetQuoteRequest_SendAmount{SendAmount: 100000},
+			}, testQuoteFees)
+			require.NoError(t, err)
+			require.Equal(t, uint64(250), send.ServiceFee)
+			require.Equal(t, uint64(100000-250-300), send.OnchainAmount)
+			require.Equal(t, send.OnchainAmount-200, send.ReceiveAmount)
+			require.Equal(t, swapType == boltzrpc.SwapType_REVERSE, send.InvoiceAmount != nil)
+
+			for _, amount := range []uint64{send.ReceiveAmount, send.ReceiveAmount + 1, 12345} {
+				receive, err := quoteOnchain(&boltzrpc.GetQuoteRequest{
+					Type:   swapType,
+					Amount: &boltzrpc.GetQuoteRequest_ReceiveAmount{ReceiveAmount: amount},
+				}, testQuoteFees)
+				require.NoError(t, err)
+				require.GreaterOrEqual(t, receive.ReceiveAmount, amount)
+
+				// one satoshi less would not be enough
+				lower, err := quoteOnchain(&boltzrpc.GetQuoteRequest{
+					Type:   swapType,
+					Amount: &boltzrpc.GetQuoteRequest_SendAmount{SendAmount: receive.SendAmount - 1},
+				}, testQuoteFees)
+				require.NoError(t, err)
+				require.Less(t, lower.ReceiveAmount, amount)
+			}
+
+			_, err = quoteOnchain(&boltzrpc.GetQuoteRequest{
+				Type:   swapType,
+				Amount: &boltzrpc.GetQuoteRequest_SendAmount{SendAmount: 2000000},
+			}, testQuoteFees)
+			require.ErrorContains(t, err, "maximal")
+		})
+	}
+}
@@ -13,6 +13,8 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/BoltzExchange/boltz-client/build"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -30,6 +32,7 @@ import (
 	"github.com/BoltzExchange/boltz-client/logger"
 	"github.com/BoltzExchange/boltz-client/nursery"
 	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/BoltzExchange/boltz-client/rootstock"
 	"github.com/BoltzExchange/boltz-client/utils"
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/lightningnetwork/lnd/zpay32"
@@ -37,6 +40,12 @@ import (
 
 const referralId = "boltz-client"
 
+// upper bounds for the size of a lockup transaction sent from one of our wallets
+var lockupVsizeEstimation = map[boltz.Currency]float64{
+	boltz.CurrencyBtc:    250,
+	boltz.CurrencyLiquid: 2500,
+}
+
 type routedBoltzServer struct {
 	boltzrpc.BoltzServer
 
@@ -49,8 +58,14 @@ type routedBoltzServer struct {
 	database  *database.Database
 	swapper   *autoswap.AutoSwapper
 
+	nurseryConfig nursery.Config
+	pairs         pairCaches
+
 	stop   chan bool
 	locked bool
+
+	// held for reading by every request and for writing while the database is restored
+	restoreLock sync.RWMutex
 }
 
 func handleError(err error) error {
@@ -84,6 +99,9 @@ func (server *routedBoltzServer) GetInfo(_ context.Context, _ *boltzrpc.GetInfoR
 	var pendingReverseSwapIds []string
 
 	for _, pendingReverseSwap := range pendingReverseSwaps {
+		if pendingReverseSwap.Status.IsFailedStatus() {
+			continue
+		}
 		pendingReverseSwapIds = append(pendingReverseSwapIds, pendingReverseSwap.Id)
 	}
 
@@ -160,8 +178,12 @@ func (server *routedBoltzServer) ListSwaps(_ context.Context, request *boltzrpc.
 	response := &boltzrpc.ListSwapsResponse{}
 
 	args := database.SwapQuery{
-		IsAuto: request.IsAuto,
-		State:  request.State,
+		IsAuto:     request.IsAuto,
+		State:      request.State,
+		Search:     request.GetQuery(),
+		Label:      request.GetLabel(),
+		ExternalId: request.GetExternalId(),
+		Descending: request.Order == boltzrpc.SortOrder_DESCENDING,
 	}
 
 	if request.From != nil {
@@ -174,13 +196,42 @@ func (server *routedBoltzServer) ListSwaps(_ context.Context, request *boltzrpc.
 		args.To = &parsed
 	}
 
+	if request.Since != nil {
+		args.Since = time.Unix(request.GetSince(), 0)
+	}
+
+	if request.Until != nil {
+		args.Until = time.Unix(request.GetUntil(), 0)
+	}
+
+	if request.Cursor != nil {
+		cursor, err := decodeSwapCursor(request.GetCursor())
+		if err != nil {
+			return nil, handleError(status.Errorf(codes.InvalidArgument, "invalid cursor: %s", err))
+		}
+		args.After = cursor
+	}
+
+	if request.Limit != nil {
+		if request.GetLimit() == 0 {
+			return nil, handleError(status.Errorf(codes.InvalidArgument, "limit has to be greater than 0"))
+		}
+		// one more swap than requested is queried to know whether there is another page
+		args.Limit = request.GetLimit() + 1
+	}
+
+	page := &swapPage{descending: args.Descending}
+
 	swaps, err := server.database.QuerySwaps(args)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, swap := range swaps {
-		response.Swaps = append(response.Swaps, serializeSwap(&swap))
+	for i := range swaps {
+		swap := &swaps[i]
+		page.add(swap.CreatedAt, swap.Id, func() {
+			response.Swaps = append(response.Swaps, server.swapInfo(swap))
+		})
 	}
 
 	// Reverse Swaps
@@ -190,10 +241,28 @@ func (server *routedBoltzServer) ListSwaps(_ context.Context, request *boltzrpc.
 		return nil, err
 	}
 
-	for _, reverseSwap := range reverseSwaps {
-		response.ReverseSwaps = append(response.ReverseSwaps, serializeReverseSwap(&reverseSwap))
+	for i := range reverseSwaps {
+		reverseSwap := &reverseSwaps[i]
+		page.add(reverseSwap.CreatedAt, reverseSwap.Id, func() {
+			response.ReverseSwaps = append(response.ReverseSwaps, server.reverseSwapInfo(reverseSwap))
+		})
+	}
+
+	// Chain Swaps
+	chainSwaps, err := server.database.QueryChainSwaps(args)
+	if err != nil {
+		return nil, err
 	}
 
+	for i := range chainSwaps {
+		chainSwap := &chainSwaps[i]
+		page.add(chainSwap.CreatedAt, chainSwap.Id, func() {
+			response.ChainSwaps = append(response.ChainSwaps, server.chainSwapInfo(chainSwap))
+		})
+	}
+
+	response.NextCursor = page.finish(request.GetLimit())
+
 	return response, nil
 }
 
@@ -211,6 +280,16 @@ func (server *routedBoltzServer) RefundSwap(ctx context.Context, request *boltzr
 		return nil, handleError(status.Errorf(codes.InvalidArgument, "invalid address"))
 	}
 
+	if request.FeePolicy != nil {
+		feePolicy, err := parseFeePolicy(request.FeePolicy)
+		if err != nil {
+			return nil, handleError(err)
+		}
+		if err := server.database.SetSwapFeePolicy(swap, feePolicy); err != nil {
+			return nil, handleError(err)
+		}
+	}
+
 	if err := server.database.SetSwapRefundRefundAddress(swap, request.Address); err != nil {
 		return nil, handleError(err)
 	}
@@ -222,15 +301,72 @@ func (server *routedBoltzServer) RefundSwap(ctx context.Context, request *boltzr
 	return server.GetSwapInfo(ctx, &boltzrpc.GetSwapInfoRequest{Id: request.Id})
 }
 
+func (server *routedBoltzServer) BumpTransaction(_ context.Context, request *boltzrpc.BumpTransactionRequest) (*boltzrpc.BumpTransactionResponse, error) {
+	txId, err := server.nursery.BumpTransaction(request.TxId, request.SatPerVbyte)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return &boltzrpc.BumpTransactionResponse{TxId: txId}, nil
+}
+
 func (server *routedBoltzServer) GetSwapInfo(_ context.Context, request *boltzrpc.GetSwapInfoRequest) (*boltzrpc.GetSwapInfoResponse, error) {
-	swap, reverseSwap, err := server.database.QueryAnySwap(request.Id)
+	swap, reverseSwap, chainSwap, err := server.database.QueryAnySwap(request.Id)
 	if err != nil {
 		return nil, handleError(errors.New("could not find Swap with ID " + request.Id))
 	}
-	return &boltzrpc.GetSwapInfoResponse{
-		Swap:        serializeSwap(swap),
-		ReverseSwap: serializeReverseSwap(reverseSwap),
-	}, nil
+	events, err := server.database.QuerySwapEvents(request.Id)
+	if err != nil {
+		return nil, handleError(err)
+	}
+	response := &boltzrpc.GetSwapInfoResponse{
+		Swap:        server.swapInfo(swap),
+		ReverseSwap: server.reverseSwapInfo(reverseSwap),
+		ChainSwap:   server.chainSwapInfo(chainSwap),
+	}
+	for i := range events {
+		response.History = append(response.History, serializeSwapEvent(&events[i]))
+	}
+	return response, nil
+}
+
+func (server *routedBoltzServer) timeoutEta(currency boltz.Currency, timeoutBlockHeight uint32) *int64 {
+	eta, err := server.nursery.TimeoutEta(currency, timeoutBlockHeight)
+	if err != nil {
+		logger.Warnf("Could not estimate timeout of block %d on %s: %v", timeoutBlockHeight, currency, err)
+		return nil
+	}
+	serialized := serializeTime(eta)
+	return &serialized
+}
+
+// swapInfo serializes the swap and adds the estimated time of its timeout if it is still pending
+func (server *routedBoltzServer) swapInfo(swap *database.Swap) *boltzrpc.SwapInfo {
+	info := serializeSwap(swap)
+	if info != nil && swap.State == boltzrpc.SwapState_PENDING {
+		info.TimeoutEta = server.timeoutEta(swap.Pair.From, swap.TimoutBlockHeight)
+	}
+	return info
+}
+
+func (server *routedBoltzServer) reverseSwapInfo(reverseSwap *database.ReverseSwap) *boltzrpc.ReverseSwapInfo {
+	info := serializeReverseSwap(reverseSwap)
+	if info != nil && reverseSwap.State == boltzrpc.SwapState_PENDING {
+		info.TimeoutEta = server.timeoutEta(reverseSwap.Pair.To, reverseSwap.TimeoutBlockHeight)
+	}
+	return info
+}
+
+func (server *routedBoltzServer) chainSwapInfo(chainSwap *database.ChainSwap) *boltzrpc.ChainSwapInfo {
+	info := serializeChainSwap(chainSwap)
+	if info != nil && chainSwap.State == boltzrpc.SwapState_PENDING {
+		if info.FromData != nil {
+			info.FromData.TimeoutEta = server.timeoutEta(chainSwap.FromData.Currency, chainSwap.FromData.TimeoutBlockHeight)
+		}
+		if info.ToData != nil {
+			info.ToData.TimeoutEta = server.timeoutEta(chainSwap.ToData.Currency, chainSwap.ToData.TimeoutBlockHeight)
+		}
+	}
+	return info
 }
 
 func (server *routedBoltzServer) GetSwapInfoStream(request *boltzrpc.GetSwapInfoRequest, stream boltzrpc.Boltz_GetSwapInfoStreamServer) error {
@@ -257,8 +393,10 @@ func (server *routedBoltzServer) GetSwapInfoStream(request *boltzrpc.GetSwapInfo
 
 	for update := range updates {
 		if err := stream.Send(&boltzrpc.GetSwapInfoResponse{
-			Swap:        serializeSwap(update.Swap),
-			ReverseSwap: serializeReverseSwap(update.ReverseSwap),
+			Swap:           server.swapInfo(update.Swap),
+			ReverseSwap:    server.reverseSwapInfo(update.ReverseSwap),
+			ChainSwap:      server.chainSwapInfo(update.ChainSwap),
+			TimeoutWarning: serializeTimeoutWarning(update.TimeoutWarning),
 		}); err != nil {
 			stop()
 			return handleError(err)
@@ -268,6 +406,21 @@ func (server *routedBoltzServer) GetSwapInfoStream(request *boltzrpc.GetSwapInfo
 	return nil
 }
 
+func (server *routedBoltzServer) UpdateSwapLabel(_ context.Context, request *boltzrpc.UpdateSwapLabelRequest) (*empty.Empty, error) {
+	if request.Label == nil && request.ExternalId == nil {
+		return nil, handleError(status.Errorf(codes.InvalidArgument, "label or external id has to be set"))
+	}
+
+	if err := server.database.UpdateSwapLabel(request.Id, request.Label, request.ExternalId); err != nil {
+		if errors.Is(err, database.ErrSwapNotFound) {
+			return nil, handleError(status.Errorf(codes.NotFound, "swap not found"))
+		}
+		return nil, handleError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
 func (server *routedBoltzServer) Deposit(_ context.Context, request *boltzrpc.DepositRequest) (*boltzrpc.DepositResponse, error) {
 	response, err := server.createSwap(false, &boltzrpc.CreateSwapRequest{
 		Pair: &boltzrpc.Pair{
@@ -290,6 +443,24 @@ func (server *routedBoltzServer) Deposit(_ context.Context, request *boltzrpc.De
 func (server *routedBoltzServer) createSwap(isAuto bool, request *boltzrpc.CreateSwapRequest) (*boltzrpc.CreateSwapResponse, error) {
 	logger.Info("Creating Swap for " + strconv.FormatInt(request.Amount, 10) + " satoshis")
 
+	feePolicy, err := parseFeePolicy(request.FeePolicy)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	var chanIds []lightning.ChanId
+	for _, chanId := range request.ChanIds {
+		parsed, err := lightning.NewChanIdFromString(chanId)
+		if err != nil {
+			return nil, handleError(errors.New("invalid channel id: " + err.Error()))
+		}
+		chanIds = append(chanIds, parsed)
+	}
+
+	if len(chanIds) > 0 && request.GetInvoice() != "" {
+		return nil, handleError(status.Errorf(codes.InvalidArgument, "channel ids can not be used with a custom invoice"))
+	}
+
 	privateKey, publicKey, err := newKeys()
 	if err != nil {
 		return nil, handleError(err)
@@ -297,9 +468,11 @@ func (server *routedBoltzServer) createSwap(isAuto bool, request *boltzrpc.Creat
 
 	pair := utils.ParsePair(request.Pair)
 
-	submarinePair, err := server.GetSubmarinePair(context.Background(), request.Pair)
+	// swaps without a pinned hash or fee ceiling always use the latest fees
+	pinned := request.PairHash != nil || request.MaxBoltzFee != nil
+	submarinePair, err := server.pairs.submarine.find(pair, !pinned, request.GetPairHash())
 	if err != nil {
-		return nil, err
+		return nil, handleError(err)
 	}
 
 	createSwap := boltz.CreateSwapRequest{
@@ -310,19 +483,55 @@ func (server *routedBoltzServer) createSwap(isAuto bool, request *boltzrpc.Creat
 		ReferralId:      referralId,
 	}
 
-	var preimage, preimageHash []byte
+	if pair.From == boltz.CurrencyRootstock {
+		if request.GetRefundAddress() != "" {
+			return nil, handleError(errors.New("lockups of RBTC can only be refunded to the address which locked them"))
+		}
+		// EVM lockups are refunded to the address which locked them rather than with a key
+		createSwap.RefundPublicKey = nil
+	}
+
+	invoiceAmount := uint64(request.Amount)
+	var invoice *zpay32.Invoice
 	if request.GetInvoice() != "" {
-		invoice, err := zpay32.Decode(request.GetInvoice(), server.network.Btc)
+		invoice, err = zpay32.Decode(request.GetInvoice(), server.network.Btc)
 		if err != nil {
 			return nil, handleError(fmt.Errorf("invalid invoice: %w", err))
 		}
+		if invoice.MilliSat != nil {
+			invoiceAmount = uint64(invoice.MilliSat.ToSatoshis())
+		}
+	}
+
+	if request.MaxBoltzFee != nil && invoiceAmount == 0 {
+		return nil, handleError(status.Errorf(codes.InvalidArgument, "a maximal boltz fee requires an amount"))
+	}
+	checkBoltzFee := func(submarinePair *boltz.SubmarinePair) error {
+		if request.MaxBoltzFee == nil {
+			return nil
+		}
+		boltzFee := percentageFee(invoiceAmount, submarinePair.Fees.Percentage) + submarinePair.Fees.MinerFees
+		if boltzFee > *request.MaxBoltzFee {
+			return newFeesChangedError(
+				serializeSubmarinePair(pair, submarinePair),
+				"boltz fee of %d satoshis exceeds the maximum of %d satoshis", boltzFee, *request.MaxBoltzFee,
+			)
+		}
+		return nil
+	}
+	if err := checkBoltzFee(submarinePair); err != nil {
+		return nil, handleError(err)
+	}
+
+	var preimage, preimageHash []byte
+	if invoice != nil {
 		preimageHash = invoice.PaymentHash[:]
 		createSwap.Invoice = request.GetInvoice()
 	} else if server.lightning == nil {
 		return nil, handleError(errors.New("invoice is required in standalone mode"))
 	} else if request.Amount != 0 {
 
-		invoice, err := server.lightning.CreateInvoice(request.Amount, nil, 0, utils.GetSwapMemo(string(pair.From)))
+		invoice, err := server.lightning.CreateInvoice(request.Amount, nil, 0, utils.GetSwapMemo(string(pair.From)), chanIds)
 		if err != nil {
 			return nil, handleError(err)
 		}
@@ -349,10 +558,45 @@ func (server *routedBoltzServer) createSwap(isAuto bool, request *boltzrpc.Creat
 		}
 	}
 
-	response, err := server.boltz.CreateSwap(createSwap)
+	var feeSatPerVbyte float64
+	checkLockupFee := func(*boltz.SubmarinePair) error { return nil }
+	if request.SendFromInternal && pair.From != boltz.CurrencyRootstock {
+		feeSatPerVbyte, err = server.onchain.EstimateFeeWithPolicy(pair.From, feePolicy)
+		if err != nil {
+			return nil, handleError(err)
+		}
+		// the wallet does not tell us the fee before broadcasting, so the policy is checked against an upper bound
+		// before boltz creates a swap that we would not pay
+		checkLockupFee = func(submarinePair *boltz.SubmarinePair) error {
+			expectedAmount := invoiceAmount + percentageFee(invoiceAmount, submarinePair.Fees.Percentage) + submarinePair.Fees.MinerFees
+			estimatedFee := uint64(math.Ceil(feeSatPerVbyte * lockupVsizeEstimation[pair.From]))
+			return feePolicy.CheckFee(estimatedFee, expectedAmount)
+		}
+		if err := checkLockupFee(submarinePair); err != nil {
+			return nil, handleError(err)
+		}
+	}
 
+	var response *boltz.CreateSwapResponse
+	err = server.pairs.submarine.create(
+		pair, submarinePair, request.PairHash == nil,
+		func(current *boltz.SubmarinePair) error {
+			if err := checkBoltzFee(current); err != nil {
+				return err
+			}
+			return checkLockupFee(current)
+		},
+		func(current *boltz.SubmarinePair) (err error) {
+			submarinePair = current
+			createSwap.PairHash = current.Hash
+			if response, err = server.boltz.CreateSwap(createSwap); err != nil {
+				return fmt.Errorf("boltz error: %w", err)
+			}
+			return nil
+		},
+	)
 	if err != nil {
-		return nil, handleError(errors.New("boltz error: " + err.Error()))
+		return nil, handleError(err)
 	}
 
 	swap := database.Swap{
@@ -362,6 +606,7 @@ func (server *routedBoltzServer) createSwap(isAuto bool, request *boltzrpc.Creat
 		Error:               "",
 		PrivateKey:          privateKey,
 		Preimage:            preimage,
+		PaymentHash:         preimageHash,
 		Invoice:             createSwap.Invoice,
 		Address:             response.Address,
 		ExpectedAmount:      response.ExpectedAmount,
@@ -372,47 +617,54 @@ func (server *routedBoltzServer) createSwap(isAuto bool, request *boltzrpc.Creat
 		RefundAddress:       request.GetRefundAddress(),
 		IsAuto:              isAuto,
 		ServiceFeePercent:   utils.Percentage(submarinePair.Fees.Percentage),
+		FeePolicy:           feePolicy,
+		ChanIds:             chanIds,
+		BoltzUrl:            response.Endpoint,
+		Label:               request.GetLabel(),
+		ExternalId:          request.GetExternalId(),
 	}
 
 	if request.SendFromInternal {
 		swap.Wallet = wallet.Name()
 	}
 
-	swap.ClaimPubKey, err = btcec.ParsePubKey([]byte(response.ClaimPublicKey))
-	if err != nil {
-		return nil, handleError(err)
-	}
+	var rootstockClaimAddress rootstock.Address
+	if pair.From == boltz.CurrencyRootstock {
+		rootstockClaimAddress, err = server.checkRootstockSwap(response.Address, response.ClaimAddress)
+		if err != nil {
+			return nil, handleError(err)
+		}
 
-	// for _, chanId := range request.ChanIds {
-	// 	parsed, err := lightning.NewChanIdFromString(chanId)
-	// 	if err != nil {
-	// 		return nil, handleError(errors.New("invalid channel id: " + err.Error()))
-	// 	}
-	// 	swap.ChanIds = append(swap.ChanIds, parsed)
-	// }
+		logger.Info("Verified contract and claim address of Swap " + swap.Id)
+	} else {
+		swap.ClaimPubKey, err = btcec.ParsePubKey([]byte(response.ClaimPublicKey))
+		if err != nil {
+			return nil, handleError(err)
+		}
 
-	if pair.From == boltz.CurrencyLiquid {
-		swap.BlindingKey, _ = btcec.PrivKeyFromBytes(response.BlindingKey)
+		if pair.From == boltz.CurrencyLiquid {
+			swap.BlindingKey, _ = btcec.PrivKeyFromBytes(response.BlindingKey)
 
-		if err != nil {
+			if err != nil {
+				return nil, handleError(err)
+			}
+		}
+
+		if err := swap.InitTree(); err != nil {
 			return nil, handleError(err)
 		}
-	}
 
-	if err := swap.InitTree(); err != nil {
-		return nil, handleError(err)
-	}
+		if err := swap.SwapTree.Check(false, swap.TimoutBlockHeight, preimageHash); err != nil {
+			return nil, handleError(err)
+		}
 
-	if err := swap.SwapTree.Check(false, swap.TimoutBlockHeight, preimageHash); err != nil {
-		return nil, handleError(err)
-	}
+		if err := swap.SwapTree.CheckAddress(response.Address, server.network, swap.BlindingPubKey()); err != nil {
+			return nil, handleError(err)
+		}
 
-	if err := swap.SwapTree.CheckAddress(response.Address, server.network, swap.BlindingPubKey()); err != nil {
-		return nil, handleError(err)
+		logger.Info("Verified redeem script and address of Swap " + swap.Id)
 	}
 
-	logger.Info("Verified redeem script and address of Swap " + swap.Id)
-
 	err = server.database.CreateSwap(swap)
 	if err != nil {
 		return nil, handleError(err)
@@ -423,7 +675,7 @@ func (server *routedBoltzServer) createSwap(isAuto bool, request *boltzrpc.Creat
 		return nil, handleError(err)
 	}
 
-	timeoutHours := boltz.BlocksToHours(response.TimeoutBlockHeight-blockHeight, pair.From)
+	timeoutHours := server.nursery.BlocksToDuration(pair.From, response.TimeoutBlockHeight-blockHeight).Hours()
 	swapResponse := &boltzrpc.CreateSwapResponse{
 		Id:                 swap.Id,
 		Address:            response.Address,
@@ -433,12 +685,21 @@ func (server *routedBoltzServer) createSwap(isAuto bool, request *boltzrpc.Creat
 		TimeoutHours:       float32(timeoutHours),
 	}
 
-	if request.SendFromInternal {
-		// TODO: custom block target?
-		feeSatPerVbyte, err := server.onchain.EstimateFee(pair.From, 2)
+	if request.SendFromInternal && pair.From == boltz.CurrencyRootstock {
+		rootstockWallet, err := server.nursery.RootstockWallet()
+		if err != nil {
+			return nil, handleError(err)
+		}
+		txId, fee, err := rootstockWallet.Lock(preimageHash, response.ExpectedAmount, rootstockClaimAddress, response.TimeoutBlockHeight)
 		if err != nil {
 			return nil, handleError(err)
 		}
+		logger.Infof("Locked %d satoshis for Swap %s in EtherSwap contract", response.ExpectedAmount, swap.Id)
+		if err := server.database.SetSwapOnchainFee(&swap, fee); err != nil {
+			return nil, handleError(err)
+		}
+		swapResponse.TxId = txId
+	} else if request.SendFromInternal {
 		logger.Infof("Paying swap %s with fee of %f sat/vbyte", swap.Id, feeSatPerVbyte)
 		txId, err := wallet.SendToAddress(response.Address, response.ExpectedAmount, feeSatPerVbyte)
 		if err != nil {
@@ -447,7 +708,7 @@ func (server *routedBoltzServer) createSwap(isAuto bool, request *boltzrpc.Creat
 		swapResponse.TxId = txId
 	}
 
-	logger.Info("Created new Swap " + swap.Id + ": " + marshalJson(swap.Serialize()))
+	logger.Info("Created new Swap " + swap.Id + ": " + marshalJson(swap.Serialize().Redacted()))
 
 	if err := server.nursery.RegisterSwap(swap); err != nil {
 		return nil, handleError(err)
@@ -456,12 +717,35 @@ func (server *routedBoltzServer) createSwap(isAuto bool, request *boltzrpc.Creat
 	return swapResponse, nil
 }
 
+// checkRootstockSwap makes sure that boltz uses the EtherSwap contract we know about and parses the address of boltz
+func (server *routedBoltzServer) checkRootstockSwap(contract string, boltzAddress string) (rootstock.Address, error) {
+	rootstockWallet, err := server.nursery.RootstockWallet()
+	if err != nil {
+		return rootstock.Address{}, err
+	}
+	contractAddress, err := rootstock.ParseAddress(contract)
+	if err != nil {
+		return rootstock.Address{}, err
+	}
+	if contractAddress != rootstockWallet.EtherSwap.Address {
+		return rootstock.Address{}, fmt.Errorf("boltz uses unknown contract %s", contract)
+	}
+	return rootstock.ParseAddress(boltzAddress)
+}
+
 func (server *routedBoltzServer) CreateSwap(_ context.Context, request *boltzrpc.CreateSwapRequest) (*boltzrpc.CreateSwapResponse, error) {
 	return server.createSwap(false, request)
 }
 
 func (server *routedBoltzServer) createReverseSwap(isAuto bool, request *boltzrpc.CreateReverseSwapRequest) (*boltzrpc.CreateReverseSwapResponse, error) {
-	logger.Info("Creating Reverse Swap for " + strconv.FormatInt(request.Amount, 10) + " satoshis")
+	if request.OnchainAmount != nil {
+		if request.Amount != 0 {
+			return nil, handleError(status.Errorf(codes.InvalidArgument, "amount and onchain amount can not be set at the same time"))
+		}
+		logger.Infof("Creating Reverse Swap for an onchain amount of %d satoshis", *request.OnchainAmount)
+	} else {
+		logger.Info("Creating Reverse Swap for " + strconv.FormatInt(request.Amount, 10) + " satoshis")
+	}
 
 	externalPay := request.GetExternalPay()
 	if server.lightning == nil {
@@ -482,9 +766,17 @@ func (server *routedBoltzServer) createReverseSwap(isAuto bool, request *boltzrp
 		}
 	}
 
+	feePolicy, err := parseFeePolicy(request.FeePolicy)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
 	claimAddress := request.Address
 
 	pair := utils.ParsePair(request.Pair)
+	if pair.To == boltz.CurrencyRootstock && claimAddress != "" {
+		return nil, handleError(errors.New("lockups of RBTC can only be claimed by the rootstock wallet"))
+	}
 	if claimAddress != "" {
 		err := boltz.ValidateAddress(server.network, claimAddress, pair.To)
 
@@ -511,7 +803,7 @@ func (server *routedBoltzServer) createReverseSwap(isAuto bool, request *boltzrp
 		return nil, handleError(err)
 	}
 
-	logger.Info("Generated preimage " + hex.EncodeToString(preimage))
+	logger.Info("Generated preimage with hash " + hex.EncodeToString(preimageHash))
 
 	privateKey, publicKey, err := newKeys()
 
@@ -519,29 +811,99 @@ func (server *routedBoltzServer) createReverseSwap(isAuto bool, request *boltzrp
 		return nil, handleError(err)
 	}
 
-	reversePair, err := server.GetReversePair(context.Background(), request.Pair)
+	// swaps without a pinned hash or fee ceiling always use the latest fees
+	pinned := request.PairHash != nil || request.MaxBoltzFee != nil
+	reversePair, err := server.pairs.reverse.find(pair, !pinned, request.GetPairHash())
 	if err != nil {
 		return nil, handleError(err)
 	}
 
-	response, err := server.boltz.CreateReverseSwap(boltz.CreateReverseSwapRequest{
+	if request.OnchainAmount != nil && pair.To == boltz.CurrencyRootstock {
+		return nil, handleError(errors.New("onchain amounts are not supported for reverse swaps to RBTC"))
+	}
+
+	var invoiceAmount, minOnchainAmount uint64
+	// calculateAmounts derives the amounts from the fees of the pair and checks them against the maximal boltz fee
+	calculateAmounts := func(reversePair *boltz.ReversePair) error {
+		invoiceAmount = uint64(request.Amount)
+		if request.OnchainAmount != nil {
+			fees, err := server.reverseQuoteFees(pair, reversePair, feePolicy)
+			if err != nil {
+				return err
+			}
+			quote, err := quoteOnchain(&boltzrpc.GetQuoteRequest{
+				Type:   boltzrpc.SwapType_REVERSE,
+				Pair:   request.Pair,
+				Amount: &boltzrpc.GetQuoteRequest_ReceiveAmount{ReceiveAmount: *request.OnchainAmount},
+			}, fees)
+			if err != nil {
+				return err
+			}
+			invoiceAmount = quote.GetInvoiceAmount()
+			minOnchainAmount = *request.OnchainAmount + fees.networkFee
+			logger.Infof(
+				"Calculated invoice amount of %d satoshis for onchain amount of %d satoshis with estimated claim fee of %d satoshis",
+				invoiceAmount, *request.OnchainAmount, fees.networkFee,
+			)
+		}
+
+		if request.MaxBoltzFee != nil {
+			boltzFee := percentageFee(invoiceAmount, reversePair.Fees.Percentage) + reversePair.Fees.MinerFees.Lockup
+			if boltzFee > *request.MaxBoltzFee {
+				return newFeesChangedError(
+					serializeReversePair(pair, reversePair),
+					"boltz fee of %d satoshis exceeds the maximum of %d satoshis", boltzFee, *request.MaxBoltzFee,
+				)
+			}
+		}
+		return nil
+	}
+	if err := calculateAmounts(reversePair); err != nil {
+		return nil, handleError(err)
+	}
+
+	createReverseSwap := boltz.CreateReverseSwapRequest{
 		From:           pair.From,
 		To:             pair.To,
-		PairHash:       reversePair.Hash,
-		InvoiceAmount:  uint64(request.Amount),
 		PreimageHash:   preimageHash,
 		ClaimPublicKey: publicKey.SerializeCompressed(),
 		ReferralId:     referralId,
-	})
-	if err != nil {
-		return nil, handleError(err)
+	}
+	if pair.To == boltz.CurrencyRootstock {
+		createReverseSwap.ClaimPublicKey = nil
+		createReverseSwap.ClaimAddress = claimAddress
 	}
 
-	key, err := btcec.ParsePubKey(response.RefundPublicKey)
+	var response *boltz.CreateReverseSwapResponse
+	err = server.pairs.reverse.create(
+		pair, reversePair, request.PairHash == nil, calculateAmounts,
+		func(current *boltz.ReversePair) (err error) {
+			reversePair = current
+			createReverseSwap.PairHash = current.Hash
+			createReverseSwap.InvoiceAmount = invoiceAmount
+			response, err = server.boltz.CreateReverseSwap(createReverseSwap)
+			return err
+		},
+	)
 	if err != nil {
 		return nil, handleError(err)
 	}
 
+	if response.OnchainAmount < minOnchainAmount {
+		return nil, handleError(fmt.Errorf(
+			"boltz would lock up %d satoshis, which does not cover the onchain amount and claim fee of %d satoshis",
+			response.OnchainAmount, minOnchainAmount,
+		))
+	}
+
+	var key *btcec.PublicKey
+	if pair.To != boltz.CurrencyRootstock {
+		key, err = btcec.ParsePubKey(response.RefundPublicKey)
+		if err != nil {
+			return nil, handleError(err)
+		}
+	}
+
 	reverseSwap := database.ReverseSwap{
 		Id:                  response.Id,
 		IsAuto:              isAuto,
@@ -552,6 +914,7 @@ func (server *routedBoltzServer) createReverseSwap(isAuto bool, request *boltzrp
 		SwapTree:            response.SwapTree.Deserialize(),
 		RefundPubKey:        key,
 		Preimage:            preimage,
+		PaymentHash:         preimageHash,
 		Invoice:             response.Invoice,
 		ClaimAddress:        claimAddress,
 		OnchainAmount:       response.OnchainAmount,
@@ -560,6 +923,13 @@ func (server *routedBoltzServer) createReverseSwap(isAuto bool, request *boltzrp
 		ClaimTransactionId:  "",
 		ServiceFeePercent:   utils.Percentage(reversePair.Fees.Percentage),
 		ExternalPay:         externalPay,
+		FeePolicy:           feePolicy,
+		BoltzUrl:            response.Endpoint,
+		Label:               request.GetLabel(),
+		ExternalId:          request.GetExternalId(),
+	}
+	if request.OnchainAmount != nil {
+		reverseSwap.TargetAmount = *request.OnchainAmount
 	}
 
 	for _, chanId := range request.ChanIds {
@@ -580,16 +950,22 @@ func (server *routedBoltzServer) createReverseSwap(isAuto bool, request *boltzrp
 		}
 	}
 
-	if err := reverseSwap.InitTree(); err != nil {
-		return nil, handleError(err)
-	}
+	if pair.To == boltz.CurrencyRootstock {
+		if _, err := server.checkRootstockSwap(response.LockupAddress, response.RefundAddress); err != nil {
+			return nil, handleError(err)
+		}
+	} else {
+		if err := reverseSwap.InitTree(); err != nil {
+			return nil, handleError(err)
+		}
 
-	if err := reverseSwap.SwapTree.Check(true, reverseSwap.TimeoutBlockHeight, preimageHash); err != nil {
-		return nil, handleError(err)
-	}
+		if err := reverseSwap.SwapTree.Check(true, reverseSwap.TimeoutBlockHeight, preimageHash); err != nil {
+			return nil, handleError(err)
+		}
 
-	if err := reverseSwap.SwapTree.CheckAddress(response.LockupAddress, server.network, blindingPubKey); err != nil {
-		return nil, handleError(err)
+		if err := reverseSwap.SwapTree.CheckAddress(response.LockupAddress, server.network, blindingPubKey); err != nil {
+			return nil, handleError(err)
+		}
 	}
 
 	invoice, err := zpay32.Decode(reverseSwap.Invoice, server.network.Btc)
@@ -614,7 +990,7 @@ func (server *routedBoltzServer) createReverseSwap(isAuto bool, request *boltzrp
 		return nil, handleError(err)
 	}
 
-	logger.Info("Created new Reverse Swap " + reverseSwap.Id + ": " + marshalJson(reverseSwap.Serialize()))
+	logger.Info("Created new Reverse Swap " + reverseSwap.Id + ": " + marshalJson(reverseSwap.Serialize().Redacted()))
 
 	rpcResponse := &boltzrpc.CreateReverseSwapResponse{
 		Id:            reverseSwap.Id,
@@ -656,6 +1032,194 @@ func (server *routedBoltzServer) CreateReverseSwap(_ context.Context, request *b
 	return server.createReverseSwap(false, request)
 }
 
+func (server *routedBoltzServer) createChainSwap(isAuto bool, request *boltzrpc.CreateChainSwapRequest) (*boltzrpc.ChainSwapInfo, error) {
+	logger.Infof("Creating Chain Swap for %d satoshis", request.Amount)
+
+	pair := utils.ParsePair(request.Pair)
+	if pair.From == pair.To {
+		return nil, handleError(errors.New("chain swaps require different currencies"))
+	}
+
+	if request.RefundAddress != nil {
+		if err := boltz.ValidateAddress(server.network, request.GetRefundAddress(), pair.From); err != nil {
+			return nil, handleError(fmt.Errorf("invalid refund address %s: %w", request.GetRefundAddress(), err))
+		}
+	}
+
+	var fromWallet onchain.Wallet
+	if request.FromWallet != nil {
+		var err error
+		fromWallet, err = server.onchain.GetWallet(request.GetFromWallet(), pair.From, false)
+		if err != nil {
+			return nil, handleError(err)
+		}
+	}
+
+	claimAddress := request.GetToAddress()
+	var toWallet string
+	if claimAddress != "" {
+		if err := boltz.ValidateAddress(server.network, claimAddress, pair.To); err != nil {
+			return nil, handleError(fmt.Errorf("invalid claim address %s: %w", claimAddress, err))
+		}
+	} else {
+		wallet, err := server.onchain.GetWallet(request.GetToWallet(), pair.To, true)
+		if err != nil {
+			return nil, handleError(err)
+		}
+
+		claimAddress, err = wallet.NewAddress()
+		if err != nil {
+			return nil, handleError(err)
+		}
+		toWallet = wallet.Name()
+
+		logger.Infof("Got claim address from wallet %v: %v", toWallet, claimAddress)
+	}
+
+	preimage, preimageHash, err := newPreimage()
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	claimPrivateKey, claimPublicKey, err := newKeys()
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	refundPrivateKey, refundPublicKey, err := newKeys()
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	chainPair, err := server.pairs.chain.find(pair, true, "")
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	response, err := server.boltz.CreateChainSwap(boltz.ChainRequest{
+		From:            pair.From,
+		To:              pair.To,
+		PreimageHash:    preimageHash,
+		ClaimPublicKey:  claimPublicKey.SerializeCompressed(),
+		RefundPublicKey: refundPublicKey.SerializeCompressed(),
+		UserLockAmount:  request.Amount,
+		PairHash:        chainPair.Hash,
+		ReferralId:      referralId,
+	})
+	if err != nil {
+		return nil, handleError(errors.New("boltz error: " + err.Error()))
+	}
+
+	chainSwap := database.ChainSwap{
+		Id:                response.Id,
+		Pair:              pair,
+		State:             boltzrpc.SwapState_PENDING,
+		Status:            boltz.SwapCreated,
+		AcceptZeroConf:    request.GetAcceptZeroConf(),
+		Preimage:          preimage,
+		PaymentHash:       preimageHash,
+		IsAuto:            isAuto,
+		ServiceFeePercent: utils.Percentage(chainPair.Fees.Percentage),
+		BoltzUrl:          response.Endpoint,
+		Label:             request.GetLabel(),
+		ExternalId:        request.GetExternalId(),
+		CreatedAt:         time.Now(),
+	}
+
+	parseDetails := func(details *boltz.ChainSwapData, currency boltz.Currency, privateKey *btcec.PrivateKey, isClaim bool) (*database.ChainSwapData, error) {
+		if details == nil {
+			return nil, errors.New("boltz did not return swap details")
+		}
+		theirPublicKey, err := btcec.ParsePubKey(details.ServerPublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		data := &database.ChainSwapData{
+			Id:                 response.Id,
+			Currency:           currency,
+			PrivateKey:         privateKey,
+			TheirPublicKey:     theirPublicKey,
+			Tree:               details.SwapTree.Deserialize(),
+			Amount:             details.Amount,
+			TimeoutBlockHeight: details.TimeoutBlockHeight,
+			LockupAddress:      details.LockupAddress,
+		}
+
+		if currency == boltz.CurrencyLiquid {
+			data.BlindingKey, _ = btcec.PrivKeyFromBytes(details.BlindingKey)
+		}
+
+		if err := data.InitTree(); err != nil {
+			return nil, err
+		}
+
+		if err := data.Tree.CheckChain(isClaim, data.TimeoutBlockHeight, preimageHash); err != nil {
+			return nil, err
+		}
+
+		if err := data.Tree.CheckAddress(data.LockupAddress, server.network, data.BlindingPubKey()); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+
+	chainSwap.FromData, err = parseDetails(response.LockupDetails, pair.From, refundPrivateKey, false)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("invalid lockup details: %w", err))
+	}
+	chainSwap.FromData.Address = request.GetRefundAddress()
+
+	if chainSwap.FromData.Amount != request.Amount {
+		return nil, handleError(fmt.Errorf("boltz expects lockup of %d instead of %d satoshis", chainSwap.FromData.Amount, request.Amount))
+	}
+
+	chainSwap.ToData, err = parseDetails(response.ClaimDetails, pair.To, claimPrivateKey, true)
+	if err != nil {
+		return nil, handleError(fmt.Errorf("invalid claim details: %w", err))
+	}
+	chainSwap.ToData.Address = claimAddress
+	chainSwap.ToData.Wallet = toWallet
+
+	if fromWallet != nil {
+		chainSwap.FromData.Wallet = fromWallet.Name()
+	}
+
+	logger.Info("Verified redeem scripts and addresses of Chain Swap " + chainSwap.Id)
+
+	if err := server.database.CreateChainSwap(chainSwap); err != nil {
+		return nil, handleError(err)
+	}
+
+	if fromWallet != nil {
+		feeSatPerVbyte, err := server.onchain.EstimateFee(pair.From, onchain.DefaultConfTarget)
+		if err != nil {
+			return nil, handleError(err)
+		}
+		logger.Infof("Paying Chain Swap %s with fee of %f sat/vbyte", chainSwap.Id, feeSatPerVbyte)
+		txId, err := fromWallet.SendToAddress(chainSwap.FromData.LockupAddress, chainSwap.FromData.Amount, feeSatPerVbyte)
+		if err != nil {
+			if dbErr := server.database.UpdateChainSwapState(&chainSwap, boltzrpc.SwapState_ERROR, err.Error()); dbErr != nil {
+				return nil, handleError(dbErr)
+			}
+			return nil, handleError(err)
+		}
+		logger.Infof("Paid lockup of Chain Swap %s with transaction %s", chainSwap.Id, txId)
+	}
+
+	logger.Info("Created new Chain Swap " + chainSwap.Id + ": " + marshalJson(chainSwap.Serialize().Redacted()))
+
+	if err := server.nursery.RegisterChainSwap(chainSwap); err != nil {
+		return nil, handleError(err)
+	}
+
+	return serializeChainSwap(&chainSwap), nil
+}
+
+func (server *routedBoltzServer) CreateChainSwap(_ context.Context, request *boltzrpc.CreateChainSwapRequest) (*boltzrpc.ChainSwapInfo, error) {
+	return server.createChainSwap(false, request)
+}
+
 func (server *routedBoltzServer) importWallet(credentials *wallet.Credentials, password string) error {
 	decryptWalletCredentials, err := server.decryptWalletCredentials(password)
 	if err != nil {
@@ -911,11 +1475,26 @@ func (server *routedBoltzServer) decryptWalletCredentials(password string) (decr
 	return decrypted, nil
 }
 
+// the secrets of swaps are encrypted with the same password as the wallets
+func secretsPasswordError(err error) error {
+	if errors.Is(err, database.ErrWrongPassword) {
+		return status.Errorf(codes.InvalidArgument, "wrong password")
+	}
+	return err
+}
+
 func (server *routedBoltzServer) encryptWalletCredentials(password string, credentials []*wallet.Credentials) (err error) {
 	tx, err := server.database.BeginTx()
 	if err != nil {
 		return err
 	}
+	if err := updateWalletCredentials(tx, password, credentials); err != nil {
+		return tx.Rollback(err)
+	}
+	return tx.Commit()
+}
+
+func updateWalletCredentials(tx *database.Transaction, password string, credentials []*wallet.Credentials) (err error) {
 	for _, creds := range credentials {
 		if password != "" {
 			if creds, err = creds.Encrypt(password); err != nil {
@@ -923,10 +1502,10 @@ func (server *routedBoltzServer) encryptWalletCredentials(password string, crede
 			}
 		}
 		if err := tx.UpdateWalletCredentials(creds); err != nil {
-			return tx.Rollback(err)
+			return err
 		}
 	}
-	return tx.Commit()
+	return nil
 }
 
 func (server *routedBoltzServer) Unlock(_ context.Context, request *boltzrpc.UnlockRequest) (*empty.Empty, error) {
@@ -935,6 +1514,9 @@ func (server *routedBoltzServer) Unlock(_ context.Context, request *boltzrpc.Unl
 
 func (server *routedBoltzServer) VerifyWalletPassword(_ context.Context, request *boltzrpc.VerifyWalletPasswordRequest) (*boltzrpc.VerifyWalletPasswordResponse, error) {
 	_, err := server.decryptWalletCredentials(request.Password)
+	if err == nil {
+		err = server.database.VerifySecretsPassword(request.Password)
+	}
 	return &boltzrpc.VerifyWalletPasswordResponse{Correct: err == nil}, nil
 }
 
@@ -947,6 +1529,12 @@ func (server *routedBoltzServer) unlock(password string) error {
 	if err != nil {
 		return err
 	}
+	if err := server.database.UnlockSecrets(password); err != nil {
+		return secretsPasswordError(err)
+	}
+	if err := server.unlockRootstock(); err != nil {
+		return fmt.Errorf("could not unlock rootstock wallet: %w", err)
+	}
 	for _, creds := range credentials {
 		wallet, err := wallet.Login(creds)
 		if err != nil {
@@ -959,19 +1547,48 @@ func (server *routedBoltzServer) unlock(password string) error {
 	if err := server.swapper.LoadConfig(); err != nil {
 		logger.Warnf("Could not load autoswap config: %v", err)
 	}
+	if err := server.startNursery(); err != nil {
+		return err
+	}
+	server.locked = false
+
+	return nil
+}
+
+func (server *routedBoltzServer) startNursery() error {
 	server.nursery = &nursery.Nursery{}
-	err = server.nursery.Init(
+	return server.nursery.Init(
 		server.network,
 		server.lightning,
 		server.onchain,
 		server.boltz,
 		server.database,
+		server.nurseryConfig,
 	)
-	if err != nil {
-		return err
-	}
-	server.locked = false
+}
 
+// unlockRootstock sets the key of the rootstock wallet, which is created on the first unlock
+func (server *routedBoltzServer) unlockRootstock() error {
+	for _, current := range server.onchain.Wallets {
+		rootstockWallet, ok := current.(*rootstock.Wallet)
+		if !ok {
+			continue
+		}
+		key, err := server.database.QueryRootstockKey()
+		if err != nil {
+			return err
+		}
+		if key == nil {
+			if key, err = btcec.NewPrivateKey(); err != nil {
+				return err
+			}
+			if err := server.database.CreateRootstockKey(key); err != nil {
+				return err
+			}
+		}
+		rootstockWallet.Unlock(key)
+		logger.Infof("Using rootstock wallet %s", rootstockWallet.Address())
+	}
 	return nil
 }
 
@@ -981,13 +1598,98 @@ func (server *routedBoltzServer) ChangeWalletPassword(_ context.Context, request
 		return nil, handleError(err)
 	}
 
-	if err := server.encryptWalletCredentials(request.New, decrypted); err != nil {
+	// the credentials are encrypted in the same transaction as the swap secrets so that both always use the same password
+	err = server.database.ChangeSecretsPassword(request.Old, request.New, func(tx *database.Transaction) error {
+		return updateWalletCredentials(tx, request.New, decrypted)
+	})
+	if err != nil {
+		return nil, handleError(secretsPasswordError(err))
+	}
+	return &empty.Empty{}, nil
+}
+
+func (server *routedBoltzServer) BackupDatabase(_ context.Context, request *boltzrpc.BackupDatabaseRequest) (*boltzrpc.BackupDatabaseResponse, error) {
+	path := utils.ExpandHomeDir(request.GetPath())
+	if path == "" {
+		var err error
+		path, err = server.database.NewBackupPath(request.GetPassword() != "")
+		if err != nil {
+			return nil, handleError(status.Errorf(codes.InvalidArgument, "path is required: %s", err))
+		}
+	}
+
+	if err := server.database.Backup(path, request.GetPassword()); err != nil {
+		return nil, handleError(err)
+	}
+	logger.Info("Backed up database to " + path)
+	return &boltzrpc.BackupDatabaseResponse{Path: path}, nil
+}
+
+func (server *routedBoltzServer) RestoreDatabase(_ context.Context, request *boltzrpc.RestoreDatabaseRequest) (*empty.Empty, error) {
+	if request.Path == "" {
+		return nil, handleError(status.Errorf(codes.InvalidArgument, "path is required"))
+	}
+
+	path := utils.ExpandHomeDir(request.Path)
+	if err := server.database.CheckBackup(path, request.GetPassword()); err != nil {
+		return nil, handleError(status.Errorf(codes.InvalidArgument, "invalid backup: %s", err))
+	}
+
+	// no other request may use the database while it is replaced
+	server.restoreLock.Lock()
+	defer server.restoreLock.Unlock()
+
+	// no swaps may be updated or created while the database is replaced
+	server.nursery.Stop()
+	logger.Debugf("Stopped nursery")
+	autoSwapRunning := server.swapper.Running()
+	server.swapper.Stop()
+
+	if err := server.database.Restore(path, request.GetPassword()); err != nil {
+		server.recoverFailedRestore(autoSwapRunning)
 		return nil, handleError(err)
 	}
+
+	logger.Info("Shutting down to use the restored database")
+	server.stop <- true
 	return &empty.Empty{}, nil
 }
 
+// recoverFailedRestore starts the nursery and auto swapper again with the previous database or shuts down if that is not possible
+func (server *routedBoltzServer) recoverFailedRestore(autoSwapRunning bool) {
+	if server.database.Connected() {
+		err := server.startNursery()
+		if err == nil {
+			logger.Info("Restarted nursery after failed restore")
+			if autoSwapRunning {
+				if err := server.swapper.Start(); err != nil {
+					logger.Errorf("Could not restart auto swapper after failed restore: %v", err)
+				}
+			}
+			return
+		}
+		logger.Errorf("Could not restart nursery after failed restore: %v", err)
+	} else {
+		logger.Error("Previous database could not be opened again after failed restore")
+	}
+	logger.Info("Shutting down")
+	server.stop <- true
+}
+
 var errLocked = errors.New("boltzd is locked, use \"unlock\" to enable full RPC access")
+var errRestoring = errors.New("database is being restored")
+
+// beginRequest rejects requests while the database is restored; the returned function has to be called once the request is done.
+// The restore itself takes the lock exclusively and therefore doesn't begin a request.
+func (server *routedBoltzServer) beginRequest(fullMethod string) (func(), error) {
+	if strings.HasSuffix(fullMethod, "/RestoreDatabase") {
+		return func() {}, nil
+	}
+	if !server.restoreLock.TryRLock() {
+		return nil, handleError(errRestoring)
+	}
+	return server.restoreLock.RUnlock, nil
+}
 
 func (server *routedBoltzServer) requestAllowed(fullMethod string) error {
 	if server.locked && !strings.Contains(fullMethod, "Unlock") {
@@ -1006,6 +1708,11 @@ func (server *routedBoltzServer) UnaryServerInterceptor() grpc.UnaryServerInterc
 		if err := server.requestAllowed(info.FullMethod); err != nil {
 			return nil, err
 		}
+		done, err := server.beginRequest(info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		defer done()
 
 		return handler(ctx, req)
 	}
@@ -1021,6 +1728,12 @@ func (server *routedBoltzServer) StreamServerInterceptor() grpc.StreamServerInte
 		if err := server.requestAllowed(info.FullMethod); err != nil {
 			return err
 		}
+		// streams only read updates of the nursery, which is stopped during a restore, so they don't hold the lock
+		done, err := server.beginRequest(info.FullMethod)
+		if err != nil {
+			return err
+		}
+		done()
 
 		return handler(srv, ss)
 	}
@@ -1051,12 +1764,8 @@ func findPair[T any](pair boltz.Pair, nested map[boltz.Currency]map[boltz.Curren
 }
 
 func (server *routedBoltzServer) GetSubmarinePair(ctx context.Context, request *boltzrpc.Pair) (*boltzrpc.SubmarinePair, error) {
-	pairsResponse, err := server.boltz.GetSubmarinePairs()
-	if err != nil {
-		return nil, handleError(err)
-	}
 	pair := utils.ParsePair(request)
-	submarinePair, err := findPair(pair, pairsResponse)
+	submarinePair, err := server.pairs.submarine.find(pair, false, "")
 	if err != nil {
 		return nil, handleError(err)
 	}
@@ -1065,30 +1774,36 @@ func (server *routedBoltzServer) GetSubmarinePair(ctx context.Context, request *
 }
 
 func (server *routedBoltzServer) GetReversePair(ctx context.Context, request *boltzrpc.Pair) (*boltzrpc.ReversePair, error) {
-	pairsResponse, err := server.boltz.GetReversePairs()
+	pair := utils.ParsePair(request)
+	reversePair, err := server.pairs.reverse.find(pair, false, "")
 	if err != nil {
 		return nil, err
 	}
+
+	return serializeReversePair(pair, reversePair), nil
+}
+
+func (server *routedBoltzServer) GetChainPair(ctx context.Context, request *boltzrpc.Pair) (*boltzrpc.ChainPair, error) {
 	pair := utils.ParsePair(request)
-	reversePair, err := findPair(pair, pairsResponse)
+	chainPair, err := server.pairs.chain.find(pair, false, "")
 	if err != nil {
 		return nil, err
 	}
 
-	return serializeReversePair(pair, reversePair), nil
+	return serializeChainPair(pair, chainPair), nil
 }
 
 func (server *routedBoltzServer) GetPairs(context.Context, *empty.Empty) (*boltzrpc.GetPairsResponse, error) {
 	response := &boltzrpc.GetPairsResponse{}
 
-	submarinePairs, err := server.boltz.GetSubmarinePairs()
+	submarinePairs, err := server.pairs.submarine.get(false)
 	if err != nil {
 		return nil, err
 	}
 
 	for from, p := range submarinePairs {
 		for to, pair := range p {
-			if from != boltz.CurrencyRootstock {
+			if from != boltz.CurrencyRootstock || server.onchain.Rootstock != nil {
 				response.Submarine = append(response.Submarine, serializeSubmarinePair(boltz.Pair{
 					From: from,
 					To:   to,
@@ -1097,14 +1812,14 @@ func (server *routedBoltzServer) GetPairs(context.Context, *empty.Empty) (*boltz
 		}
 	}
 
-	reversePairs, err := server.boltz.GetReversePairs()
+	reversePairs, err := server.pairs.reverse.get(false)
 	if err != nil {
 		return nil, err
 	}
 
 	for from, p := range reversePairs {
 		for to, pair := range p {
-			if to != boltz.CurrencyRootstock {
+			if to != boltz.CurrencyRootstock || server.onchain.Rootstock != nil {
 				response.Reverse = append(response.Reverse, serializeReversePair(boltz.Pair{
 					From: from,
 					To:   to,
@@ -1113,6 +1828,22 @@ func (server *routedBoltzServer) GetPairs(context.Context, *empty.Empty) (*boltz
 		}
 	}
 
+	chainPairs, err := server.pairs.chain.get(false)
+	if err != nil {
+		return nil, err
+	}
+
+	for from, p := range chainPairs {
+		for to, pair := range p {
+			if from != boltz.CurrencyRootstock && to != boltz.CurrencyRootstock {
+				response.Chain = append(response.Chain, serializeChainPair(boltz.Pair{
+					From: from,
+					To:   to,
+				}, &pair))
+			}
+		}
+	}
+
 	return response, nil
 
 }
@@ -1158,6 +1889,34 @@ func calculateDepositLimit(limit uint64, fees *boltzrpc.Fees, isMin bool) uint64
 	return uint64(limitFloat) + uint64(fees.Miner.Normal)
 }
 
+func parseFeePolicy(policy *boltzrpc.FeePolicy) (*onchain.FeePolicy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	if policy.ConfTarget != nil && *policy.ConfTarget < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "conf target has to be at least 1")
+	}
+	if policy.SatPerVbyte != nil && *policy.SatPerVbyte <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "sat per vbyte has to be positive")
+	}
+	if policy.MaxFee != nil && *policy.MaxFee == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max fee has to be positive")
+	}
+	if policy.MaxFeePercent != nil && *policy.MaxFeePercent <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max fee percent has to be positive")
+	}
+	feePolicy := &onchain.FeePolicy{
+		ConfTarget:  policy.ConfTarget,
+		SatPerVbyte: policy.SatPerVbyte,
+		MaxFee:      policy.MaxFee,
+	}
+	if policy.MaxFeePercent != nil {
+		maxFeePercent := utils.Percentage(*policy.MaxFeePercent)
+		feePolicy.MaxFeePercent = &maxFeePercent
+	}
+	return feePolicy, nil
+}
+
 func newKeys() (*btcec.PrivateKey, *btcec.PublicKey, error) {
 	privateKey, err := btcec.NewPrivateKey()
 
@@ -0,0 +1,185 @@
+package rpcserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/BoltzExchange/boltz-client/boltz"
+	"github.com/BoltzExchange/boltz-client/boltz/mock"
+	"github.com/BoltzExchange/boltz-client/boltzrpc"
+	"github.com/BoltzExchange/boltz-client/database"
+	"github.com/BoltzExchange/boltz-client/nursery"
+	"github.com/BoltzExchange/boltz-client/onchain"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testBlockHeight = 1000
+
+// testChain gets and broadcasts transactions through the mock server and never mines a block
+type testChain struct {
+	onchain.TxProvider
+}
+
+func (chain *testChain) RegisterBlockListener(_ chan<- *onchain.BlockEpoch, stop <-chan bool) error {
+	<-stop
+	return nil
+}
+
+func (chain *testChain) GetBlockHeight() (uint32, error) {
+	return testBlockHeight, nil
+}
+
+func (chain *testChain) EstimateFee(int32) (float64, error) {
+	return 2, nil
+}
+
+// newTestServer returns a server in standalone mode which uses the mock server as Boltz API and has no wallets
+func newTestServer(t *testing.T) (*routedBoltzServer, *mock.Server) {
+	boltzServer, err := mock.NewServer(boltz.Regtest)
+	require.NoError(t, err)
+	t.Cleanup(boltzServer.Close)
+	boltzServer.SetBlockHeight(boltz.CurrencyBtc, testBlockHeight)
+	client := boltzServer.Client()
+
+	db := &database.Database{Path: filepath.Join(t.TempDir(), "boltz.db")}
+	require.NoError(t, db.Connect())
+
+	btc := &testChain{onchain.NewBoltzTxProvider(client, boltz.CurrencyBtc)}
+	chain := &onchain.Onchain{
+		Btc:     &onchain.Currency{Listener: btc, Fees: btc, Tx: btc},
+		Network: boltz.Regtest,
+	}
+	chain.Init()
+
+	server := &routedBoltzServer{
+		network:       boltz.Regtest,
+		onchain:       chain,
+		boltz:         client,
+		database:      db,
+		nurseryConfig: nursery.Config{},
+		pairs:         newPairCaches(client),
+		stop:          make(chan bool, 1),
+	}
+	require.NoError(t, server.startNursery())
+	t.Cleanup(server.nursery.Stop)
+	return server, boltzServer
+}
+
+func newTestAddress(t *testing.T) string {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	address, err := btcutil.NewAddressWitnessPubKeyHash(btcutil.Hash160(key.PubKey().SerializeCompressed()), boltz.Regtest.Btc)
+	require.NoError(t, err)
+	return address.EncodeAddress()
+}
+
+// payTo creates a transaction from a made up input to address
+func payTo(t *testing.T, address string, amount uint64) string {
+	decoded, err := btcutil.DecodeAddress(address, boltz.Regtest.Btc)
+	require.NoError(t, err)
+	script, err := txscript.PayToAddrScript(decoded)
+	require.NoError(t, err)
+
+	var funding chainhash.Hash
+	_, err = rand.Read(funding[:])
+	require.NoError(t, err)
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&funding, 0), nil, nil))
+	tx.AddTxOut(wire.NewTxOut(int64(amount), script))
+
+	var serialized bytes.Buffer
+	require.NoError(t, tx.Serialize(&serialized))
+	return hex.EncodeToString(serialized.Bytes())
+}
+
+func TestCreateAndRefundSwap(t *testing.T) {
+	server, boltzServer := newTestServer(t)
+	ctx := context.Background()
+
+	preimage := make([]byte, 32)
+	_, err := rand.Read(preimage)
+	require.NoError(t, err)
+	preimageHash := sha256.Sum256(preimage)
+	invoice, err := boltzServer.NewInvoice(preimageHash[:], 100000)
+	require.NoError(t, err)
+
+	created, err := server.CreateSwap(ctx, &boltzrpc.CreateSwapRequest{
+		Pair:    &boltzrpc.Pair{From: boltzrpc.Currency_BTC, To: boltzrpc.Currency_BTC},
+		Invoice: &invoice,
+	})
+	require.NoError(t, err)
+	require.Contains(t, boltzServer.Swaps(boltz.NormalSwap), created.Id)
+
+	_, err = server.RefundSwap(ctx, &boltzrpc.RefundSwapRequest{Id: created.Id, Address: newTestAddress(t)})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// boltz rejects the lockup since it pays too little; without a refund address or wallet the swap can't be refunded by itself
+	lockupId, err := server.boltz.BroadcastTransaction(payTo(t, created.Address, uint64(created.ExpectedAmount)-1), boltz.CurrencyBtc)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		info, err := server.GetSwapInfo(ctx, &boltzrpc.GetSwapInfoRequest{Id: created.Id})
+		require.NoError(t, err)
+		return info.Swap.LockupTransactionId == lockupId && info.Swap.State == boltzrpc.SwapState_ERROR
+	}, 10*time.Second, 10*time.Millisecond)
+
+	_, err = server.RefundSwap(ctx, &boltzrpc.RefundSwapRequest{Id: "invalid", Address: newTestAddress(t)})
+	require.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.RefundSwap(ctx, &boltzrpc.RefundSwapRequest{Id: created.Id, Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	refunded, err := server.RefundSwap(ctx, &boltzrpc.RefundSwapRequest{Id: created.Id, Address: newTestAddress(t)})
+	require.NoError(t, err)
+	require.Equal(t, boltzrpc.SwapState_REFUNDED, refunded.Swap.State)
+	require.NotEmpty(t, refunded.Swap.RefundTransactionId)
+	require.True(t, refunded.Swap.GetRefundCooperative())
+
+	_, err = server.RefundSwap(ctx, &boltzrpc.RefundSwapRequest{Id: created.Id, Address: newTestAddress(t)})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestBeginRequestDuringRestore(t *testing.T) {
+	server := &routedBoltzServer{}
+
+	done, err := server.beginRequest("/boltzrpc.Boltz/ListSwaps")
+	require.NoError(t, err)
+
+	restored := make(chan bool)
+	go func() {
+		server.restoreLock.Lock()
+		restored <- true
+	}()
+
+	// the restore waits for running requests
+	select {
+	case <-restored:
+		require.Fail(t, "restore did not wait for running request")
+	case <-time.After(100 * time.Millisecond):
+	}
+	done()
+	<-restored
+
+	_, err = server.beginRequest("/boltzrpc.Boltz/ListSwaps")
+	require.ErrorIs(t, err, errRestoring)
+
+	done, err = server.beginRequest("/boltzrpc.Boltz/RestoreDatabase")
+	require.NoError(t, err)
+	done()
+
+	server.restoreLock.Unlock()
+	done, err = server.beginRequest("/boltzrpc.Boltz/ListSwaps")
+	require.NoError(t, err)
+	done()
+}
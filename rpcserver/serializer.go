@@ -5,6 +5,7 @@ import (
 
 	"github.com/BoltzExchange/boltz-client/boltz"
 	"github.com/BoltzExchange/boltz-client/lightning"
+	"github.com/BoltzExchange/boltz-client/nursery"
 	"github.com/BoltzExchange/boltz-client/onchain"
 	"github.com/BoltzExchange/boltz-client/onchain/wallet"
 
@@ -40,6 +41,8 @@ func serializeChanIds(chanIds []lightning.ChanId) (result []*boltzrpc.ChannelId)
 func serializeCurrency(currency boltz.Currency) boltzrpc.Currency {
 	if currency == boltz.CurrencyBtc {
 		return boltzrpc.Currency_BTC
+	} else if currency == boltz.CurrencyRootstock {
+		return boltzrpc.Currency_RBTC
 	} else {
 		return boltzrpc.Currency_LBTC
 	}
@@ -80,6 +83,11 @@ func serializeSwap(swap *database.Swap) *boltzrpc.SwapInfo {
 		ServiceFee:          serializedSwap.ServiceFee,
 		OnchainFee:          serializedSwap.OnchainFee,
 		Wallet:              serializeOptionalString(serializedSwap.Wallet),
+		RefundCooperative:   serializedSwap.RefundCooperative,
+		CooperativeError:    serializeOptionalString(serializedSwap.CooperativeError),
+		FeePolicy:           serializeFeePolicy(swap.FeePolicy),
+		Label:               serializeOptionalString(swap.Label),
+		ExternalId:          serializeOptionalString(swap.ExternalId),
 	}
 }
 
@@ -111,6 +119,57 @@ func serializeReverseSwap(reverseSwap *database.ReverseSwap) *boltzrpc.ReverseSw
 		OnchainFee:          serializedReverseSwap.OnchainFee,
 		RoutingFeeMsat:      serializedReverseSwap.RoutingFeeMsat,
 		ExternalPay:         serializedReverseSwap.ExternalPay,
+		FeePolicy:           serializeFeePolicy(reverseSwap.FeePolicy),
+		Label:               serializeOptionalString(reverseSwap.Label),
+		ExternalId:          serializeOptionalString(reverseSwap.ExternalId),
+	}
+}
+
+func serializeChainSwapData(data *database.ChainSwapData) *boltzrpc.ChainSwapData {
+	if data == nil {
+		return nil
+	}
+	serializedData := data.Serialize()
+
+	return &boltzrpc.ChainSwapData{
+		Id:                  serializedData.Id,
+		Currency:            serializeCurrency(data.Currency),
+		PrivateKey:          serializedData.PrivateKey,
+		TheirPublicKey:      serializedData.TheirPublicKey,
+		Amount:              serializedData.Amount,
+		TimeoutBlockHeight:  serializedData.TimeoutBlockHeight,
+		LockupTransactionId: serializeOptionalString(serializedData.LockupTransactionId),
+		TransactionId:       serializeOptionalString(serializedData.TransactionId),
+		Wallet:              serializeOptionalString(serializedData.Wallet),
+		Address:             serializeOptionalString(serializedData.Address),
+		BlindingKey:         serializeOptionalString(serializedData.BlindingKey),
+		LockupAddress:       serializedData.LockupAddress,
+	}
+}
+
+func serializeChainSwap(chainSwap *database.ChainSwap) *boltzrpc.ChainSwapInfo {
+	if chainSwap == nil {
+		return nil
+	}
+	serializedChainSwap := chainSwap.Serialize()
+
+	return &boltzrpc.ChainSwapInfo{
+		Id:                serializedChainSwap.Id,
+		Pair:              serializePair(chainSwap.Pair),
+		State:             chainSwap.State,
+		Error:             serializedChainSwap.Error,
+		Status:            serializedChainSwap.Status,
+		Preimage:          serializedChainSwap.Preimage,
+		IsAuto:            serializedChainSwap.IsAuto,
+		AcceptZeroConf:    serializedChainSwap.AcceptZeroConf,
+		ServiceFee:        serializedChainSwap.ServiceFee,
+		ServiceFeePercent: float64(serializedChainSwap.ServiceFeePercent),
+		OnchainFee:        serializedChainSwap.OnchainFee,
+		CreatedAt:         serializeTime(chainSwap.CreatedAt),
+		Label:             serializeOptionalString(chainSwap.Label),
+		ExternalId:        serializeOptionalString(chainSwap.ExternalId),
+		FromData:          serializeChainSwapData(chainSwap.FromData),
+		ToData:            serializeChainSwapData(chainSwap.ToData),
 	}
 }
 
@@ -150,6 +209,29 @@ func serializeReversePair(pair boltz.Pair, reversePair *boltz.ReversePair) *bolt
 	}
 }
 
+func serializeChainPair(pair boltz.Pair, chainPair *boltz.ChainPair) *boltzrpc.ChainPair {
+	return &boltzrpc.ChainPair{
+		Pair: serializePair(pair),
+		Hash: chainPair.Hash,
+		Rate: float32(chainPair.Rate),
+		Fees: &boltzrpc.ChainPair_Fees{
+			Percentage: float32(chainPair.Fees.Percentage),
+			MinerFees: &boltzrpc.ChainPair_Fees_MinerFees{
+				Server: chainPair.Fees.MinerFees.Server,
+				User: &boltzrpc.ChainPair_Fees_MinerFees_UserFees{
+					Claim:  chainPair.Fees.MinerFees.User.Claim,
+					Lockup: chainPair.Fees.MinerFees.User.Lockup,
+				},
+			},
+		},
+		Limits: &boltzrpc.Limits{
+			Minimal:               chainPair.Limits.Minimal,
+			Maximal:               chainPair.Limits.Maximal,
+			MaximalZeroConfAmount: chainPair.Limits.MaximalZeroConfAmount,
+		},
+	}
+}
+
 func serializeWalletBalance(balance *onchain.Balance) *boltzrpc.Balance {
 	return &boltzrpc.Balance{
 		Confirmed:   balance.Confirmed,
@@ -175,10 +257,62 @@ func serializeWalletCredentials(credentials *wallet.Credentials) *boltzrpc.Walle
 	}
 }
 
+func serializeFeePolicy(policy *onchain.FeePolicy) *boltzrpc.FeePolicy {
+	if policy == nil {
+		return nil
+	}
+	result := &boltzrpc.FeePolicy{
+		ConfTarget:  policy.ConfTarget,
+		SatPerVbyte: policy.SatPerVbyte,
+		MaxFee:      policy.MaxFee,
+	}
+	if policy.MaxFeePercent != nil {
+		maxFeePercent := float32(*policy.MaxFeePercent)
+		result.MaxFeePercent = &maxFeePercent
+	}
+	return result
+}
+
 func serializeTime(t time.Time) int64 {
 	return t.UTC().Unix()
 }
 
+func serializeTimeoutWarning(warning *nursery.TimeoutWarning) *boltzrpc.TimeoutWarning {
+	if warning == nil {
+		return nil
+	}
+	return &boltzrpc.TimeoutWarning{
+		BlocksLeft: warning.BlocksLeft,
+		Eta:        serializeTime(warning.Eta),
+		Message:    warning.Message,
+	}
+}
+
+func serializeSwapEvent(event *database.SwapEvent) *boltzrpc.SwapEvent {
+	result := &boltzrpc.SwapEvent{
+		Type:          event.Type,
+		CreatedAt:     serializeTime(event.CreatedAt),
+		Status:        serializeOptionalString(event.Status),
+		Error:         serializeOptionalString(event.Error),
+		TransactionId: serializeOptionalString(event.TransactionId),
+		Fee:           event.Fee,
+	}
+	if event.Currency != "" {
+		currency := serializeCurrency(event.Currency)
+		result.Currency = &currency
+	}
+	if event.BlockHeight != 0 {
+		result.BlockHeight = &event.BlockHeight
+	}
+	if event.Type == boltzrpc.SwapEventType_STATE {
+		result.State = &event.State
+	}
+	if event.SatPerVbyte != 0 {
+		result.SatPerVbyte = &event.SatPerVbyte
+	}
+	return result
+}
+
 func serializeLightningChannel(channel *lightning.LightningChannel) *boltzrpc.LightningChannel {
 	if channel == nil {
 		return nil
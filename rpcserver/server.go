@@ -19,6 +19,7 @@ import (
 	"github.com/BoltzExchange/boltz-client/lightning"
 	"github.com/BoltzExchange/boltz-client/logger"
 	"github.com/BoltzExchange/boltz-client/macaroons"
+	"github.com/BoltzExchange/boltz-client/nursery"
 	"github.com/BoltzExchange/boltz-client/onchain"
 	"github.com/BoltzExchange/boltz-client/utils"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -58,6 +59,7 @@ func (server *RpcServer) Init(
 	database *database.Database,
 	onchain *onchain.Onchain,
 	autoSwapConfigPath string,
+	nurseryConfig nursery.Config,
 ) error {
 	var serverOpts []grpc.ServerOption
 	var err error
@@ -71,6 +73,9 @@ func (server *RpcServer) Init(
 		database:  database,
 		onchain:   onchain,
 
+		nurseryConfig: nurseryConfig,
+		pairs:         newPairCaches(boltzApi),
+
 		stop:   server.Stop,
 		locked: true,
 	}
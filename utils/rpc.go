@@ -10,6 +10,8 @@ func ParseCurrency(grpcCurrency *boltzrpc.Currency) boltz.Currency {
 		return ""
 	} else if *grpcCurrency == boltzrpc.Currency_BTC {
 		return boltz.CurrencyBtc
+	} else if *grpcCurrency == boltzrpc.Currency_RBTC {
+		return boltz.CurrencyRootstock
 	} else {
 		return boltz.CurrencyLiquid
 	}